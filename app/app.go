@@ -3,6 +3,7 @@ package app
 import (
 	"github.com/arterynetwork/artr/x/delegating"
 	"github.com/arterynetwork/artr/x/earning"
+	"github.com/arterynetwork/artr/x/escrow"
 	"github.com/arterynetwork/artr/x/noding"
 	"github.com/arterynetwork/artr/x/profile"
 	"github.com/arterynetwork/artr/x/referral"
@@ -64,6 +65,7 @@ var (
 		voting.AppModuleBasic{},
 		noding.AppModuleBasic{},
 		earning.AppModuleBasic{},
+		escrow.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -73,6 +75,7 @@ var (
 		storage.ModuleName:    nil,
 		noding.ModuleName:     nil,
 		earning.ModuleName:    nil,
+		escrow.ModuleName:     nil,
 	}
 )
 
@@ -119,6 +122,7 @@ type ArteryApp struct {
 	votingKeeper       voting.Keeper
 	nodingKeeper       noding.Keeper
 	earningKeeper      earning.Keeper
+	escrowKeeper       escrow.Keeper
 
 	// Module Manager
 	mm *module.Manager
@@ -149,7 +153,7 @@ func NewArteryApp(
 		schedule.StoreKey, referral.StoreKey, referral.IndexStoreKey, delegating.MainStoreKey,
 		delegating.ClusterStoreKey, vpn.StoreKey, storage.StoreKey,
 		subscription.StoreKey, voting.StoreKey, noding.StoreKey, noding.IdxStoreKey,
-		earning.StoreKey)
+		earning.StoreKey, escrow.StoreKey, bank.StoreKey)
 
 	tKeys := sdk.NewTransientStoreKeys(params.TStoreKey)
 
@@ -203,6 +207,8 @@ func NewArteryApp(
 
 	// The BankKeeper allows you perform sdk.Coins interactions
 	app.bankKeeper = bank.NewBaseKeeper(
+		app.cdc,
+		keys[bank.StoreKey],
 		app.accountKeeper,
 		app.subspaces[bank.ModuleName],
 		app.ModuleAccountAddrs(),
@@ -282,6 +288,13 @@ func NewArteryApp(
 		app.profileKeeper,
 	)
 
+	app.escrowKeeper = escrow.NewKeeper(
+		app.cdc,
+		keys[escrow.StoreKey],
+		app.supplyKeeper,
+		app.bankKeeper,
+	)
+
 	app.upgradeKeeper = upgrade.NewKeeper(
 		map[int64]bool{},
 		keys[upgrade.StoreKey],
@@ -338,13 +351,38 @@ func NewArteryApp(
 	app.scheduleKeeper.AddHook(referral.CompressionHookName, app.referralKeeper.PerformCompression)
 	app.scheduleKeeper.AddHook(referral.TransitionTimeoutHookName, app.referralKeeper.PerformTransitionTimeout)
 	app.scheduleKeeper.AddHook(subscription.HookName, app.subscriptionKeeper.ProcessSchedule)
+	app.scheduleKeeper.AddHook(subscription.RateTallyHookName, app.subscriptionKeeper.ProcessRateTally)
 	app.scheduleKeeper.AddHook(voting.HookName, app.votingKeeper.ProcessSchedule)
 	app.scheduleKeeper.AddHook(earning.StartHookName, app.earningKeeper.MustPerformStart)
 	app.scheduleKeeper.AddHook(earning.ContinueHookName, app.earningKeeper.MustPerformContinue)
+	app.scheduleKeeper.AddHook(earning.AttestedHookName, app.earningKeeper.PerformAttested)
+	app.scheduleKeeper.AddHook(earning.SnapshotHookName, app.earningKeeper.PerformSnapshot)
+	app.scheduleKeeper.AddHook(earning.ClaimsCloseHookName, app.earningKeeper.PerformCloseClaims)
 	app.scheduleKeeper.AddHook(delegating.RevokeHookName, app.delegatingKeeper.MustPerformRevoking)
+	app.scheduleKeeper.AddHook(bank.StandingOrderHookName,
+		bank.NewStandingOrderHook(app.bankKeeper, app.supplyKeeper, app.accountKeeper),
+	)
+
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, app.referralKeeper.MigrateAccount)
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, app.delegatingKeeper.MigrateAccount)
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, app.subscriptionKeeper.MigrateAccount)
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, app.vpnKeeper.MigrateAccount)
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, app.storageKeeper.MigrateAccount)
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, app.escrowKeeper.MigrateAccount)
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, app.nodingKeeper.MigrateAccount)
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, app.earningKeeper.MigrateAccount)
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback, bank.NewAccountMigratedHook(app.bankKeeper, app.scheduleKeeper))
+	app.profileKeeper.AddHook(profile.AccountMigratedCallback,
+		func(ctx sdk.Context, from, to sdk.AccAddress) error {
+			app.scheduleKeeper.ReassignTasks(ctx, from, to)
+			return nil
+		})
+	app.profileKeeper.AddHook(profile.PrepaidInviteRedeemedCallback, app.subscriptionKeeper.PayForInvitee)
 
 	app.referralKeeper.AddHook(referral.StatusUpdatedCallback, app.nodingKeeper.OnStatusUpdate)
 	app.referralKeeper.AddHook(referral.StakeChangedCallback, app.nodingKeeper.OnStakeChanged)
+	app.referralKeeper.AddHook(referral.AccountCompressedCallback, app.profileKeeper.OnAccountCompressed)
+	app.storageKeeper.AddHook(storage.HookQuotaExceeded, app.subscriptionKeeper.AutoBuyStorage)
 
 	app.upgradeKeeper.SetUpgradeHandler("1.1.1", NopUpgradeHandler)
 	//Cancelled: app.upgradeKeeper.SetUpgradeHandler("1.1.2", CliWarningUpgradeHandler)
@@ -366,13 +404,32 @@ func NewArteryApp(
 		RebuildTeamCoinsCache(app.referralKeeper, app.accountKeeper),
 	)
 	app.upgradeKeeper.SetUpgradeHandler("1.3.0", InitializeNodingLottery(app.nodingKeeper, app.subspaces[noding.ModuleName]))
+	app.upgradeKeeper.SetUpgradeHandler("1.4.0",
+		Chain(
+			InitializeRateOracle(app.subscriptionKeeper, app.subspaces[subscription.ModuleName]),
+			InitializeStorageParams(app.storageKeeper, app.vpnKeeper),
+			InitializeScheduleRetries(app.scheduleKeeper, app.subspaces[schedule.ModuleName]),
+			IndexScheduledTasks(app.scheduleKeeper),
+			InitializeMonthDuration(app.subscriptionKeeper, app.subspaces[subscription.ModuleName]),
+			InitializeRevokePeriod(app.delegatingKeeper, app.subspaces[delegating.ModuleName]),
+			InitializeScheduleBudget(app.scheduleKeeper, app.subspaces[schedule.ModuleName]),
+			PrefixEarners(app.earningKeeper),
+			InitializeEarningApprovals(app.earningKeeper, app.subspaces[earning.ModuleName]),
+			InitializeSnapshotPeriod(app.earningKeeper, app.subspaces[earning.ModuleName]),
+			InitializeClaimWindow(app.earningKeeper, app.subspaces[earning.ModuleName]),
+			InitializeRecoveryDelay(app.profileKeeper, app.subspaces[profile.ModuleName]),
+			InitializeTxFee(app.bankKeeper),
+			InitializeFeeExemptions(app.bankKeeper),
+			InitializeMinStandingOrderInterval(app.bankKeeper),
+		),
+	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
 		schedule.NewAppModule(app.scheduleKeeper),
 		auth.NewAppModule(app.accountKeeper),
-		bank.NewAppModule(app.bankKeeper, app.accountKeeper, app.supplyKeeper),
+		bank.NewAppModule(app.bankKeeper, app.accountKeeper, app.supplyKeeper, app.scheduleKeeper),
 		upgrade.NewAppModule(app.upgradeKeeper),
 		profile.NewAppModule(app.profileKeeper, app.accountKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
@@ -383,6 +440,7 @@ func NewArteryApp(
 		subscription.NewAppModule(app.subscriptionKeeper),
 		noding.NewAppModule(app.nodingKeeper, app.referralKeeper, app.scheduleKeeper, app.supplyKeeper),
 		earning.NewAppModule(app.earningKeeper, app.supplyKeeper, app.scheduleKeeper),
+		escrow.NewAppModule(app.escrowKeeper),
 		voting.NewAppModule(app.votingKeeper, app.scheduleKeeper, app.upgradeKeeper, app.nodingKeeper, app.delegatingKeeper, app.referralKeeper, app.subscriptionKeeper, app.profileKeeper, app.earningKeeper, app.vpnKeeper),
 	)
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -409,6 +467,7 @@ func NewArteryApp(
 		supply.ModuleName,
 		noding.ModuleName,
 		earning.ModuleName,
+		escrow.ModuleName,
 	)
 
 	// register all module routes and module queriers
@@ -420,17 +479,26 @@ func NewArteryApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	// The AnteHandler handles signature verification and transaction pre-processing
-	app.SetAnteHandler(
-		auth.NewAnteHandler(
-			app.accountKeeper,
-			app.supplyKeeper,
-			auth.DefaultSigVerificationGasConsumer,
-		),
+	authAnteHandler := auth.NewAnteHandler(
+		app.accountKeeper,
+		app.supplyKeeper,
+		auth.DefaultSigVerificationGasConsumer,
+	)
+	bankAnteHandler := sdk.ChainAnteDecorators(
+		bank.NewMemoRequiredDecorator(app.bankKeeper),
 	)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		newCtx, err := authAnteHandler(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		return bankAnteHandler(newCtx, tx, simulate)
+	})
 
 	// initialize stores
 	app.MountKVStores(keys)
 	app.MountTransientStores(tKeys)
+	app.SetStoreLoader(UpgradeStoreLoader(db, keys, app.upgradeKeeper, PlannedStoreUpgrades))
 
 	if loadLatest {
 		err := app.LoadLatestVersion(app.keys[bam.MainStoreKey])
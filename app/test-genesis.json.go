@@ -565,7 +565,13 @@ const defaultGenesis = `{
 	}
       ]
     },
-    "schedule": {},
+    "schedule": {
+      "params": {
+        "initial_height": "0",
+        "max_retries": 3,
+        "max_tasks_per_block": 0
+      }
+    },
     "distribution": {
       "params": {
         "community_tax": "0.020000000000000000",
@@ -595,7 +601,11 @@ const defaultGenesis = `{
         "base_storage_gb": 5,
 		"course_change_signers": [
 		  "artr1d4ezqdj03uachct8hum0z9zlfftzdq2f6yzvhj"
-		]
+		],
+        "rate_vote_window": "120",
+        "rate_min_votes": 1,
+        "rate_max_deviation": "10%",
+        "month_duration": "0"
       },
       "activity": [
         {
@@ -813,7 +823,13 @@ const defaultGenesis = `{
     },
     "params": null,
     "artrbank": {
-      "send_enabled": true
+      "send_enabled": true,
+      "tx_fee": {
+        "default": {
+          "rate": "3/1000",
+          "max": "10000000"
+        }
+      }
     },
     "vpn": {
       "params": {
@@ -824,7 +840,15 @@ const defaultGenesis = `{
       },
       "vpn_statuses": null
     },
-    "storage": {},
+    "storage": {
+      "params": {
+        "signers": [
+          "artr1d4ezqdj03uachct8hum0z9zlfftzdq2f6yzvhj",
+          "artr1h8s8yf433ypjc5htavsyc9zvg3vk43vms03z3l"
+        ],
+        "near_quota": "90%"
+      }
+    },
     "supply": {
       "supply": []
     },
@@ -836,7 +860,8 @@ const defaultGenesis = `{
           "ten_k_plus": "27",
           "hundred_k_plus": "30"
         },
-        "min_delegate": "1000"
+        "min_delegate": "1000",
+        "revoke_period": "0"
       }
     },
     "earning": {
@@ -846,6 +871,9 @@ const defaultGenesis = `{
 		  "artr1h8s8yf433ypjc5htavsyc9zvg3vk43vms03z3l"
 		]
       }
+    },
+    "escrow": {
+      "next_id": "1"
     }
   }
 }`
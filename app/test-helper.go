@@ -30,6 +30,7 @@ import (
 	"github.com/arterynetwork/artr/x/bank"
 	"github.com/arterynetwork/artr/x/delegating"
 	"github.com/arterynetwork/artr/x/earning"
+	"github.com/arterynetwork/artr/x/escrow"
 	"github.com/arterynetwork/artr/x/noding"
 	"github.com/arterynetwork/artr/x/profile"
 	"github.com/arterynetwork/artr/x/referral"
@@ -67,6 +68,7 @@ func (app ArteryApp) GetSubscriptionKeeper() subscription.Keeper { return app.su
 func (app ArteryApp) GetVotingKeeper() voting.Keeper             { return app.votingKeeper }
 func (app ArteryApp) GetNodingKeeper() noding.Keeper             { return app.nodingKeeper }
 func (app ArteryApp) GetEarningKeeper() earning.Keeper           { return app.earningKeeper }
+func (app ArteryApp) GetEscrowKeeper() escrow.Keeper             { return app.escrowKeeper }
 
 func NewAppFromGenesis(genesis []byte) (app *ArteryApp, cleanup func()) {
 	var logger log.Logger
@@ -4,6 +4,12 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/tendermint/iavl"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -11,14 +17,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/bank"
 	"github.com/arterynetwork/artr/x/delegating"
 	dTypes "github.com/arterynetwork/artr/x/delegating/types"
+	"github.com/arterynetwork/artr/x/earning"
+	earningTypes "github.com/arterynetwork/artr/x/earning/types"
+	"github.com/arterynetwork/artr/x/escrow"
 	"github.com/arterynetwork/artr/x/noding"
 	nodingTypes "github.com/arterynetwork/artr/x/noding/types"
 	"github.com/arterynetwork/artr/x/profile"
+	profileTypes "github.com/arterynetwork/artr/x/profile/types"
 	"github.com/arterynetwork/artr/x/referral"
 	refTypes "github.com/arterynetwork/artr/x/referral/types"
+	"github.com/arterynetwork/artr/x/schedule"
+	schedTypes "github.com/arterynetwork/artr/x/schedule/types"
 	"github.com/arterynetwork/artr/x/storage"
+	"github.com/arterynetwork/artr/x/subscription"
+	subTypes "github.com/arterynetwork/artr/x/subscription/types"
+	"github.com/arterynetwork/artr/x/vpn"
 )
 
 func NopUpgradeHandler(_ sdk.Context, _ upgrade.Plan) {}
@@ -280,3 +296,310 @@ func InitializeNodingLottery(k noding.Keeper, paramspace params.Subspace) upgrad
 		logger.Debug("Finished InitializeNodingLottery", "params", pz)
 	}
 }
+
+func InitializeRateOracle(k subscription.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeRateOracle...")
+		var pz subscription.Params
+		for _, pair := range pz.ParamSetPairs() {
+			switch {
+			case bytes.Equal(pair.Key, subTypes.KeyRateVoteWindow):
+				pz.RateVoteWindow = subTypes.DefaultRateVoteWindow
+			case bytes.Equal(pair.Key, subTypes.KeyRateMinVotes):
+				pz.RateMinVotes = subTypes.DefaultRateMinVotes
+			case bytes.Equal(pair.Key, subTypes.KeyRateMaxDeviation):
+				pz.RateMaxDeviation = subTypes.DefaultRateMaxDeviation
+			default:
+				// MonthDuration isn't stored yet, it's initialized later in the chain
+				paramspace.GetIfExists(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeRateOracle", "params", pz)
+	}
+}
+
+// InitializeStorageParams sets up storage params, usage reports are signed by the same accounts as VPN traffic ones.
+func InitializeStorageParams(k storage.Keeper, vk vpn.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeStorageParams...")
+		pz := storage.NewParams(vk.GetParams(ctx).Signers, storage.DefaultParams().NearQuota)
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeStorageParams", "params", pz)
+	}
+}
+
+func InitializeScheduleRetries(k schedule.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeScheduleRetries...")
+		var pz schedule.Params
+		for _, pair := range pz.ParamSetPairs() {
+			if bytes.Equal(pair.Key, schedTypes.KeyMaxRetries) {
+				pz.MaxRetries = schedTypes.DefaultMaxRetries
+			} else {
+				// MaxTasksPerBlock isn't stored yet, it's initialized later in the chain
+				paramspace.GetIfExists(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeScheduleRetries", "params", pz)
+	}
+}
+
+// InitializeScheduleBudget limits the number of tasks performed per block (it can be changed by
+// a ProposalTypeMaxTasksPerBlock voting).
+func InitializeScheduleBudget(k schedule.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeScheduleBudget...")
+		var pz schedule.Params
+		for _, pair := range pz.ParamSetPairs() {
+			if bytes.Equal(pair.Key, schedTypes.KeyMaxTasksPerBlock) {
+				pz.MaxTasksPerBlock = schedTypes.DefaultMaxTasksPerBlock
+			} else {
+				paramspace.Get(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeScheduleBudget", "params", pz)
+	}
+}
+
+func IndexScheduledTasks(k schedule.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting IndexScheduledTasks...")
+		k.ReindexTasks(ctx)
+		logger.Debug("Finished IndexScheduledTasks", "next_task_id", k.GetNextTaskID(ctx))
+	}
+}
+
+// InitializeMonthDuration keeps subscription periods measured in blocks (wall-clock ones are switched on by
+// a ProposalTypeMonthDuration voting).
+func InitializeMonthDuration(k subscription.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeMonthDuration...")
+		var pz subscription.Params
+		for _, pair := range pz.ParamSetPairs() {
+			if bytes.Equal(pair.Key, subTypes.KeyMonthDuration) {
+				pz.MonthDuration = 0
+			} else {
+				paramspace.Get(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeMonthDuration", "params", pz)
+	}
+}
+
+// InitializeRevokePeriod keeps revoke periods measured in blocks (wall-clock ones are switched on by
+// a ProposalTypeRevokePeriod voting).
+func InitializeRevokePeriod(k delegating.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeRevokePeriod...")
+		var pz delegating.Params
+		for _, pair := range pz.ParamSetPairs() {
+			if bytes.Equal(pair.Key, dTypes.KeyRevokePeriod) {
+				pz.RevokePeriod = 0
+			} else {
+				paramspace.Get(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeRevokePeriod", "params", pz)
+	}
+}
+
+// PrefixEarners moves the pending earner list under a key prefix, so that the earning store can keep other data.
+func PrefixEarners(k earning.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting PrefixEarners...")
+		k.MigrateEarners(ctx)
+		logger.Debug("Finished PrefixEarners")
+	}
+}
+
+// InitializeEarningApprovals keeps a single signer's approval enough to run an earning period, with no challenge
+// window (M-of-N attestation is switched on by a ProposalTypeEarningApprovals voting).
+func InitializeEarningApprovals(k earning.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeEarningApprovals...")
+		var pz earning.Params
+		for _, pair := range pz.ParamSetPairs() {
+			if bytes.Equal(pair.Key, earningTypes.KeyMinApprovals) {
+				pz.MinApprovals = 0
+			} else if bytes.Equal(pair.Key, earningTypes.KeyChallengeWindow) {
+				pz.ChallengeWindow = 0
+			} else {
+				// SnapshotPeriod isn't stored yet, it's initialized later in the chain
+				paramspace.GetIfExists(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeEarningApprovals", "params", pz)
+	}
+}
+
+// InitializeSnapshotPeriod makes fund balances snapshotted every billing month and takes the first snapshot right
+// away, so the earning period following the upgrade has one to be paid from.
+func InitializeSnapshotPeriod(k earning.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeSnapshotPeriod...")
+		var pz earning.Params
+		for _, pair := range pz.ParamSetPairs() {
+			if bytes.Equal(pair.Key, earningTypes.KeySnapshotPeriod) {
+				pz.SnapshotPeriod = util.BlocksOneMonth
+			} else {
+				// ClaimWindow isn't stored yet, it's initialized later in the chain
+				paramspace.GetIfExists(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		snapshot := k.TakeSnapshot(ctx)
+		logger.Debug("Finished InitializeSnapshotPeriod", "params", pz, "snapshot", snapshot.ID)
+	}
+}
+
+// InitializeClaimWindow makes claim periods last for the default claim window (a month).
+func InitializeClaimWindow(k earning.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeClaimWindow...")
+		var pz earning.Params
+		for _, pair := range pz.ParamSetPairs() {
+			if bytes.Equal(pair.Key, earningTypes.KeyClaimWindow) {
+				pz.ClaimWindow = earningTypes.DefaultClaimWindow
+			} else {
+				paramspace.Get(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeClaimWindow", "params", pz)
+	}
+}
+
+// InitializeRecoveryDelay sets a default account recovery delay and request timeout (a week both).
+func InitializeRecoveryDelay(k profile.Keeper, paramspace params.Subspace) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeRecoveryDelay...")
+		var pz profile.Params
+		for _, pair := range pz.ParamSetPairs() {
+			if bytes.Equal(pair.Key, profileTypes.KeyRecoveryDelay) {
+				pz.RecoveryDelay = profileTypes.DefaultRecoveryDelay
+			} else if bytes.Equal(pair.Key, profileTypes.KeyRecoveryTimeout) {
+				pz.RecoveryTimeout = profileTypes.DefaultRecoveryTimeout
+			} else {
+				paramspace.Get(ctx, pair.Key, pair.Value)
+			}
+		}
+		k.SetParams(ctx, pz)
+		logger.Debug("Finished InitializeRecoveryDelay", "params", pz)
+	}
+}
+
+func InitializeTxFee(k bank.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeTxFee...")
+		schedule := util.DefaultFeeSchedule()
+		k.SetFeeSchedule(ctx, schedule)
+		logger.Debug("Finished InitializeTxFee", "schedule", schedule)
+	}
+}
+
+func InitializeFeeExemptions(k bank.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeFeeExemptions...")
+		k.SetFeeExemptions(ctx, bank.FeeExemptions{})
+		logger.Debug("Finished InitializeFeeExemptions")
+	}
+}
+
+func InitializeMinStandingOrderInterval(k bank.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Debug("Starting InitializeMinStandingOrderInterval...")
+		k.SetMinStandingOrderInterval(ctx, bank.DefaultMinStandingOrderInterval)
+		logger.Debug("Finished InitializeMinStandingOrderInterval", "interval", bank.DefaultMinStandingOrderInterval)
+	}
+}
+
+// StoreUpgrades lists KV stores introduced by an upgrade. Store upgrades of cosmos-sdk 0.39 can only rename and
+// delete stores, so added ones are handled by UpgradeStoreLoader.
+type StoreUpgrades struct {
+	Added []string
+}
+
+// PlannedStoreUpgrades lists store upgrades by the upgrade plan name
+var PlannedStoreUpgrades = map[string]StoreUpgrades{
+	"1.4.0": {Added: []string{escrow.StoreKey, bank.StoreKey}},
+}
+
+// UpgradeStoreLoader returns a store loader applying store upgrades of the pending upgrade plan, if the node is about
+// to run it (i.e. the plan height is the next one). A store mounted on a running chain has no committed version, so it
+// would be loaded from scratch and lag behind the other stores forever (and no query at a committed height could be
+// served). So an empty tree is saved for it at the last committed height first, and the multistore is reloaded.
+func UpgradeStoreLoader(db dbm.DB, keys map[string]*sdk.KVStoreKey, uk upgrade.Keeper, upgrades map[string]StoreUpgrades) bam.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if err := ms.LoadLatestVersion(); err != nil {
+			return err
+		}
+		version := ms.LastCommitID().Version
+		if version == 0 {
+			return nil
+		}
+
+		plan, ok := uk.GetUpgradePlan(sdk.NewContext(ms.CacheMultiStore(), abci.Header{}, false, log.NewNopLogger()))
+		if !ok || plan.Height != version+1 {
+			return nil
+		}
+		storeUpgrades, ok := upgrades[plan.Name]
+		if !ok {
+			return nil
+		}
+
+		reload := false
+		for _, name := range storeUpgrades.Added {
+			key, ok := keys[name]
+			if !ok {
+				return fmt.Errorf("upgrade %s: store %s is not mounted", plan.Name, name)
+			}
+			if ms.GetCommitKVStore(key).LastCommitID().Version != 0 {
+				continue
+			}
+			if err := initAddedStore(db, name, version); err != nil {
+				return fmt.Errorf("upgrade %s: cannot initialize store %s: %w", plan.Name, name, err)
+			}
+			reload = true
+		}
+		if reload {
+			return ms.LoadLatestVersion()
+		}
+		return nil
+	}
+}
+
+// initAddedStore saves an empty IAVL tree of the version to the store's database (the same prefix the root multistore
+// uses)
+func initAddedStore(db dbm.DB, name string, version int64) error {
+	tree, err := iavl.NewMutableTree(dbm.NewPrefixDB(db, []byte("s/k:"+name+"/")), 0)
+	if err != nil {
+		return err
+	}
+	importer, err := tree.Import(version)
+	if err != nil {
+		return err
+	}
+	defer importer.Close()
+	return importer.Commit()
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	db := dbm.NewMemDB()
+	oldKey, newKey, upgradeKey := sdk.NewKVStoreKey("old"), sdk.NewKVStoreKey("new"), sdk.NewKVStoreKey(upgrade.StoreKey)
+	uk := upgrade.NewKeeper(nil, upgradeKey, codec.New())
+
+	ms := rootmulti.NewStore(db)
+	ms.MountStoreWithDB(oldKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 4; i++ {
+		ms.GetKVStore(oldKey).Set([]byte{byte(i)}, []byte{0x01})
+		ms.Commit()
+	}
+	ctx := sdk.NewContext(ms, abci.Header{Height: 5}, false, log.NewNopLogger())
+	require.NoError(t, uk.ScheduleUpgrade(ctx, upgrade.Plan{Name: "test", Height: 7}))
+	ms.Commit()
+
+	keys := map[string]*sdk.KVStoreKey{"old": oldKey, "new": newKey, upgrade.StoreKey: upgradeKey}
+	loader := UpgradeStoreLoader(db, keys, uk, map[string]StoreUpgrades{"test": {Added: []string{"new"}}})
+	mount := func() *rootmulti.Store {
+		ms := rootmulti.NewStore(db)
+		ms.MountStoreWithDB(oldKey, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(newKey, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+		return ms
+	}
+
+	// The plan isn't due at the next height yet
+	ms = mount()
+	require.NoError(t, loader(ms))
+	require.Equal(t, int64(0), ms.GetCommitKVStore(newKey).LastCommitID().Version)
+
+	// The old binary commits one more block
+	ms = rootmulti.NewStore(db)
+	ms.MountStoreWithDB(oldKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+
+	ms = mount()
+	require.NoError(t, loader(ms))
+	require.Equal(t, int64(6), ms.GetCommitKVStore(newKey).LastCommitID().Version)
+
+	ms.GetKVStore(newKey).Set([]byte{0x01}, []byte{0x01})
+	id := ms.Commit()
+	require.Equal(t, int64(7), id.Version)
+	require.Equal(t, int64(7), ms.GetCommitKVStore(newKey).LastCommitID().Version)
+	_, err := ms.CacheMultiStoreWithVersion(7)
+	require.NoError(t, err)
+
+	// Already initialized stores are left as they are
+	ms = mount()
+	require.NoError(t, loader(ms))
+	require.Equal(t, []byte{0x01}, ms.GetKVStore(newKey).Get([]byte{0x01}))
+}
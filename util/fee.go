@@ -1,24 +1,159 @@
 package util
 
 import (
+	"fmt"
+	"math/big"
+	"strings"
+
 	"github.com/tendermint/tendermint/libs/log"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
-func CalculateFee(amount sdk.Int) sdk.Int {
-	fee := amount.MulRaw(3).QuoRaw(1000)
+// FeeRule defines a transaction fee as a share of the amount, but not less than Min and not more than Max
+// (zero means no limit). A fee never exceeds the amount itself.
+type FeeRule struct {
+	Rate Fraction `json:"rate" yaml:"rate"`
+	Min  int64    `json:"min,omitempty" yaml:"min,omitempty"`
+	Max  int64    `json:"max,omitempty" yaml:"max,omitempty"`
+}
 
-	if fee.GT(sdk.NewInt(10000000)) {
-		fee = sdk.NewInt(10000000)
+func NewFeeRule(rate Fraction, min, max int64) FeeRule {
+	return FeeRule{
+		Rate: rate,
+		Min:  min,
+		Max:  max,
+	}
+}
+
+func (r FeeRule) Calculate(amount sdk.Int) sdk.Int {
+	if !amount.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	// The rate is within [0; 1], so the fee is never greater than the amount and cannot overflow
+	fee := sdk.NewIntFromBigInt((&big.Int{}).Quo(
+		(&big.Int{}).Mul(amount.BigInt(), r.Rate.num),
+		r.Rate.denom,
+	))
+
+	if r.Min != 0 && fee.LT(sdk.NewInt(r.Min)) {
+		fee = sdk.NewInt(r.Min)
+	}
+	if r.Max != 0 && fee.GT(sdk.NewInt(r.Max)) {
+		fee = sdk.NewInt(r.Max)
+	}
+	if fee.GT(amount) {
+		fee = amount
 	}
 
 	return fee
 }
 
-func CalculateFeeString(coins sdk.Coins) string {
-	fee := CalculateFee(coins.AmountOf(ConfigMainDenom))
+func (r FeeRule) Validate() error {
+	if r.Rate.IsNullValue() {
+		return fmt.Errorf("rate is missing")
+	}
+	if r.Rate.IsNegative() || r.Rate.GT(FractionInt(1)) {
+		return fmt.Errorf("rate must be in [0; 1], got %s", r.Rate)
+	}
+	if r.Min < 0 {
+		return fmt.Errorf("min must be non-negative")
+	}
+	if r.Max < 0 {
+		return fmt.Errorf("max must be non-negative")
+	}
+	if r.Max != 0 && r.Min > r.Max {
+		return fmt.Errorf("min (%d) is greater than max (%d)", r.Min, r.Max)
+	}
+	return nil
+}
+
+func (r FeeRule) String() string {
+	return fmt.Sprintf("Rate: %s\nMin: %d\nMax: %d", r.Rate, r.Min, r.Max)
+}
+
+// FeeOverride is a fee rule for a message type (as returned by sdk.Msg.Type(), e.g. "send")
+type FeeOverride struct {
+	MsgType string  `json:"msg_type" yaml:"msg_type"`
+	Rule    FeeRule `json:"rule" yaml:"rule"`
+}
+
+// FeeSchedule is a default fee rule along with per-message-type overrides
+type FeeSchedule struct {
+	Default   FeeRule       `json:"default" yaml:"default"`
+	Overrides []FeeOverride `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+}
+
+func NewFeeSchedule(defaultRule FeeRule, overrides []FeeOverride) FeeSchedule {
+	return FeeSchedule{
+		Default:   defaultRule,
+		Overrides: overrides,
+	}
+}
+
+// DefaultFeeSchedule is 0.3%, but no more than 10 ARTR, for all message types
+func DefaultFeeSchedule() FeeSchedule {
+	return NewFeeSchedule(NewFeeRule(Permille(3), 0, 10_000000), nil)
+}
+
+// Rule returns a fee rule for the message type
+func (s FeeSchedule) Rule(msgType string) FeeRule {
+	for _, o := range s.Overrides {
+		if o.MsgType == msgType {
+			return o.Rule
+		}
+	}
+	return s.Default
+}
+
+func (s FeeSchedule) Validate() error {
+	if err := s.Default.Validate(); err != nil {
+		return fmt.Errorf("invalid default rule: %w", err)
+	}
+	seen := make(map[string]bool, len(s.Overrides))
+	for _, o := range s.Overrides {
+		if o.MsgType == "" {
+			return fmt.Errorf("override message type is missing")
+		}
+		if seen[o.MsgType] {
+			return fmt.Errorf("duplicate override for %s", o.MsgType)
+		}
+		seen[o.MsgType] = true
+		if err := o.Rule.Validate(); err != nil {
+			return fmt.Errorf("invalid rule for %s: %w", o.MsgType, err)
+		}
+	}
+	return nil
+}
+
+func (s FeeSchedule) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("Default:\n%s", s.Default))
+	for _, o := range s.Overrides {
+		sb.WriteString(fmt.Sprintf("\n%s:\n%s", o.MsgType, o.Rule))
+	}
+	return sb.String()
+}
+
+// FeeKeeper provides the live fee schedule, fee exemptions and fee grants
+type FeeKeeper interface {
+	GetFeeSchedule(ctx sdk.Context) FeeSchedule
+	// IsFeeExempt checks if transfers from the sender to the recipient are free of charge
+	IsFeeExempt(ctx sdk.Context, sender, recipient sdk.AccAddress) bool
+	// FindFeeGrant finds a grant covering the grantee's fee. It returns the granter, or nil if there's no suitable grant.
+	FindFeeGrant(ctx sdk.Context, grantee sdk.AccAddress, fee sdk.Int) sdk.AccAddress
+	// UseFeeGrant charges the fee paid by the granter against the grant.
+	UseFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Int)
+}
+
+func CalculateFee(schedule FeeSchedule, msgType string, amount sdk.Int) sdk.Int {
+	return schedule.Rule(msgType).Calculate(amount)
+}
+
+func CalculateFeeString(schedule FeeSchedule, msgType string, coins sdk.Coins) string {
+	fee := CalculateFee(schedule, msgType, coins.AmountOf(ConfigMainDenom))
 	return fee.String() + ConfigMainDenom
 }
 
@@ -26,22 +161,38 @@ type SupplyKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
-func PayTxFee(ctx sdk.Context, k SupplyKeeper, logger log.Logger, acc sdk.AccAddress, amount sdk.Int) (fee sdk.Int, err error) {
-	fee = CalculateFee(amount)
+// PayTxFee charges a fee for the amount sent by acc. The recipient is optional (nil for payments to modules and
+// transfers to many accounts), it's used to check fee exemptions. If someone has granted acc a fee allowance,
+// the fee is paid by the granter.
+func PayTxFee(ctx sdk.Context, fk FeeKeeper, k SupplyKeeper, logger log.Logger, acc, recipient sdk.AccAddress, amount sdk.Int, msgType string) (fee sdk.Int, err error) {
+	if recipient != nil && fk.IsFeeExempt(ctx, acc, recipient) {
+		return sdk.ZeroInt(), nil
+	}
+
+	fee = CalculateFee(fk.GetFeeSchedule(ctx), msgType, amount)
 	if !fee.IsZero() {
+		payer := acc
+		granter := fk.FindFeeGrant(ctx, acc, fee)
+		if granter != nil {
+			payer = granter
+		}
 		if err = k.SendCoinsFromAccountToModule(
-			ctx, acc, auth.FeeCollectorName,
+			ctx, payer, auth.FeeCollectorName,
 			sdk.NewCoins(sdk.NewCoin(ConfigMainDenom, fee)),
 		); err != nil {
 			logger.Error(
 				"cannot collect fee",
 				"accAddress", acc,
+				"payer", payer,
 				"amount", amount,
 				"fee", fee,
 				"error", err,
 			)
 			return sdk.ZeroInt(), err
 		}
+		if granter != nil {
+			fk.UseFeeGrant(ctx, granter, acc, fee)
+		}
 	}
 	return fee, err
 }
@@ -0,0 +1,20 @@
+package util
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MoveKeys re-keys all the store entries having keys starting with `from`, so that they start with `to` instead.
+// It's used to move per-account data when an account is migrated to a new address.
+func MoveKeys(store sdk.KVStore, from, to []byte) {
+	var keys, values [][]byte
+	it := sdk.KVStorePrefixIterator(store, from)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	it.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		store.Set(append(append([]byte(nil), to...), key[len(from):]...), values[i])
+	}
+}
@@ -8,57 +8,123 @@ import (
 )
 
 const (
-	QueryBalance       = keeper.QueryBalance
-	QueryParams        = keeper.QueryParams
-	ModuleName         = types.ModuleName
-	QuerierRoute       = types.QuerierRoute
-	RouterKey          = types.RouterKey
-	DefaultParamspace  = types.DefaultParamspace
-	DefaultSendEnabled = types.DefaultSendEnabled
+	QueryBalance          = keeper.QueryBalance
+	QueryParams           = keeper.QueryParams
+	QueryStandingOrders   = keeper.QueryStandingOrders
+	QueryFee              = keeper.QueryFee
+	QueryFeeGrants        = keeper.QueryFeeGrants
+	QueryTransferSettings = keeper.QueryTransferSettings
+	ModuleName            = types.ModuleName
+	QuerierRoute          = types.QuerierRoute
+	StoreKey              = types.StoreKey
+	RouterKey             = types.RouterKey
+	DefaultParamspace     = types.DefaultParamspace
+	DefaultSendEnabled    = types.DefaultSendEnabled
+	StandingOrderHookName = types.StandingOrderHookName
+	StandingOrderFeeType  = types.StandingOrderFeeType
+
+	DefaultMinStandingOrderInterval = types.DefaultMinStandingOrderInterval
 
 	EventTypeTransfer      = types.EventTypeTransfer
 	AttributeKeyRecipient  = types.AttributeKeyRecipient
 	AttributeKeySender     = types.AttributeKeySender
 	AttributeValueCategory = types.AttributeValueCategory
+
+	EventTypeStandingOrderCreated  = types.EventTypeStandingOrderCreated
+	EventTypeStandingOrderCanceled = types.EventTypeStandingOrderCanceled
+	EventTypeStandingOrderExecuted = types.EventTypeStandingOrderExecuted
+	EventTypeStandingOrderFailed   = types.EventTypeStandingOrderFailed
+	AttributeKeyStandingOrder      = types.AttributeKeyStandingOrder
+	AttributeKeyError              = types.AttributeKeyError
+	EventTypeFeeGranted            = types.EventTypeFeeGranted
+	EventTypeFeeGrantRevoked       = types.EventTypeFeeGrantRevoked
+	EventTypeFeeGrantUsed          = types.EventTypeFeeGrantUsed
+	AttributeKeyGranter            = types.AttributeKeyGranter
+	AttributeKeyGrantee            = types.AttributeKeyGrantee
+	AttributeKeyFee                = types.AttributeKeyFee
+	EventTypeTransferSettings      = types.EventTypeTransferSettings
+	AttributeKeyAddress            = types.AttributeKeyAddress
+	AttributeKeyMemoRequired       = types.AttributeKeyMemoRequired
+	AttributeKeyRecvDisabled       = types.AttributeKeyRecvDisabled
 )
 
 var (
-	RegisterInvariants          = keeper.RegisterInvariants
-	NonnegativeBalanceInvariant = keeper.NonnegativeBalanceInvariant
-	NewBaseKeeper               = keeper.NewBaseKeeper
-	NewBaseSendKeeper           = keeper.NewBaseSendKeeper
-	NewBaseViewKeeper           = keeper.NewBaseViewKeeper
-	NewQuerier                  = keeper.NewQuerier
-	RegisterCodec               = types.RegisterCodec
-	ErrNoInputs                 = types.ErrNoInputs
-	ErrNoOutputs                = types.ErrNoOutputs
-	ErrInputOutputMismatch      = types.ErrInputOutputMismatch
-	ErrSendDisabled             = types.ErrSendDisabled
-	NewGenesisState             = types.NewGenesisState
-	DefaultGenesisState         = types.DefaultGenesisState
-	ValidateGenesis             = types.ValidateGenesis
-	NewMsgSend                  = types.NewMsgSend
-	NewMsgMultiSend             = types.NewMsgMultiSend
-	NewInput                    = types.NewInput
-	NewOutput                   = types.NewOutput
-	ValidateInputsOutputs       = types.ValidateInputsOutputs
-	ParamKeyTable               = types.ParamKeyTable
-	NewQueryBalanceParams       = types.NewQueryBalanceParams
-	ModuleCdc                   = types.ModuleCdc
-	ParamStoreKeySendEnabled    = types.ParamStoreKeySendEnabled
+	RegisterInvariants             = keeper.RegisterInvariants
+	NonnegativeBalanceInvariant    = keeper.NonnegativeBalanceInvariant
+	NewBaseKeeper                  = keeper.NewBaseKeeper
+	NewBaseSendKeeper              = keeper.NewBaseSendKeeper
+	NewBaseViewKeeper              = keeper.NewBaseViewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	RegisterCodec                  = types.RegisterCodec
+	ErrNoInputs                    = types.ErrNoInputs
+	ErrNoOutputs                   = types.ErrNoOutputs
+	ErrInputOutputMismatch         = types.ErrInputOutputMismatch
+	ErrSendDisabled                = types.ErrSendDisabled
+	ErrNoStandingOrder             = types.ErrNoStandingOrder
+	ErrNoFeeGrant                  = types.ErrNoFeeGrant
+	ErrReceiveDisabled             = types.ErrReceiveDisabled
+	ErrMemoRequired                = types.ErrMemoRequired
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	ValidateGenesis                = types.ValidateGenesis
+	NewMsgSend                     = types.NewMsgSend
+	NewMsgMultiSend                = types.NewMsgMultiSend
+	NewMsgCreateStandingOrder      = types.NewMsgCreateStandingOrder
+	NewMsgCancelStandingOrder      = types.NewMsgCancelStandingOrder
+	NewStandingOrder               = types.NewStandingOrder
+	StandingOrderFromBytes         = types.StandingOrderFromBytes
+	NewQueryStandingOrdersParams   = types.NewQueryStandingOrdersParams
+	NewInput                       = types.NewInput
+	NewOutput                      = types.NewOutput
+	ValidateInputsOutputs          = types.ValidateInputsOutputs
+	ParamKeyTable                  = types.ParamKeyTable
+	NewQueryBalanceParams          = types.NewQueryBalanceParams
+	NewQueryFeeParams              = types.NewQueryFeeParams
+	NewMsgGrantFee                 = types.NewMsgGrantFee
+	NewMsgRevokeFeeGrant           = types.NewMsgRevokeFeeGrant
+	NewFeeGrant                    = types.NewFeeGrant
+	NewFeeExemption                = types.NewFeeExemption
+	NewQueryFeeGrantsParams        = types.NewQueryFeeGrantsParams
+	NewMsgSetTransferSettings      = types.NewMsgSetTransferSettings
+	NewTransferSettings            = types.NewTransferSettings
+	NewQueryTransferSettingsParams = types.NewQueryTransferSettingsParams
+	ModuleCdc                      = types.ModuleCdc
+	ParamStoreKeySendEnabled       = types.ParamStoreKeySendEnabled
+	ParamStoreKeyTxFee             = types.ParamStoreKeyTxFee
+	ParamStoreKeyFeeExemptions     = types.ParamStoreKeyFeeExemptions
+
+	ParamStoreKeyMinStandingOrderInterval = types.ParamStoreKeyMinStandingOrderInterval
 )
 
 type (
-	Keeper             = keeper.Keeper
-	BaseKeeper         = keeper.BaseKeeper
-	SendKeeper         = keeper.SendKeeper
-	BaseSendKeeper     = keeper.BaseSendKeeper
-	ViewKeeper         = keeper.ViewKeeper
-	BaseViewKeeper     = keeper.BaseViewKeeper
-	GenesisState       = types.GenesisState
-	MsgSend            = types.MsgSend
-	MsgMultiSend       = types.MsgMultiSend
-	Input              = types.Input
-	Output             = types.Output
-	QueryBalanceParams = types.QueryBalanceParams
+	Keeper                      = keeper.Keeper
+	BaseKeeper                  = keeper.BaseKeeper
+	SendKeeper                  = keeper.SendKeeper
+	BaseSendKeeper              = keeper.BaseSendKeeper
+	ViewKeeper                  = keeper.ViewKeeper
+	BaseViewKeeper              = keeper.BaseViewKeeper
+	GenesisState                = types.GenesisState
+	MsgSend                     = types.MsgSend
+	MsgMultiSend                = types.MsgMultiSend
+	Input                       = types.Input
+	Output                      = types.Output
+	QueryBalanceParams          = types.QueryBalanceParams
+	MsgCreateStandingOrder      = types.MsgCreateStandingOrder
+	MsgCancelStandingOrder      = types.MsgCancelStandingOrder
+	StandingOrder               = types.StandingOrder
+	StandingOrderInfo           = types.StandingOrderInfo
+	QueryStandingOrdersParams   = types.QueryStandingOrdersParams
+	QueryResStandingOrders      = types.QueryResStandingOrders
+	QueryFeeParams              = types.QueryFeeParams
+	QueryResFee                 = types.QueryResFee
+	MsgGrantFee                 = types.MsgGrantFee
+	MsgRevokeFeeGrant           = types.MsgRevokeFeeGrant
+	FeeGrant                    = types.FeeGrant
+	FeeGrants                   = types.FeeGrants
+	FeeExemption                = types.FeeExemption
+	FeeExemptions               = types.FeeExemptions
+	QueryFeeGrantsParams        = types.QueryFeeGrantsParams
+	MsgSetTransferSettings      = types.MsgSetTransferSettings
+	TransferSettings            = types.TransferSettings
+	QueryTransferSettingsParams = types.QueryTransferSettingsParams
 )
@@ -0,0 +1,59 @@
+package bank
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/arterynetwork/artr/x/bank/internal/keeper"
+	"github.com/arterynetwork/artr/x/bank/internal/types"
+)
+
+// MemoRequiredDecorator rejects a tx without a memo if it transfers coins to an account requiring one.
+// A memo is checked for MsgSend, MsgMultiSend and MsgCreateStandingOrder.
+type MemoRequiredDecorator struct {
+	k keeper.Keeper
+}
+
+func NewMemoRequiredDecorator(k keeper.Keeper) MemoRequiredDecorator {
+	return MemoRequiredDecorator{k: k}
+}
+
+func (d MemoRequiredDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	memoTx, ok := tx.(ante.TxWithMemo)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	if strings.TrimSpace(memoTx.GetMemo()) != "" {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		for _, addr := range transferRecipients(msg) {
+			if d.k.GetTransferSettings(ctx, addr).MemoRequired {
+				return ctx, sdkerrors.Wrap(types.ErrMemoRequired, addr.String())
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func transferRecipients(msg sdk.Msg) []sdk.AccAddress {
+	switch msg := msg.(type) {
+	case types.MsgSend:
+		return []sdk.AccAddress{msg.ToAddress}
+	case types.MsgMultiSend:
+		result := make([]sdk.AccAddress, len(msg.Outputs))
+		for i, out := range msg.Outputs {
+			result[i] = out.Address
+		}
+		return result
+	case types.MsgCreateStandingOrder:
+		return []sdk.AccAddress{msg.Recipient}
+	default:
+		return nil
+	}
+}
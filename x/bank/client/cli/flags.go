@@ -0,0 +1,11 @@
+package cli
+
+const (
+	FlagStart = "start"
+	FlagEnd   = "end"
+
+	FlagExpiration = "expiration"
+
+	FlagMemoRequired    = "memo-required"
+	FlagReceiveDisabled = "receive-disabled"
+)
@@ -10,9 +10,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/arterynetwork/artr/x/bank/internal/keeper"
 	"github.com/arterynetwork/artr/x/bank/internal/types"
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -29,6 +31,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	bankQueryCmd.AddCommand(
 		flags.GetCommands(
 			getParamsCmd(queryRoute, cdc),
+			getStandingOrdersCmd(queryRoute, cdc),
+			getFeeCmd(queryRoute, cdc),
+			getFeeGrantsCmd(queryRoute, cdc),
+			getTransferSettingsCmd(queryRoute, cdc),
 		)...,
 	)
 
@@ -62,3 +68,158 @@ func getParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getStandingOrdersCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "standing-orders <address>",
+		Aliases: []string{"so"},
+		Short:   "Get standing orders of an account",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryStandingOrdersParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(strings.Join(
+				[]string{
+					"custom",
+					queryRoute,
+					keeper.QueryStandingOrders,
+				}, "/",
+			), bz)
+			if err != nil {
+				fmt.Println("could not get standing orders:", err)
+				return err
+			}
+
+			var out types.QueryResStandingOrders
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getFeeCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "fee <amount> [msg type]",
+		Example: "artrcli query artrbank fee 1000000uartr\nartrcli query artrbank fee 1000000uartr pay_subscription",
+		Short:   "Estimate a transaction fee for the amount using the current fee schedule (msg type defaults to \"send\")",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+			msgType := types.MsgSend{}.Type()
+			if len(args) > 1 {
+				msgType = args[1]
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeParams(amount, msgType))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(strings.Join(
+				[]string{
+					"custom",
+					queryRoute,
+					keeper.QueryFee,
+				}, "/",
+			), bz)
+			if err != nil {
+				fmt.Println("could not estimate the fee:", err)
+				return err
+			}
+
+			var out types.QueryResFee
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getFeeGrantsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "fee-grants <address>",
+		Aliases: []string{"fg"},
+		Short:   "Get fee grants an account is the granter or the grantee of",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeGrantsParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(strings.Join(
+				[]string{
+					"custom",
+					queryRoute,
+					keeper.QueryFeeGrants,
+				}, "/",
+			), bz)
+			if err != nil {
+				fmt.Println("could not get fee grants:", err)
+				return err
+			}
+
+			var out types.QueryResFeeGrants
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getTransferSettingsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-settings <address>",
+		Short: "Get an account's incoming transfer settings",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTransferSettingsParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(strings.Join(
+				[]string{
+					"custom",
+					queryRoute,
+					keeper.QueryTransferSettings,
+				}, "/",
+			), bz)
+			if err != nil {
+				fmt.Println("could not get transfer settings:", err)
+				return err
+			}
+
+			var out types.TransferSettings
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
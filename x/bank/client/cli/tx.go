@@ -2,10 +2,13 @@ package cli
 
 import (
 	"bufio"
+	"strconv"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/arterynetwork/artr/x/bank/internal/types"
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +29,11 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		SendTxCmd(cdc),
+		CreateStandingOrderTxCmd(cdc),
+		CancelStandingOrderTxCmd(cdc),
+		GrantFeeTxCmd(cdc),
+		RevokeFeeGrantTxCmd(cdc),
+		SetTransferSettingsTxCmd(cdc),
 	)
 	return txCmd
 }
@@ -41,7 +49,7 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
 
-			to, err := sdk.AccAddressFromBech32(args[1])
+			to, err := resolver.ResolveAddressCLI(cliCtx, args[1])
 			if err != nil {
 				return err
 			}
@@ -62,3 +70,174 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// CreateStandingOrderTxCmd will create a standing order tx and sign it with the given key.
+func CreateStandingOrderTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "standing-order <from_key_or_address> <to_address> <amount> <interval>",
+		Short: "Create and sign a tx registering a transfer repeated every <interval> blocks",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			to, err := resolver.ResolveAddressCLI(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateStandingOrder(
+				cliCtx.GetFromAddress(), to, coins,
+				viper.GetUint64(FlagStart), interval, viper.GetUint64(FlagEnd),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	cmd.Flags().Uint64(FlagStart, 0, "Block height of the first transfer (one interval from now, if not set)")
+	cmd.Flags().Uint64(FlagEnd, 0, "Last block height a transfer can be made at (never ends, if not set)")
+
+	return cmd
+}
+
+// CancelStandingOrderTxCmd will create a standing order cancellation tx and sign it with the given key.
+func CancelStandingOrderTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-standing-order <from_key_or_address> <id>",
+		Short: "Create and sign a tx canceling a standing order",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelStandingOrder(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// GrantFeeTxCmd will create a fee grant tx and sign it with the given key.
+func GrantFeeTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee <from_key_or_address> <grantee> <spend_limit>",
+		Short: "Create and sign a tx allowing the grantee's transaction fees to be paid from your account",
+		Long: "Create and sign a tx allowing the grantee's transaction fees to be paid from your account, " +
+			"up to <spend_limit> in total. A new grant replaces the previous one to the same grantee.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			grantee, err := resolver.ResolveAddressCLI(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			limit, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantFee(cliCtx.GetFromAddress(), grantee, limit, viper.GetInt64(FlagExpiration))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	cmd.Flags().Int64(FlagExpiration, 0, "Block height the grant expires at (never expires, if not set)")
+
+	return cmd
+}
+
+// RevokeFeeGrantTxCmd will create a fee grant revocation tx and sign it with the given key.
+func RevokeFeeGrantTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-fee-grant <from_key_or_address> <grantee>",
+		Short: "Create and sign a tx revoking a fee grant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			grantee, err := resolver.ResolveAddressCLI(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeGrant(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// SetTransferSettingsTxCmd will create a transfer settings tx and sign it with the given key.
+func SetTransferSettingsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-settings <from_key_or_address>",
+		Short: "Create and sign a tx changing how your account accepts incoming transfers",
+		Long: "Create and sign a tx changing how your account accepts incoming transfers. " +
+			"Settings not passed as flags are reset to their defaults.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			msg := types.NewMsgSetTransferSettings(
+				cliCtx.GetFromAddress(),
+				viper.GetBool(FlagMemoRequired),
+				viper.GetBool(FlagReceiveDisabled),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	cmd.Flags().Bool(FlagMemoRequired, false, "Reject incoming transfers without a memo")
+	cmd.Flags().Bool(FlagReceiveDisabled, false, "Reject all incoming transfers")
+
+	return cmd
+}
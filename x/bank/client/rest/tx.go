@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 
 	"github.com/arterynetwork/artr/x/bank/internal/types"
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -29,11 +30,8 @@ type SendReq struct {
 func SendRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		bech32Addr := vars["address"]
-
-		toAddr, err := sdk.AccAddressFromBech32(bech32Addr)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		toAddr, ok := resolver.ResolveAddressREST(w, cliCtx, vars["address"])
+		if !ok {
 			return
 		}
 
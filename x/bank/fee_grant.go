@@ -0,0 +1,55 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/x/bank/internal/keeper"
+	"github.com/arterynetwork/artr/x/bank/internal/types"
+)
+
+// Handle MsgGrantFee.
+func handleMsgGrantFee(ctx sdk.Context, k keeper.Keeper, msg types.MsgGrantFee) (*sdk.Result, error) {
+	grant := types.NewFeeGrant(msg.Granter, msg.Grantee, msg.SpendLimit, msg.Expiration)
+	if grant.IsExpired(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiration %d is in the past", grant.Expiration)
+	}
+	k.SetFeeGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeeGranted,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.SpendLimit.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle MsgRevokeFeeGrant.
+func handleMsgRevokeFeeGrant(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevokeFeeGrant) (*sdk.Result, error) {
+	if _, found := k.GetFeeGrant(ctx, msg.Granter, msg.Grantee); !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoFeeGrant, "%s -> %s", msg.Granter, msg.Grantee)
+	}
+	k.DeleteFeeGrant(ctx, msg.Granter, msg.Grantee)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeeGrantRevoked,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -9,9 +9,36 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	ctx.Logger().With("module", "x/"+ModuleName).Info("Starting from genesis...")
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
 	keeper.SetMinSend(ctx, data.MinSend)
+	keeper.SetFeeSchedule(ctx, data.TxFee)
+	keeper.SetFeeExemptions(ctx, data.FeeExemptions)
+	keeper.SetMinStandingOrderInterval(ctx, data.MinStandingOrderInterval)
+	for _, grant := range data.FeeGrants {
+		keeper.SetFeeGrant(ctx, grant)
+	}
+	for _, settings := range data.TransferSettings {
+		keeper.SetTransferSettings(ctx, settings)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx), keeper.GetMinSend(ctx))
+	var grants FeeGrants
+	keeper.IterateFeeGrants(ctx, func(grant FeeGrant) (stop bool) {
+		grants = append(grants, grant)
+		return false
+	})
+	var settings []TransferSettings
+	keeper.IterateTransferSettings(ctx, func(s TransferSettings) (stop bool) {
+		settings = append(settings, s)
+		return false
+	})
+	return NewGenesisState(
+		keeper.GetSendEnabled(ctx),
+		keeper.GetMinSend(ctx),
+		keeper.GetFeeSchedule(ctx),
+		keeper.GetFeeExemptions(ctx),
+		keeper.GetMinStandingOrderInterval(ctx),
+		grants,
+		settings,
+	)
 }
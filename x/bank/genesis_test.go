@@ -0,0 +1,81 @@
+// +build testing
+
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/app"
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/bank"
+)
+
+func TestBankGenesis(t *testing.T) {
+	suite.Run(t, new(GenesisSuite))
+}
+
+type GenesisSuite struct {
+	suite.Suite
+
+	app     *app.ArteryApp
+	cleanup func()
+	ctx     sdk.Context
+	k       bank.Keeper
+}
+
+func (s *GenesisSuite) SetupTest() {
+	s.app, s.cleanup = app.NewAppFromGenesis(nil)
+	s.ctx = s.app.NewContext(true, abci.Header{Height: 1})
+	s.k = s.app.GetBankKeeper()
+}
+
+func (s *GenesisSuite) TearDownTest() {
+	s.cleanup()
+}
+
+func (s GenesisSuite) TestCleanGenesis() {
+	s.checkExportImport()
+}
+
+func (s GenesisSuite) TestFullData() {
+	s.NoError(s.k.AddFeeExemption(s.ctx, bank.NewFeeExemption(app.DefaultGenesisUsers["user1"], app.DefaultGenesisUsers["user2"])))
+	s.k.SetFeeGrant(s.ctx, bank.NewFeeGrant(
+		app.DefaultGenesisUsers["user1"],
+		app.DefaultGenesisUsers["user2"],
+		util.Uartrs(1_000000),
+		0,
+	))
+	s.k.SetFeeGrant(s.ctx, bank.NewFeeGrant(
+		app.DefaultGenesisUsers["user3"],
+		app.DefaultGenesisUsers["user2"],
+		util.Uartrs(2_000000),
+		100,
+	))
+	s.k.SetTransferSettings(s.ctx, bank.NewTransferSettings(app.DefaultGenesisUsers["user4"], true, false))
+	s.k.SetTransferSettings(s.ctx, bank.NewTransferSettings(app.DefaultGenesisUsers["user5"], false, true))
+	s.checkExportImport()
+}
+
+func (s GenesisSuite) checkExportImport() {
+	s.app.CheckExportImport(s.T(),
+		[]string{
+			bank.StoreKey,
+			"params",
+		},
+		map[string]app.Decoder{
+			bank.StoreKey: app.DummyDecoder,
+			"params":      app.DummyDecoder,
+		},
+		map[string]app.Decoder{
+			bank.StoreKey: app.DummyDecoder,
+			"params":      app.DummyDecoder,
+		},
+		make(map[string][][]byte, 0),
+	)
+}
@@ -2,6 +2,7 @@ package bank
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -15,7 +16,7 @@ import (
 )
 
 // NewHandler returns a handler for "bank" type messages.
-func NewHandler(k keeper.Keeper, sk types.SupplyKeeper, ak types.AccountKeeper) sdk.Handler {
+func NewHandler(k keeper.Keeper, sk types.SupplyKeeper, ak types.AccountKeeper, schk types.ScheduleKeeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
@@ -26,6 +27,21 @@ func NewHandler(k keeper.Keeper, sk types.SupplyKeeper, ak types.AccountKeeper)
 		case types.MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, sk, msg)
 
+		case types.MsgCreateStandingOrder:
+			return handleMsgCreateStandingOrder(ctx, k, ak, schk, msg)
+
+		case types.MsgCancelStandingOrder:
+			return handleMsgCancelStandingOrder(ctx, schk, msg)
+
+		case types.MsgGrantFee:
+			return handleMsgGrantFee(ctx, k, msg)
+
+		case types.MsgRevokeFeeGrant:
+			return handleMsgRevokeFeeGrant(ctx, k, msg)
+
+		case types.MsgSetTransferSettings:
+			return handleMsgSetTransferSettings(ctx, k, msg)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bank message type: %T", msg)
 		}
@@ -34,32 +50,12 @@ func NewHandler(k keeper.Keeper, sk types.SupplyKeeper, ak types.AccountKeeper)
 
 // Handle MsgSend.
 func handleMsgSend(ctx sdk.Context, k keeper.Keeper, sk types.SupplyKeeper, ak types.AccountKeeper, msg types.MsgSend) (*sdk.Result, error) {
-	if !k.GetSendEnabled(ctx) {
-		return nil, types.ErrSendDisabled
-	}
-
-	if ak.GetAccount(ctx, msg.ToAddress) == nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s account doesn't exist", msg.ToAddress)
-	}
-
-	if k.BlacklistedAddr(msg.ToAddress) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", msg.ToAddress)
-	}
-
-	minCoins := sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(k.GetMinSend(ctx))))
-
-	if minCoins.IsAnyGT(msg.Amount) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "tying to send less then minimum coins")
-	}
-
-	for _, coin := range msg.Amount {
-		if strings.ToLower(coin.Denom) != strings.ToLower(util.ConfigMainDenom) {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "tying to send forbidden denom")
-		}
+	if err := checkTransfer(ctx, k, ak, msg.ToAddress, msg.Amount); err != nil {
+		return nil, err
 	}
 
 	amount := msg.Amount.AmountOf(util.ConfigMainDenom)
-	_, err := util.PayTxFee(ctx, sk, logger(ctx), msg.FromAddress, amount)
+	_, err := util.PayTxFee(ctx, k, sk, logger(ctx), msg.FromAddress, msg.ToAddress, amount, msg.Type())
 	if err != nil {
 		return nil, err
 	}
@@ -85,24 +81,33 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, sk types.SupplyKeeper,
 		return nil, types.ErrSendDisabled
 	}
 
+	for _, out := range msg.Outputs {
+		if k.BlacklistedAddr(out.Address) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", out.Address)
+		}
+		if k.GetTransferSettings(ctx, out.Address).ReceiveDisabled {
+			return nil, sdkerrors.Wrap(types.ErrReceiveDisabled, out.Address.String())
+		}
+	}
+
+	// Fee exemptions only apply to a transfer to a single recipient
+	var recipient sdk.AccAddress
+	if len(msg.Outputs) == 1 {
+		recipient = msg.Outputs[0].Address
+	}
+
 	for _, in := range msg.Inputs {
 		for _, coin := range in.Coins {
 			if strings.ToLower(coin.Denom) != strings.ToLower(util.ConfigMainDenom) {
 				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "tying to send forbidden denom")
 			}
 		}
-		_, err := util.PayTxFee(ctx, sk, logger(ctx), in.Address, in.Coins.AmountOf(util.ConfigMainDenom))
+		_, err := util.PayTxFee(ctx, k, sk, logger(ctx), in.Address, recipient, in.Coins.AmountOf(util.ConfigMainDenom), msg.Type())
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	for _, out := range msg.Outputs {
-		if k.BlacklistedAddr(out.Address) {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", out.Address)
-		}
-	}
-
 	err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return nil, err
@@ -118,6 +123,58 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, sk types.SupplyKeeper,
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+// Handle MsgSetTransferSettings.
+func handleMsgSetTransferSettings(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetTransferSettings) (*sdk.Result, error) {
+	k.SetTransferSettings(ctx, types.NewTransferSettings(msg.Address, msg.MemoRequired, msg.ReceiveDisabled))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferSettings,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyMemoRequired, strconv.FormatBool(msg.MemoRequired)),
+			sdk.NewAttribute(types.AttributeKeyRecvDisabled, strconv.FormatBool(msg.ReceiveDisabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// checkTransfer checks if a single-output transfer is allowed
+func checkTransfer(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, to sdk.AccAddress, amt sdk.Coins) error {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled
+	}
+
+	if ak.GetAccount(ctx, to) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s account doesn't exist", to)
+	}
+
+	if k.BlacklistedAddr(to) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", to)
+	}
+
+	if k.GetTransferSettings(ctx, to).ReceiveDisabled {
+		return sdkerrors.Wrap(types.ErrReceiveDisabled, to.String())
+	}
+
+	minCoins := sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(k.GetMinSend(ctx))))
+
+	if minCoins.IsAnyGT(amt) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "tying to send less then minimum coins")
+	}
+
+	for _, coin := range amt {
+		if strings.ToLower(coin.Denom) != strings.ToLower(util.ConfigMainDenom) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "tying to send forbidden denom")
+		}
+	}
+	return nil
+}
+
 func logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -5,6 +5,7 @@ package bank_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -14,11 +15,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/arterynetwork/artr/app"
 	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/bank"
+	"github.com/arterynetwork/artr/x/schedule"
 )
 
 func TestBankHandler(t *testing.T) {
@@ -29,7 +33,7 @@ func TestBankHandler(t *testing.T) {
 type OriginalBankSuite struct{ suite.Suite }
 
 func (s *OriginalBankSuite) TestInvalidMsg() {
-	h := bank.NewHandler(nil, nil, nil)
+	h := bank.NewHandler(nil, nil, nil, nil)
 
 	res, err := h(sdk.NewContext(nil, abci.Header{}, false, nil), sdk.NewTestMsg())
 	require.Error(s.T(), err)
@@ -48,6 +52,7 @@ type HandlerSuite struct {
 	k            bank.Keeper
 	supplyKeeper supply.Keeper
 	accKeeper    auth.AccountKeeper
+	schedKeeper  schedule.Keeper
 	handler      sdk.Handler
 }
 
@@ -57,7 +62,8 @@ func (s *HandlerSuite) SetupTest() {
 	s.k = s.app.GetBankKeeper()
 	s.supplyKeeper = s.app.GetSupplyKeeper()
 	s.accKeeper = s.app.GetAccountKeeper()
-	s.handler = bank.NewHandler(s.k, s.supplyKeeper, s.accKeeper)
+	s.schedKeeper = s.app.GetScheduleKeeper()
+	s.handler = bank.NewHandler(s.k, s.supplyKeeper, s.accKeeper, s.schedKeeper)
 }
 
 func (s *HandlerSuite) TearDownTest() { s.cleanup() }
@@ -92,6 +98,39 @@ func (s *HandlerSuite) TestSend_TxFee() {
 	)
 }
 
+func (s *HandlerSuite) TestSend_TxFeeSchedule() {
+	s.k.SetFeeSchedule(s.ctx, util.NewFeeSchedule(
+		util.NewFeeRule(util.Permille(3), 0, 10_000000),
+		[]util.FeeOverride{
+			{MsgType: bank.MsgSend{}.Type(), Rule: util.NewFeeRule(util.Percent(1), 5_000000, 20_000000)},
+		},
+	))
+	userA := app.DefaultGenesisUsers["root"]
+	userB := app.DefaultGenesisUsers["user2"]
+	feeCollected := func() int64 {
+		return s.supplyKeeper.GetModuleAccount(s.ctx, auth.FeeCollectorName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	}
+
+	_, err := s.handler(s.ctx, bank.NewMsgSend(userA, userB, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(int64(5_000000), feeCollected(), "floor") // 1% of 100 is less than 5
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(userA, userB, util.Uartrs(1_000_000000)))
+	s.NoError(err)
+	s.Equal(int64(5_000000+10_000000), feeCollected(), "rate") // = 1000 * 1%
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(userA, userB, util.Uartrs(10_000_000000)))
+	s.NoError(err)
+	s.Equal(int64(5_000000+10_000000+20_000000), feeCollected(), "cap") // 1% of 10000 is more than 20
+
+	_, err = s.handler(s.ctx, bank.NewMsgMultiSend(
+		[]bank.Input{bank.NewInput(userA, util.Uartrs(1_000_000000))},
+		[]bank.Output{bank.NewOutput(userB, util.Uartrs(1_000_000000))},
+	))
+	s.NoError(err)
+	s.Equal(int64(5_000000+10_000000+20_000000+3_000000), feeCollected(), "default rule") // = 1000 * 0.3%
+}
+
 func (s *HandlerSuite) TestSend_SendAll() {
 	userA := app.DefaultGenesisUsers["user1"]
 	s.Equal(
@@ -111,6 +150,22 @@ func (s *HandlerSuite) TestSend_SendAll() {
 	require.True(s.T(), strings.Contains(log, "insufficient funds"))
 }
 
+func (s *HandlerSuite) TestSend_HugeAmount() {
+	userA := app.DefaultGenesisUsers["user1"]
+	userB := app.DefaultGenesisUsers["user2"]
+
+	amount, ok := sdk.NewIntFromString("100000000000000000000000") // > MaxInt64
+	s.Require().True(ok)
+	s.Equal(sdk.NewInt(10_000000), util.CalculateFee(s.k.GetFeeSchedule(s.ctx), "send", amount), "max fee")
+
+	msg := bank.NewMsgSend(userA, userB, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, amount)))
+	s.NoError(msg.ValidateBasic())
+	s.NotPanics(func() {
+		_, err := s.handler(s.ctx, msg)
+		s.Error(err)
+	})
+}
+
 func (s*HandlerSuite) TestSend_ToNowhere() {
 	userA := app.DefaultGenesisUsers["user1"]
 	s.Equal(
@@ -189,3 +244,380 @@ func (s *HandlerSuite) TestMultiSend_TxFee() {
 		s.accKeeper.GetAccount(s.ctx, receiverB).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
 	)
 }
+
+func (s *HandlerSuite) TestStandingOrder() {
+	sender := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+
+	msg := bank.NewMsgCreateStandingOrder(sender, recipient, util.Uartrs(100_000000), 0, 5, 11)
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+
+	orders := s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName)
+	s.Len(orders, 1)
+	s.Equal(uint64(6), orders[0].Next)
+
+	for s.ctx.BlockHeight() < 6 {
+		s.nextBlock()
+	}
+	s.Equal(
+		int64(899_700000), // = 1000(from genesis) - 100 * 100.3%
+		s.accKeeper.GetAccount(s.ctx, sender).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+	s.Equal(
+		int64(1_100_000000), // = 1000(from genesis) + 100
+		s.accKeeper.GetAccount(s.ctx, recipient).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+
+	for s.ctx.BlockHeight() < 16 {
+		s.nextBlock()
+	}
+	s.Equal(
+		int64(799_400000), // = 1000(from genesis) - 2 * 100 * 100.3%
+		s.accKeeper.GetAccount(s.ctx, sender).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+	s.Equal(
+		int64(1_200_000000), // = 1000(from genesis) + 2 * 100
+		s.accKeeper.GetAccount(s.ctx, recipient).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+	s.Empty(s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName))
+}
+
+func (s *HandlerSuite) TestStandingOrder_MinInterval() {
+	sender := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+	s.k.SetMinStandingOrderInterval(s.ctx, 10)
+
+	_, err := s.handler(s.ctx, bank.NewMsgCreateStandingOrder(sender, recipient, util.Uartrs(100_000000), 0, 9, 0))
+	s.Error(err)
+	s.Empty(s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName))
+
+	_, err = s.handler(s.ctx, bank.NewMsgCreateStandingOrder(sender, recipient, util.Uartrs(100_000000), 0, 10, 0))
+	s.NoError(err)
+	s.Len(s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName), 1)
+}
+
+func (s *HandlerSuite) TestStandingOrder_InsufficientFunds() {
+	sender := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+
+	msg := bank.NewMsgCreateStandingOrder(sender, recipient, util.Uartrs(1_000_000000), 3, 5, 0) // all the money
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+
+	s.nextBlock()
+	_, bbr := s.nextBlock()
+	s.EqualValues(3, s.ctx.BlockHeight())
+
+	failed := false
+	for _, event := range bbr.Events {
+		if event.Type == bank.EventTypeStandingOrderFailed {
+			failed = true
+		}
+	}
+	s.True(failed)
+	s.Equal(
+		int64(1_000_000000), // as it was
+		s.accKeeper.GetAccount(s.ctx, sender).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+	s.Len(s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName), 1)
+}
+
+func (s *HandlerSuite) TestStandingOrder_Cancel() {
+	sender := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+
+	msg := bank.NewMsgCreateStandingOrder(sender, recipient, util.Uartrs(100_000000), 0, 5, 0)
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+	id := s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName)[0].ID
+
+	_, err = s.handler(s.ctx, bank.NewMsgCancelStandingOrder(recipient, id))
+	s.Error(err)
+	_, err = s.handler(s.ctx, bank.NewMsgCancelStandingOrder(sender, id+1))
+	s.True(bank.ErrNoStandingOrder.Is(err))
+
+	_, err = s.handler(s.ctx, bank.NewMsgCancelStandingOrder(sender, id))
+	s.NoError(err)
+	s.Empty(s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName))
+
+	for s.ctx.BlockHeight() < 7 {
+		s.nextBlock()
+	}
+	s.Equal(
+		int64(1_000_000000), // as it was
+		s.accKeeper.GetAccount(s.ctx, sender).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+}
+
+func (s *HandlerSuite) TestStandingOrder_MigrateAccount() {
+	sender := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+	_, _, newSender := authtypes.KeyTestPubAddr()
+	_, _, newRecipient := authtypes.KeyTestPubAddr()
+
+	_, err := s.handler(s.ctx, bank.NewMsgCreateStandingOrder(sender, recipient, util.Uartrs(100_000000), 0, 5, 0))
+	s.NoError(err)
+	id := s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName)[0].ID
+
+	pk := s.app.GetProfileKeeper()
+	s.NoError(pk.MigrateAccount(s.ctx, sender, newSender))
+	s.NoError(pk.MigrateAccount(s.ctx, recipient, newRecipient))
+
+	for s.ctx.BlockHeight() < 6 {
+		s.nextBlock()
+	}
+	s.Equal(
+		int64(899_700000), // = 1000(from genesis) - 100 * 100.3%
+		s.accKeeper.GetAccount(s.ctx, newSender).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+	s.Equal(
+		int64(1_100_000000), // = 1000(from genesis) + 100
+		s.accKeeper.GetAccount(s.ctx, newRecipient).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+
+	_, err = s.handler(s.ctx, bank.NewMsgCancelStandingOrder(sender, id))
+	s.True(sdkerrors.ErrUnauthorized.Is(err))
+	_, err = s.handler(s.ctx, bank.NewMsgCancelStandingOrder(newSender, id))
+	s.NoError(err)
+	s.Empty(s.schedKeeper.GetRecurringTasksByHandler(s.ctx, bank.StandingOrderHookName))
+}
+
+func (s *HandlerSuite) TestFeeExemption() {
+	userA := app.DefaultGenesisUsers["user3"]
+	userB := app.DefaultGenesisUsers["user4"]
+	s.NoError(s.k.AddFeeExemption(s.ctx, bank.NewFeeExemption(userA, userB)))
+	s.Error(s.k.AddFeeExemption(s.ctx, bank.NewFeeExemption(userA, userB)))
+
+	_, err := s.handler(s.ctx, bank.NewMsgSend(userA, userB, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(
+		int64(900_000000), // = 1000(from genesis) - 100, no fee
+		s.accKeeper.GetAccount(s.ctx, userA).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+
+	// The exemption is one-way
+	_, err = s.handler(s.ctx, bank.NewMsgSend(userB, userA, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(
+		int64(999_700000), // = 1000(from genesis) + 100 - 100 * 100.3%
+		s.accKeeper.GetAccount(s.ctx, userB).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+
+	s.NoError(s.k.RemoveFeeExemption(s.ctx, bank.NewFeeExemption(userA, userB)))
+	s.Error(s.k.RemoveFeeExemption(s.ctx, bank.NewFeeExemption(userA, userB)))
+	_, err = s.handler(s.ctx, bank.NewMsgSend(userA, userB, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(
+		int64(899_700000), // = 900 + 100 - 100 * 100.3%
+		s.accKeeper.GetAccount(s.ctx, userA).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+}
+
+func (s *HandlerSuite) TestFeeGrant() {
+	granter := app.DefaultGenesisUsers["user3"]
+	grantee := app.DefaultGenesisUsers["user4"]
+	recipient := app.DefaultGenesisUsers["user5"]
+	balance := func(addr sdk.AccAddress) int64 {
+		return s.accKeeper.GetAccount(s.ctx, addr).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	}
+
+	_, err := s.handler(s.ctx, bank.NewMsgGrantFee(granter, grantee, util.Uartrs(1_000000), 10))
+	s.NoError(err)
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(grantee, recipient, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(int64(900_000000), balance(grantee))
+	s.Equal(int64(999_700000), balance(granter))
+	grant, found := s.k.GetFeeGrant(s.ctx, granter, grantee)
+	s.True(found)
+	s.Equal(util.Uartrs(700000), grant.SpendLimit)
+
+	// The fee (0.9 ARTR) is more than the limit left, so the grantee pays it
+	_, err = s.handler(s.ctx, bank.NewMsgSend(grantee, recipient, util.Uartrs(300_000000)))
+	s.NoError(err)
+	s.Equal(int64(599_100000), balance(grantee))
+	s.Equal(int64(999_700000), balance(granter))
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(grantee, recipient, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(int64(499_100000), balance(grantee))
+	s.Equal(int64(999_400000), balance(granter))
+
+	// Expired
+	s.ctx = s.ctx.WithBlockHeight(10)
+	_, err = s.handler(s.ctx, bank.NewMsgSend(grantee, recipient, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(int64(398_800000), balance(grantee))
+	s.Equal(int64(999_400000), balance(granter))
+	_, found = s.k.GetFeeGrant(s.ctx, granter, grantee)
+	s.False(found)
+}
+
+func (s *HandlerSuite) TestFeeGrant_LockedCoins() {
+	grantee := app.DefaultGenesisUsers["user4"]
+	recipient := app.DefaultGenesisUsers["user5"]
+	_, _, granter := authtypes.KeyTestPubAddr()
+
+	acc := authtypes.NewBaseAccountWithAddress(granter)
+	s.NoError(acc.SetCoins(util.Uartrs(10_000000)))
+	s.accKeeper.SetAccount(s.ctx, vesting.NewDelayedVestingAccount(&acc, s.ctx.BlockTime().Add(time.Hour).Unix()))
+	s.k.SetFeeGrant(s.ctx, bank.NewFeeGrant(granter, grantee, util.Uartrs(1_000000), 0))
+
+	// The granter's coins are locked, so the grantee pays the fee and the grant is kept intact
+	_, err := s.handler(s.ctx, bank.NewMsgSend(grantee, recipient, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(int64(899_700000), s.accKeeper.GetAccount(s.ctx, grantee).GetCoins().AmountOf(util.ConfigMainDenom).Int64())
+	s.Equal(int64(10_000000), s.accKeeper.GetAccount(s.ctx, granter).GetCoins().AmountOf(util.ConfigMainDenom).Int64())
+	grant, found := s.k.GetFeeGrant(s.ctx, granter, grantee)
+	s.True(found)
+	s.Equal(util.Uartrs(1_000000), grant.SpendLimit)
+}
+
+func (s *HandlerSuite) TestFeeGrant_Revoke() {
+	granter := app.DefaultGenesisUsers["user3"]
+	grantee := app.DefaultGenesisUsers["user4"]
+
+	_, err := s.handler(s.ctx, bank.NewMsgGrantFee(granter, grantee, util.Uartrs(1_000000), 0))
+	s.NoError(err)
+	s.Len(s.k.GetFeeGrants(s.ctx, granter), 1)
+	s.Len(s.k.GetFeeGrants(s.ctx, grantee), 1)
+
+	_, err = s.handler(s.ctx, bank.NewMsgRevokeFeeGrant(grantee, granter))
+	s.True(bank.ErrNoFeeGrant.Is(err))
+
+	_, err = s.handler(s.ctx, bank.NewMsgRevokeFeeGrant(granter, grantee))
+	s.NoError(err)
+	s.Empty(s.k.GetFeeGrants(s.ctx, granter))
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(grantee, granter, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(
+		int64(899_700000), // = 1000(from genesis) - 100 * 100.3%
+		s.accKeeper.GetAccount(s.ctx, grantee).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+}
+
+func (s *HandlerSuite) TestFeeGrant_MigrateAccount() {
+	granter := app.DefaultGenesisUsers["user3"]
+	grantee := app.DefaultGenesisUsers["user4"]
+	other := app.DefaultGenesisUsers["user5"]
+	_, _, newGranter := authtypes.KeyTestPubAddr()
+	_, _, newGrantee := authtypes.KeyTestPubAddr()
+
+	_, err := s.handler(s.ctx, bank.NewMsgGrantFee(granter, grantee, util.Uartrs(1_000000), 0))
+	s.NoError(err)
+	_, err = s.handler(s.ctx, bank.NewMsgGrantFee(granter, other, util.Uartrs(2_000000), 0))
+	s.NoError(err)
+
+	pk := s.app.GetProfileKeeper()
+	s.NoError(pk.MigrateAccount(s.ctx, granter, newGranter))
+	s.NoError(pk.MigrateAccount(s.ctx, grantee, newGrantee))
+
+	s.Empty(s.k.GetFeeGrants(s.ctx, granter))
+	s.Empty(s.k.GetFeeGrants(s.ctx, grantee))
+	s.Len(s.k.GetFeeGrants(s.ctx, newGranter), 2)
+	grant, found := s.k.GetFeeGrant(s.ctx, newGranter, newGrantee)
+	s.True(found)
+	s.Equal(util.Uartrs(1_000000), grant.SpendLimit)
+	_, found = s.k.GetFeeGrant(s.ctx, newGranter, other)
+	s.True(found)
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(newGrantee, other, util.Uartrs(100_000000)))
+	s.NoError(err)
+	s.Equal(
+		int64(900_000000), // = 1000(from genesis) - 100, the fee is paid by the granter
+		s.accKeeper.GetAccount(s.ctx, newGrantee).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+}
+
+func (s *HandlerSuite) TestTransferSettings_ReceiveDisabled() {
+	sender := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+
+	_, err := s.handler(s.ctx, bank.NewMsgSetTransferSettings(recipient, false, true))
+	s.NoError(err)
+	s.True(s.k.GetTransferSettings(s.ctx, recipient).ReceiveDisabled)
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(sender, recipient, util.Uartrs(100_000000)))
+	s.True(bank.ErrReceiveDisabled.Is(err))
+	_, err = s.handler(s.ctx, bank.NewMsgMultiSend(
+		[]bank.Input{bank.NewInput(sender, util.Uartrs(100_000000))},
+		[]bank.Output{bank.NewOutput(recipient, util.Uartrs(100_000000))},
+	))
+	s.True(bank.ErrReceiveDisabled.Is(err))
+	_, err = s.handler(s.ctx, bank.NewMsgCreateStandingOrder(sender, recipient, util.Uartrs(100_000000), 0, 5, 0))
+	s.True(bank.ErrReceiveDisabled.Is(err))
+	s.Equal(
+		int64(1_000_000000), // as it was
+		s.accKeeper.GetAccount(s.ctx, sender).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+
+	_, err = s.handler(s.ctx, bank.NewMsgSetTransferSettings(recipient, false, false))
+	s.NoError(err)
+	s.True(s.k.GetTransferSettings(s.ctx, recipient).IsDefault())
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(sender, recipient, util.Uartrs(100_000000)))
+	s.NoError(err)
+}
+
+func (s *HandlerSuite) TestTransferSettings_MemoRequired() {
+	sender := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+	decorator := bank.NewMemoRequiredDecorator(s.k)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	_, err := s.handler(s.ctx, bank.NewMsgSetTransferSettings(recipient, true, false))
+	s.NoError(err)
+
+	msgs := []sdk.Msg{
+		bank.NewMsgSend(sender, recipient, util.Uartrs(100_000000)),
+		bank.NewMsgMultiSend(
+			[]bank.Input{bank.NewInput(sender, util.Uartrs(100_000000))},
+			[]bank.Output{bank.NewOutput(recipient, util.Uartrs(100_000000))},
+		),
+		bank.NewMsgCreateStandingOrder(sender, recipient, util.Uartrs(100_000000), 0, 5, 0),
+	}
+	for _, msg := range msgs {
+		_, err = decorator.AnteHandle(s.ctx, auth.StdTx{Msgs: []sdk.Msg{msg}}, false, next)
+		s.True(bank.ErrMemoRequired.Is(err), msg.Type())
+
+		_, err = decorator.AnteHandle(s.ctx, auth.StdTx{Msgs: []sdk.Msg{msg}, Memo: "invoice #42"}, false, next)
+		s.NoError(err, msg.Type())
+	}
+
+	_, err = decorator.AnteHandle(s.ctx, auth.StdTx{Msgs: []sdk.Msg{bank.NewMsgSend(recipient, sender, util.Uartrs(100_000000))}}, false, next)
+	s.NoError(err)
+}
+
+var bbHeader = abci.RequestBeginBlock{
+	Header: abci.Header{
+		ProposerAddress: sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey).Address().Bytes(),
+	},
+}
+
+func (s *HandlerSuite) TestTransferSettings_MigrateAccount() {
+	sender := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+	_, _, newRecipient := authtypes.KeyTestPubAddr()
+
+	_, err := s.handler(s.ctx, bank.NewMsgSetTransferSettings(recipient, true, true))
+	s.NoError(err)
+	s.NoError(s.app.GetProfileKeeper().MigrateAccount(s.ctx, recipient, newRecipient))
+
+	s.True(s.k.GetTransferSettings(s.ctx, recipient).IsDefault())
+	settings := s.k.GetTransferSettings(s.ctx, newRecipient)
+	s.Equal(newRecipient, settings.Address)
+	s.True(settings.MemoRequired)
+	s.True(settings.ReceiveDisabled)
+
+	_, err = s.handler(s.ctx, bank.NewMsgSend(sender, newRecipient, util.Uartrs(100_000000)))
+	s.True(bank.ErrReceiveDisabled.Is(err))
+}
+
+func (s *HandlerSuite) nextBlock() (abci.ResponseEndBlock, abci.ResponseBeginBlock) {
+	ebr := s.app.EndBlocker(s.ctx, abci.RequestEndBlock{})
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	bbr := s.app.BeginBlocker(s.ctx, bbHeader)
+	return ebr, bbr
+}
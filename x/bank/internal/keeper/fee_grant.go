@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/bank/internal/types"
+)
+
+// GetFeeExemptions returns sender/recipient pairs transfers between which are free of charge
+func (keeper BaseSendKeeper) GetFeeExemptions(ctx sdk.Context) types.FeeExemptions {
+	var exemptions types.FeeExemptions
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyFeeExemptions, &exemptions)
+	return exemptions
+}
+
+// SetFeeExemptions sets sender/recipient pairs transfers between which are free of charge
+func (keeper BaseSendKeeper) SetFeeExemptions(ctx sdk.Context, exemptions types.FeeExemptions) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyFeeExemptions, &exemptions)
+}
+
+func (keeper BaseSendKeeper) AddFeeExemption(ctx sdk.Context, exemption types.FeeExemption) error {
+	exemptions := keeper.GetFeeExemptions(ctx)
+	if exemptions.Contains(exemption) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fee exemption %s already exists", exemption)
+	}
+	keeper.SetFeeExemptions(ctx, append(exemptions, exemption))
+	return nil
+}
+
+func (keeper BaseSendKeeper) RemoveFeeExemption(ctx sdk.Context, exemption types.FeeExemption) error {
+	exemptions := keeper.GetFeeExemptions(ctx)
+	for i, e := range exemptions {
+		if e.Equals(exemption) {
+			keeper.SetFeeExemptions(ctx, append(exemptions[:i], exemptions[i+1:]...))
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fee exemption %s not found", exemption)
+}
+
+// IsFeeExempt checks if transfers from the sender to the recipient are free of charge
+func (keeper BaseSendKeeper) IsFeeExempt(ctx sdk.Context, sender, recipient sdk.AccAddress) bool {
+	return keeper.GetFeeExemptions(ctx).Contains(types.NewFeeExemption(sender, recipient))
+}
+
+// GetFeeGrant returns a fee grant (if any)
+func (keeper BaseSendKeeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.FeeGrant, bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.FeeGrantKey(grantee, granter))
+	if bz == nil {
+		return types.FeeGrant{}, false
+	}
+	var grant types.FeeGrant
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// SetFeeGrant saves a fee grant, replacing the previous one of the same granter to the same grantee (if any)
+func (keeper BaseSendKeeper) SetFeeGrant(ctx sdk.Context, grant types.FeeGrant) {
+	ctx.KVStore(keeper.storeKey).Set(
+		types.FeeGrantKey(grant.Grantee, grant.Granter),
+		keeper.cdc.MustMarshalBinaryBare(grant),
+	)
+}
+
+func (keeper BaseSendKeeper) DeleteFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) {
+	ctx.KVStore(keeper.storeKey).Delete(types.FeeGrantKey(grantee, granter))
+}
+
+func (keeper BaseSendKeeper) IterateFeeGrants(ctx sdk.Context, cb func(grant types.FeeGrant) (stop bool)) {
+	keeper.iterateFeeGrants(ctx, types.FeeGrantPrefix, cb)
+}
+
+// GetFeeGrants returns all fee grants the account is the granter or the grantee of
+func (keeper BaseSendKeeper) GetFeeGrants(ctx sdk.Context, addr sdk.AccAddress) types.FeeGrants {
+	result := make(types.FeeGrants, 0)
+	keeper.IterateFeeGrants(ctx, func(grant types.FeeGrant) (stop bool) {
+		if grant.Granter.Equals(addr) || grant.Grantee.Equals(addr) {
+			result = append(result, grant)
+		}
+		return false
+	})
+	return result
+}
+
+// MigrateFeeGrants re-keys all fee grants the account is the granter or the grantee of to its new address
+func (keeper BaseSendKeeper) MigrateFeeGrants(ctx sdk.Context, from, to sdk.AccAddress) {
+	for _, grant := range keeper.GetFeeGrants(ctx, from) {
+		keeper.DeleteFeeGrant(ctx, grant.Granter, grant.Grantee)
+		if grant.Granter.Equals(from) {
+			grant.Granter = to
+		}
+		if grant.Grantee.Equals(from) {
+			grant.Grantee = to
+		}
+		keeper.SetFeeGrant(ctx, grant)
+	}
+}
+
+// FindFeeGrant finds a grant covering the grantee's fee. Grants which are expired, have not enough spend limit left
+// or whose granters cannot afford the fee (locked coins don't count) are skipped (expired ones are deleted).
+// It returns the granter, or nil if there's no suitable grant. The grant is not charged, see UseFeeGrant.
+func (keeper BaseSendKeeper) FindFeeGrant(ctx sdk.Context, grantee sdk.AccAddress, fee sdk.Int) sdk.AccAddress {
+	var (
+		found   sdk.AccAddress
+		expired []types.FeeGrant
+	)
+	keeper.iterateFeeGrants(ctx, types.FeeGrantsByGranteeKey(grantee), func(grant types.FeeGrant) (stop bool) {
+		if grant.IsExpired(ctx.BlockHeight()) {
+			expired = append(expired, grant)
+			return false
+		}
+		if grant.SpendLimit.AmountOf(util.ConfigMainDenom).LT(fee) {
+			return false
+		}
+		if keeper.spendableCoins(ctx, grant.Granter).AmountOf(util.ConfigMainDenom).LT(fee) {
+			return false
+		}
+		found = grant.Granter
+		return true
+	})
+	for _, grant := range expired {
+		keeper.DeleteFeeGrant(ctx, grant.Granter, grant.Grantee)
+	}
+	return found
+}
+
+// UseFeeGrant charges the fee, which is already paid by the granter, against the grant. It should be called only
+// after the fee is transferred, so that a failed transfer doesn't consume the grant.
+func (keeper BaseSendKeeper) UseFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Int) {
+	grant, found := keeper.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return
+	}
+	grant.SpendLimit = grant.SpendLimit.Sub(sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, fee)))
+	if grant.SpendLimit.IsZero() {
+		keeper.DeleteFeeGrant(ctx, grant.Granter, grant.Grantee)
+	} else {
+		keeper.SetFeeGrant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeGrantUsed,
+		sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()+util.ConfigMainDenom),
+	))
+}
+
+func (keeper BaseSendKeeper) spendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	acc := keeper.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.NewCoins()
+	}
+	return acc.SpendableCoins(ctx.BlockHeader().Time)
+}
+
+func (keeper BaseSendKeeper) iterateFeeGrants(ctx sdk.Context, prefix []byte, cb func(grant types.FeeGrant) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), prefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var grant types.FeeGrant
+		keeper.cdc.MustUnmarshalBinaryBare(it.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/bank/internal/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -35,12 +37,12 @@ type BaseKeeper struct {
 
 // NewBaseKeeper returns a new BaseKeeper
 func NewBaseKeeper(
-	ak types.AccountKeeper, paramSpace params.Subspace, blacklistedAddrs map[string]bool,
+	cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, paramSpace params.Subspace, blacklistedAddrs map[string]bool,
 ) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(ak, ps, blacklistedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(cdc, key, ak, ps, blacklistedAddrs),
 		ak:             ak,
 		paramSpace:     ps,
 	}
@@ -149,6 +151,32 @@ type SendKeeper interface {
 	GetMinSend(ctx sdk.Context) int64
 	SetMinSend(ctx sdk.Context, minSend int64)
 
+	GetFeeSchedule(ctx sdk.Context) util.FeeSchedule
+	SetFeeSchedule(ctx sdk.Context, schedule util.FeeSchedule)
+
+	GetMinStandingOrderInterval(ctx sdk.Context) uint64
+	SetMinStandingOrderInterval(ctx sdk.Context, interval uint64)
+
+	GetFeeExemptions(ctx sdk.Context) types.FeeExemptions
+	SetFeeExemptions(ctx sdk.Context, exemptions types.FeeExemptions)
+	AddFeeExemption(ctx sdk.Context, exemption types.FeeExemption) error
+	RemoveFeeExemption(ctx sdk.Context, exemption types.FeeExemption) error
+	IsFeeExempt(ctx sdk.Context, sender, recipient sdk.AccAddress) bool
+
+	GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.FeeGrant, bool)
+	SetFeeGrant(ctx sdk.Context, grant types.FeeGrant)
+	DeleteFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress)
+	IterateFeeGrants(ctx sdk.Context, cb func(grant types.FeeGrant) (stop bool))
+	GetFeeGrants(ctx sdk.Context, addr sdk.AccAddress) types.FeeGrants
+	FindFeeGrant(ctx sdk.Context, grantee sdk.AccAddress, fee sdk.Int) sdk.AccAddress
+	UseFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Int)
+	MigrateFeeGrants(ctx sdk.Context, from, to sdk.AccAddress)
+
+	GetTransferSettings(ctx sdk.Context, addr sdk.AccAddress) types.TransferSettings
+	SetTransferSettings(ctx sdk.Context, settings types.TransferSettings)
+	MigrateTransferSettings(ctx sdk.Context, from, to sdk.AccAddress)
+	IterateTransferSettings(ctx sdk.Context, cb func(settings types.TransferSettings) (stop bool))
+
 	BlacklistedAddr(addr sdk.AccAddress) bool
 
 	AddHook(event string, name string, hook func(ctx sdk.Context, acc authexported.Account) error)
@@ -161,6 +189,8 @@ var _ SendKeeper = (*BaseSendKeeper)(nil)
 type BaseSendKeeper struct {
 	BaseViewKeeper
 
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
 	ak         types.AccountKeeper
 	paramSpace params.Subspace
 
@@ -173,11 +203,13 @@ type BaseSendKeeper struct {
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
 func NewBaseSendKeeper(
-	ak types.AccountKeeper, paramSpace params.Subspace, blacklistedAddrs map[string]bool,
+	cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, paramSpace params.Subspace, blacklistedAddrs map[string]bool,
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
 		BaseViewKeeper:   NewBaseViewKeeper(ak),
+		cdc:              cdc,
+		storeKey:         key,
 		ak:               ak,
 		paramSpace:       paramSpace,
 		blacklistedAddrs: blacklistedAddrs,
@@ -368,6 +400,30 @@ func (keeper BaseSendKeeper) SetMinSend(ctx sdk.Context, minSend int64) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyMinSend, &minSend)
 }
 
+// GetFeeSchedule returns the current transaction fee schedule
+func (keeper BaseSendKeeper) GetFeeSchedule(ctx sdk.Context) util.FeeSchedule {
+	var schedule util.FeeSchedule
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyTxFee, &schedule)
+	return schedule
+}
+
+// SetFeeSchedule sets the transaction fee schedule
+func (keeper BaseSendKeeper) SetFeeSchedule(ctx sdk.Context, schedule util.FeeSchedule) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTxFee, &schedule)
+}
+
+// GetMinStandingOrderInterval returns the least interval (in blocks) between standing order transfers
+func (keeper BaseSendKeeper) GetMinStandingOrderInterval(ctx sdk.Context) uint64 {
+	var interval uint64
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyMinStandingOrderInterval, &interval)
+	return interval
+}
+
+// SetMinStandingOrderInterval sets the least interval (in blocks) between standing order transfers
+func (keeper BaseSendKeeper) SetMinStandingOrderInterval(ctx sdk.Context, interval uint64) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyMinStandingOrderInterval, &interval)
+}
+
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
 // receiving funds)
 func (keeper BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
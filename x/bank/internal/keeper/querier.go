@@ -3,6 +3,7 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/bank/internal/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,16 +14,30 @@ const (
 	// query balance path
 	QueryBalance = "balances"
 	QueryParams  = "params"
+	QueryFee     = "fee"
+
+	QueryStandingOrders = "standing_orders"
+	QueryFeeGrants      = "fee_grants"
+
+	QueryTransferSettings = "transfer_settings"
 )
 
 // NewQuerier returns a new sdk.Keeper instance.
-func NewQuerier(k Keeper) sdk.Querier {
+func NewQuerier(k Keeper, schk types.ScheduleKeeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
 		case QueryBalance:
 			return queryBalance(ctx, req, k)
 		case QueryParams:
 			return queryParams(ctx, k)
+		case QueryFee:
+			return queryFee(ctx, req, k)
+		case QueryStandingOrders:
+			return queryStandingOrders(ctx, req, schk)
+		case QueryFeeGrants:
+			return queryFeeGrants(ctx, req, k)
+		case QueryTransferSettings:
+			return queryTransferSettings(ctx, req, k)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
@@ -53,7 +68,7 @@ func queryBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, err
 }
 
 func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
-	params := types.NewQueryResParams(k.GetSendEnabled(ctx), k.GetMinSend(ctx))
+	params := types.NewQueryResParams(k.GetSendEnabled(ctx), k.GetMinSend(ctx), k.GetFeeSchedule(ctx), k.GetFeeExemptions(ctx))
 
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, params)
 	if err != nil {
@@ -62,3 +77,84 @@ func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return bz, nil
 }
+
+// queryFee estimates a transaction fee for the amount using the current fee schedule.
+func queryFee(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryFeeParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	fee := util.CalculateFee(k.GetFeeSchedule(ctx), params.MsgType, params.Amount.AmountOf(util.ConfigMainDenom))
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResFee{Fee: util.Uartrs(fee.Int64())})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryStandingOrders(ctx sdk.Context, req abci.RequestQuery, schk types.ScheduleKeeper) ([]byte, error) {
+	var params types.QueryStandingOrdersParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := make(types.QueryResStandingOrders, 0)
+	for _, rt := range schk.GetRecurringTasksByHandler(ctx, types.StandingOrderHookName) {
+		order, err := types.StandingOrderFromBytes(rt.Data)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		if !order.Sender.Equals(params.Address) {
+			continue
+		}
+		res = append(res, types.StandingOrderInfo{
+			ID:       rt.ID,
+			Order:    order,
+			Interval: rt.Interval,
+			End:      rt.End,
+			Next:     rt.Next,
+		})
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryFeeGrants(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryFeeGrantsParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetFeeGrants(ctx, params.Address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryTransferSettings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTransferSettingsParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetTransferSettings(ctx, params.Address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
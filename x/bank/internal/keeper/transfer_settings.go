@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/x/bank/internal/types"
+)
+
+// GetTransferSettings returns the account's restrictions on incoming transfers (none by default)
+func (keeper BaseSendKeeper) GetTransferSettings(ctx sdk.Context, addr sdk.AccAddress) types.TransferSettings {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.TransferSettingsKey(addr))
+	if bz == nil {
+		return types.NewTransferSettings(addr, false, false)
+	}
+	var settings types.TransferSettings
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &settings)
+	return settings
+}
+
+// SetTransferSettings saves the account's restrictions on incoming transfers. Default settings are not stored.
+func (keeper BaseSendKeeper) SetTransferSettings(ctx sdk.Context, settings types.TransferSettings) {
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.TransferSettingsKey(settings.Address)
+	if settings.IsDefault() {
+		store.Delete(key)
+	} else {
+		store.Set(key, keeper.cdc.MustMarshalBinaryBare(settings))
+	}
+}
+
+// MigrateTransferSettings moves the account's transfer settings to its new address
+func (keeper BaseSendKeeper) MigrateTransferSettings(ctx sdk.Context, from, to sdk.AccAddress) {
+	settings := keeper.GetTransferSettings(ctx, from)
+	if settings.IsDefault() {
+		return
+	}
+	keeper.SetTransferSettings(ctx, types.NewTransferSettings(from, false, false))
+	settings.Address = to
+	keeper.SetTransferSettings(ctx, settings)
+}
+
+// IterateTransferSettings iterates over all non-default transfer settings
+func (keeper BaseSendKeeper) IterateTransferSettings(ctx sdk.Context, cb func(settings types.TransferSettings) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), types.TransferSettingsPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var settings types.TransferSettings
+		keeper.cdc.MustUnmarshalBinaryBare(it.Value(), &settings)
+		if cb(settings) {
+			break
+		}
+	}
+}
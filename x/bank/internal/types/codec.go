@@ -8,6 +8,11 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(MsgCreateStandingOrder{}, "artrbank/MsgCreateStandingOrder", nil)
+	cdc.RegisterConcrete(MsgCancelStandingOrder{}, "artrbank/MsgCancelStandingOrder", nil)
+	cdc.RegisterConcrete(MsgGrantFee{}, "artrbank/MsgGrantFee", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeGrant{}, "artrbank/MsgRevokeFeeGrant", nil)
+	cdc.RegisterConcrete(MsgSetTransferSettings{}, "artrbank/MsgSetTransferSettings", nil)
 }
 
 // module codec
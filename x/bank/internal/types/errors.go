@@ -10,4 +10,8 @@ var (
 	ErrNoOutputs           = sdkerrors.Register(ModuleName, 2, "no outputs to send transaction")
 	ErrInputOutputMismatch = sdkerrors.Register(ModuleName, 3, "sum inputs != sum outputs")
 	ErrSendDisabled        = sdkerrors.Register(ModuleName, 4, "send transactions are disabled")
+	ErrNoStandingOrder     = sdkerrors.Register(ModuleName, 5, "standing order not found")
+	ErrNoFeeGrant          = sdkerrors.Register(ModuleName, 6, "fee grant not found")
+	ErrReceiveDisabled     = sdkerrors.Register(ModuleName, 7, "recipient does not accept transfers")
+	ErrMemoRequired        = sdkerrors.Register(ModuleName, 8, "recipient requires a memo")
 )
@@ -2,10 +2,26 @@ package types
 
 // bank module event types
 const (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer              = "transfer"
+	EventTypeStandingOrderCreated  = "standing_order_created"
+	EventTypeStandingOrderCanceled = "standing_order_canceled"
+	EventTypeStandingOrderExecuted = "standing_order_executed"
+	EventTypeStandingOrderFailed   = "standing_order_failed"
+	EventTypeFeeGranted            = "fee_granted"
+	EventTypeFeeGrantRevoked       = "fee_grant_revoked"
+	EventTypeFeeGrantUsed          = "fee_grant_used"
+	EventTypeTransferSettings      = "transfer_settings"
 
-	AttributeKeyRecipient = "recipient"
-	AttributeKeySender    = "sender"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeySender        = "sender"
+	AttributeKeyStandingOrder = "standing_order"
+	AttributeKeyError         = "error"
+	AttributeKeyGranter       = "granter"
+	AttributeKeyGrantee       = "grantee"
+	AttributeKeyFee           = "fee"
+	AttributeKeyAddress       = "address"
+	AttributeKeyMemoRequired  = "memo_required"
+	AttributeKeyRecvDisabled  = "receive_disabled"
 
 	AttributeValueCategory = ModuleName
 )
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+
+	"github.com/arterynetwork/artr/x/schedule"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -22,3 +24,12 @@ type SupplyKeeper interface {
 		ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
 	) error
 }
+
+// ScheduleKeeper is used to perform standing orders
+type ScheduleKeeper interface {
+	ScheduleRecurringTask(ctx sdk.Context, event string, data *[]byte, start, interval, end uint64) (uint64, error)
+	GetRecurringTask(ctx sdk.Context, id uint64) (schedule.RecurringTask, bool)
+	GetRecurringTasksByHandler(ctx sdk.Context, handler string) []schedule.RecurringTask
+	CancelRecurringTask(ctx sdk.Context, id uint64) error
+	SetRecurringTaskData(ctx sdk.Context, id uint64, data []byte) error
+}
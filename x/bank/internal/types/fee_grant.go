@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/util"
+)
+
+// FeeGrant allows the grantee's transaction fees to be paid by the granter, up to the spend limit and until
+// the expiration block height (zero means never).
+type FeeGrant struct {
+	Granter    sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	SpendLimit sdk.Coins      `json:"spend_limit" yaml:"spend_limit"`
+	Expiration int64          `json:"expiration,omitempty" yaml:"expiration,omitempty"`
+}
+
+func NewFeeGrant(granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration int64) FeeGrant {
+	return FeeGrant{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// IsExpired checks if the grant cannot be used at the block height anymore
+func (g FeeGrant) IsExpired(height int64) bool {
+	return g.Expiration != 0 && g.Expiration <= height
+}
+
+func (g FeeGrant) Validate() error {
+	if g.Granter.Empty() {
+		return fmt.Errorf("granter is missing")
+	}
+	if g.Grantee.Empty() {
+		return fmt.Errorf("grantee is missing")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return fmt.Errorf("granter and grantee are the same")
+	}
+	if !g.SpendLimit.IsValid() || !g.SpendLimit.IsAllPositive() {
+		return fmt.Errorf("invalid spend limit: %s", g.SpendLimit)
+	}
+	if len(g.SpendLimit) != 1 || g.SpendLimit[0].Denom != util.ConfigMainDenom {
+		return fmt.Errorf("spend limit must be in %s only", util.ConfigMainDenom)
+	}
+	if g.Expiration < 0 {
+		return fmt.Errorf("expiration must be non-negative")
+	}
+	return nil
+}
+
+func (g FeeGrant) String() string {
+	return fmt.Sprintf(`Granter:    %s
+Grantee:    %s
+SpendLimit: %s
+Expiration: %d`,
+		g.Granter, g.Grantee, g.SpendLimit, g.Expiration,
+	)
+}
+
+type FeeGrants []FeeGrant
+
+func (gz FeeGrants) String() string {
+	strs := make([]string, len(gz))
+	for i, g := range gz {
+		strs[i] = g.String()
+	}
+	return strings.Join(strs, "\n\n")
+}
+
+// FeeExemption is a sender/recipient pair, transfers from the sender to the recipient are free of charge
+type FeeExemption struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+func NewFeeExemption(sender, recipient sdk.AccAddress) FeeExemption {
+	return FeeExemption{Sender: sender, Recipient: recipient}
+}
+
+func (e FeeExemption) Equals(other FeeExemption) bool {
+	return e.Sender.Equals(other.Sender) && e.Recipient.Equals(other.Recipient)
+}
+
+func (e FeeExemption) Validate() error {
+	if e.Sender.Empty() {
+		return fmt.Errorf("sender is missing")
+	}
+	if e.Recipient.Empty() {
+		return fmt.Errorf("recipient is missing")
+	}
+	if e.Sender.Equals(e.Recipient) {
+		return fmt.Errorf("sender and recipient are the same")
+	}
+	return nil
+}
+
+func (e FeeExemption) String() string {
+	return fmt.Sprintf("%s -> %s", e.Sender, e.Recipient)
+}
+
+type FeeExemptions []FeeExemption
+
+// Contains checks if the list has a pair equal to the one given
+func (ez FeeExemptions) Contains(e FeeExemption) bool {
+	for _, x := range ez {
+		if x.Equals(e) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ez FeeExemptions) Validate() error {
+	for i, e := range ez {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid fee exemption %s: %w", e, err)
+		}
+		if ez[:i].Contains(e) {
+			return fmt.Errorf("duplicate fee exemption %s", e)
+		}
+	}
+	return nil
+}
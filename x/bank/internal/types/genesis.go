@@ -1,19 +1,64 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/arterynetwork/artr/util"
+)
+
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool  `json:"send_enabled" yaml:"send_enabled"`
-	MinSend     int64 `json:"min_send" yaml:"min_send"`
+	SendEnabled bool             `json:"send_enabled" yaml:"send_enabled"`
+	MinSend     int64            `json:"min_send" yaml:"min_send"`
+	TxFee       util.FeeSchedule `json:"tx_fee" yaml:"tx_fee"`
+	// FeeExemptions are sender/recipient pairs transfers between which are free of charge
+	FeeExemptions FeeExemptions `json:"fee_exemptions,omitempty" yaml:"fee_exemptions,omitempty"`
+	// MinStandingOrderInterval is the least interval (in blocks) between standing order transfers
+	MinStandingOrderInterval uint64    `json:"min_standing_order_interval,omitempty" yaml:"min_standing_order_interval,omitempty"`
+	FeeGrants                FeeGrants `json:"fee_grants,omitempty" yaml:"fee_grants,omitempty"`
+	// TransferSettings are accounts' restrictions on incoming transfers (default ones are omitted)
+	TransferSettings []TransferSettings `json:"transfer_settings,omitempty" yaml:"transfer_settings,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool, minSend int64) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled, MinSend: minSend}
+func NewGenesisState(
+	sendEnabled bool, minSend int64, txFee util.FeeSchedule, feeExemptions FeeExemptions,
+	minStandingOrderInterval uint64, feeGrants FeeGrants, transferSettings []TransferSettings,
+) GenesisState {
+	return GenesisState{
+		SendEnabled:              sendEnabled,
+		MinSend:                  minSend,
+		TxFee:                    txFee,
+		FeeExemptions:            feeExemptions,
+		MinStandingOrderInterval: minStandingOrderInterval,
+		FeeGrants:                feeGrants,
+		TransferSettings:         transferSettings,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true, 1000) }
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(true, 1000, util.DefaultFeeSchedule(), nil, DefaultMinStandingOrderInterval, nil, nil)
+}
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if err := data.TxFee.Validate(); err != nil {
+		return fmt.Errorf("invalid tx fee schedule: %w", err)
+	}
+	if err := data.FeeExemptions.Validate(); err != nil {
+		return err
+	}
+	for _, grant := range data.FeeGrants {
+		if err := grant.Validate(); err != nil {
+			return fmt.Errorf("invalid fee grant %s -> %s: %w", grant.Granter, grant.Grantee, err)
+		}
+	}
+	for _, settings := range data.TransferSettings {
+		if err := settings.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer settings: %w", err)
+		}
+	}
+	return nil
+}
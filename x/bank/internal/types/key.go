@@ -1,7 +1,43 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// module name
 	ModuleName   = "artrbank"
 	QuerierRoute = ModuleName
+	StoreKey     = ModuleName
+
+	// StandingOrderHookName is a name of the schedule hook performing standing order transfers
+	StandingOrderHookName = "bank/standing-order"
+
+	// StandingOrderFeeType is a message type a standing order transfer fee is calculated for
+	StandingOrderFeeType = "standing_order"
+)
+
+var (
+	FeeGrantPrefix         = []byte{0x01}
+	TransferSettingsPrefix = []byte{0x02}
 )
+
+// FeeGrantKey returns a store key of a fee grant. Grants are indexed by grantee first, so all grants an account
+// can use are found by a prefix.
+func FeeGrantKey(grantee, granter sdk.AccAddress) []byte {
+	return append(FeeGrantsByGranteeKey(grantee), granter...)
+}
+
+// FeeGrantsByGranteeKey returns a store key prefix of all fee grants for the grantee
+func FeeGrantsByGranteeKey(grantee sdk.AccAddress) []byte {
+	key := make([]byte, len(FeeGrantPrefix)+len(grantee))
+	copy(key, FeeGrantPrefix)
+	copy(key[len(FeeGrantPrefix):], grantee)
+	return key
+}
+
+// TransferSettingsKey returns a store key of an account's transfer settings
+func TransferSettingsKey(addr sdk.AccAddress) []byte {
+	key := make([]byte, len(TransferSettingsPrefix)+len(addr))
+	copy(key, TransferSettingsPrefix)
+	copy(key[len(TransferSettingsPrefix):], addr)
+	return key
+}
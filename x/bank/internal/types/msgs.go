@@ -102,6 +102,242 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// MsgCreateStandingOrder - register a recurring transfer
+type MsgCreateStandingOrder struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	// Start is a block height of the first transfer (optional, one interval from now by default)
+	Start uint64 `json:"start,omitempty" yaml:"start,omitempty"`
+	// Interval between transfers (in blocks)
+	Interval uint64 `json:"interval" yaml:"interval"`
+	// End is the last block height a transfer can be made at (optional)
+	End uint64 `json:"end,omitempty" yaml:"end,omitempty"`
+}
+
+var _ sdk.Msg = MsgCreateStandingOrder{}
+
+// NewMsgCreateStandingOrder - construct a standing order creation msg.
+func NewMsgCreateStandingOrder(sender, recipient sdk.AccAddress, amount sdk.Coins, start, interval, end uint64) MsgCreateStandingOrder {
+	return MsgCreateStandingOrder{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		Start:     start,
+		Interval:  interval,
+		End:       end,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateStandingOrder) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateStandingOrder) Type() string { return "create_standing_order" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateStandingOrder) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if msg.Sender.Equals(msg.Recipient) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender and recipient are the same")
+	}
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.Interval == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "interval must be positive")
+	}
+	if msg.End != 0 && msg.End < msg.Start {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "end (%d) is before start (%d)", msg.End, msg.Start)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateStandingOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateStandingOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgCancelStandingOrder - cancel a recurring transfer
+type MsgCancelStandingOrder struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	ID     uint64         `json:"id" yaml:"id"`
+}
+
+var _ sdk.Msg = MsgCancelStandingOrder{}
+
+// NewMsgCancelStandingOrder - construct a standing order cancellation msg.
+func NewMsgCancelStandingOrder(sender sdk.AccAddress, id uint64) MsgCancelStandingOrder {
+	return MsgCancelStandingOrder{Sender: sender, ID: id}
+}
+
+// Route Implements Msg.
+func (msg MsgCancelStandingOrder) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCancelStandingOrder) Type() string { return "cancel_standing_order" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelStandingOrder) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if msg.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing standing order ID")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelStandingOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelStandingOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgGrantFee - let the grantee's transaction fees be paid by the granter
+type MsgGrantFee struct {
+	Granter    sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	SpendLimit sdk.Coins      `json:"spend_limit" yaml:"spend_limit"`
+	// Expiration is a block height the grant expires at (optional)
+	Expiration int64 `json:"expiration,omitempty" yaml:"expiration,omitempty"`
+}
+
+var _ sdk.Msg = MsgGrantFee{}
+
+// NewMsgGrantFee - construct a fee grant msg.
+func NewMsgGrantFee(granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration int64) MsgGrantFee {
+	return MsgGrantFee{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgGrantFee) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgGrantFee) Type() string { return "grant_fee" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrantFee) ValidateBasic() error {
+	if err := NewFeeGrant(msg.Granter, msg.Grantee, msg.SpendLimit, msg.Expiration).Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrantFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrantFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeFeeGrant - revoke a fee grant
+type MsgRevokeFeeGrant struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+var _ sdk.Msg = MsgRevokeFeeGrant{}
+
+// NewMsgRevokeFeeGrant - construct a fee grant revocation msg.
+func NewMsgRevokeFeeGrant(granter, grantee sdk.AccAddress) MsgRevokeFeeGrant {
+	return MsgRevokeFeeGrant{Granter: granter, Grantee: grantee}
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeFeeGrant) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevokeFeeGrant) Type() string { return "revoke_fee_grant" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeFeeGrant) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeFeeGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeFeeGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgSetTransferSettings - set restrictions on incoming transfers to the account
+type MsgSetTransferSettings struct {
+	Address         sdk.AccAddress `json:"address" yaml:"address"`
+	MemoRequired    bool           `json:"memo_required" yaml:"memo_required"`
+	ReceiveDisabled bool           `json:"receive_disabled" yaml:"receive_disabled"`
+}
+
+var _ sdk.Msg = MsgSetTransferSettings{}
+
+// NewMsgSetTransferSettings - construct a transfer settings msg.
+func NewMsgSetTransferSettings(addr sdk.AccAddress, memoRequired, receiveDisabled bool) MsgSetTransferSettings {
+	return MsgSetTransferSettings{
+		Address:         addr,
+		MemoRequired:    memoRequired,
+		ReceiveDisabled: receiveDisabled,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetTransferSettings) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetTransferSettings) Type() string { return "set_transfer_settings" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetTransferSettings) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetTransferSettings) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetTransferSettings) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
 // Input models transaction input
 type Input struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
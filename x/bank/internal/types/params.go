@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/arterynetwork/artr/util"
 )
 
 const (
@@ -11,17 +13,25 @@ const (
 	DefaultParamspace = ModuleName
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
+	// DefaultMinStandingOrderInterval is one transfer a day
+	DefaultMinStandingOrderInterval uint64 = util.BlocksOneDay
 )
 
 // ParamStoreKeySendEnabled is store's key for SendEnabled
 var ParamStoreKeySendEnabled = []byte("sendenabled")
 var ParamStoreKeyMinSend = []byte("minsend")
+var ParamStoreKeyTxFee = []byte("txfee")
+var ParamStoreKeyFeeExemptions = []byte("feeexemptions")
+var ParamStoreKeyMinStandingOrderInterval = []byte("minstandingorderinterval")
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		params.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
 		params.NewParamSetPair(ParamStoreKeyMinSend, int64(0), validateMinSend),
+		params.NewParamSetPair(ParamStoreKeyTxFee, util.FeeSchedule{}, validateTxFee),
+		params.NewParamSetPair(ParamStoreKeyFeeExemptions, FeeExemptions{}, validateFeeExemptions),
+		params.NewParamSetPair(ParamStoreKeyMinStandingOrderInterval, uint64(0), validateMinStandingOrderInterval),
 	)
 }
 
@@ -42,3 +52,30 @@ func validateMinSend(i interface{}) error {
 
 	return nil
 }
+
+func validateTxFee(i interface{}) error {
+	schedule, ok := i.(util.FeeSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return schedule.Validate()
+}
+
+func validateFeeExemptions(i interface{}) error {
+	exemptions, ok := i.(FeeExemptions)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return exemptions.Validate()
+}
+
+func validateMinStandingOrderInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/util"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -15,13 +19,75 @@ func NewQueryBalanceParams(addr sdk.AccAddress) QueryBalanceParams {
 }
 
 type QueryResParams struct {
-	SendEnabled bool  `json:"send_enabled" yaml:"send_enabled"`
-	MinSend     int64 `json:"min_send" yaml:"min_send"`
+	SendEnabled   bool             `json:"send_enabled" yaml:"send_enabled"`
+	MinSend       int64            `json:"min_send" yaml:"min_send"`
+	TxFee         util.FeeSchedule `json:"tx_fee" yaml:"tx_fee"`
+	FeeExemptions FeeExemptions    `json:"fee_exemptions" yaml:"fee_exemptions"`
 }
 
-func NewQueryResParams(sendEnabled bool, minSend int64) QueryResParams {
+func NewQueryResParams(sendEnabled bool, minSend int64, txFee util.FeeSchedule, feeExemptions FeeExemptions) QueryResParams {
 	return QueryResParams{
-		SendEnabled: sendEnabled,
-		MinSend:     minSend,
+		SendEnabled:   sendEnabled,
+		MinSend:       minSend,
+		TxFee:         txFee,
+		FeeExemptions: feeExemptions,
+	}
+}
+
+// QueryFeeParams defines the params for a transaction fee estimation.
+type QueryFeeParams struct {
+	Amount  sdk.Coins `json:"amount" yaml:"amount"`
+	MsgType string    `json:"msg_type,omitempty" yaml:"msg_type,omitempty"`
+}
+
+func NewQueryFeeParams(amount sdk.Coins, msgType string) QueryFeeParams {
+	return QueryFeeParams{Amount: amount, MsgType: msgType}
+}
+
+type QueryResFee struct {
+	Fee sdk.Coins `json:"fee" yaml:"fee"`
+}
+
+func (r QueryResFee) String() string { return r.Fee.String() }
+
+// QueryStandingOrdersParams defines the params for querying an account's standing orders.
+type QueryStandingOrdersParams struct {
+	Address sdk.AccAddress
+}
+
+func NewQueryStandingOrdersParams(addr sdk.AccAddress) QueryStandingOrdersParams {
+	return QueryStandingOrdersParams{Address: addr}
+}
+
+type QueryResStandingOrders []StandingOrderInfo
+
+func (r QueryResStandingOrders) String() string {
+	if len(r) == 0 {
+		return "[]"
+	}
+	parts := make([]string, len(r))
+	for i, info := range r {
+		parts[i] = info.String()
 	}
+	return strings.Join(parts, "\n\n")
+}
+
+// QueryFeeGrantsParams defines the params for querying fee grants an account is the granter or the grantee of.
+type QueryFeeGrantsParams struct {
+	Address sdk.AccAddress
+}
+
+func NewQueryFeeGrantsParams(addr sdk.AccAddress) QueryFeeGrantsParams {
+	return QueryFeeGrantsParams{Address: addr}
+}
+
+type QueryResFeeGrants = FeeGrants
+
+// QueryTransferSettingsParams defines the params for querying an account's transfer settings.
+type QueryTransferSettingsParams struct {
+	Address sdk.AccAddress
+}
+
+func NewQueryTransferSettingsParams(addr sdk.AccAddress) QueryTransferSettingsParams {
+	return QueryTransferSettingsParams{Address: addr}
 }
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StandingOrder is a recurring transfer. It's kept in the payload of a recurring x/schedule task, which performs it.
+type StandingOrder struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func NewStandingOrder(sender, recipient sdk.AccAddress, amount sdk.Coins) StandingOrder {
+	return StandingOrder{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// StandingOrderInfo is a standing order along with its timing
+type StandingOrderInfo struct {
+	ID    uint64        `json:"id" yaml:"id"`
+	Order StandingOrder `json:"order" yaml:"order"`
+	// Interval between transfers (in blocks)
+	Interval uint64 `json:"interval" yaml:"interval"`
+	// End is the last block height a transfer can be made at (0 means never)
+	End uint64 `json:"end,omitempty" yaml:"end,omitempty"`
+	// Next is a block height of the next transfer
+	Next uint64 `json:"next" yaml:"next"`
+}
+
+func (i StandingOrderInfo) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf(
+		"ID: %d\nRecipient: %s\nAmount: %s\nInterval: %d\nNext: %d",
+		i.ID, i.Order.Recipient, i.Order.Amount, i.Interval, i.Next,
+	))
+	if i.End != 0 {
+		sb.WriteString(fmt.Sprintf("\nEnd: %d", i.End))
+	}
+	return sb.String()
+}
+
+// Bytes returns the order encoded as a recurring task payload
+func (o StandingOrder) Bytes() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(o)
+}
+
+// StandingOrderFromBytes decodes a recurring task payload
+func StandingOrderFromBytes(bz []byte) (StandingOrder, error) {
+	var o StandingOrder
+	err := ModuleCdc.UnmarshalBinaryBare(bz, &o)
+	return o, err
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferSettings are restrictions an account opts into for incoming transfers
+type TransferSettings struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	// MemoRequired - transfers to the account must have a memo (e.g. exchanges use it to identify deposits)
+	MemoRequired bool `json:"memo_required,omitempty" yaml:"memo_required,omitempty"`
+	// ReceiveDisabled - the account cannot receive transfers at all
+	ReceiveDisabled bool `json:"receive_disabled,omitempty" yaml:"receive_disabled,omitempty"`
+}
+
+func NewTransferSettings(addr sdk.AccAddress, memoRequired, receiveDisabled bool) TransferSettings {
+	return TransferSettings{
+		Address:         addr,
+		MemoRequired:    memoRequired,
+		ReceiveDisabled: receiveDisabled,
+	}
+}
+
+// IsDefault checks if the settings impose no restrictions
+func (s TransferSettings) IsDefault() bool {
+	return !s.MemoRequired && !s.ReceiveDisabled
+}
+
+func (s TransferSettings) Validate() error {
+	if s.Address.Empty() {
+		return fmt.Errorf("address is missing")
+	}
+	return nil
+}
+
+func (s TransferSettings) String() string {
+	return fmt.Sprintf(`Address:         %s
+MemoRequired:    %t
+ReceiveDisabled: %t`,
+		s.Address, s.MemoRequired, s.ReceiveDisabled,
+	)
+}
@@ -0,0 +1,44 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/x/bank/internal/keeper"
+	"github.com/arterynetwork/artr/x/bank/internal/types"
+)
+
+// NewAccountMigratedHook returns the bank MigrateAccount callback, to be called when an account is moved to a new
+// address. It rewrites the account's standing orders, both the ones it sends and the ones it receives, re-keys
+// fee grants it's the granter or the grantee of, and moves its transfer settings.
+func NewAccountMigratedHook(k keeper.Keeper, schk types.ScheduleKeeper) func(ctx sdk.Context, from, to sdk.AccAddress) error {
+	return func(ctx sdk.Context, from, to sdk.AccAddress) error {
+		if err := migrateStandingOrders(ctx, schk, from, to); err != nil {
+			return err
+		}
+		k.MigrateFeeGrants(ctx, from, to)
+		k.MigrateTransferSettings(ctx, from, to)
+		return nil
+	}
+}
+
+func migrateStandingOrders(ctx sdk.Context, schk types.ScheduleKeeper, from, to sdk.AccAddress) error {
+	for _, rt := range schk.GetRecurringTasksByHandler(ctx, types.StandingOrderHookName) {
+		order, err := types.StandingOrderFromBytes(rt.Data)
+		if err != nil {
+			return err
+		}
+		if !order.Sender.Equals(from) && !order.Recipient.Equals(from) {
+			continue
+		}
+		if order.Sender.Equals(from) {
+			order.Sender = to
+		}
+		if order.Recipient.Equals(from) {
+			order.Recipient = to
+		}
+		if err := schk.SetRecurringTaskData(ctx, rt.ID, order.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -74,18 +74,20 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper        Keeper
-	accountKeeper types.AccountKeeper
-	supplyKeeper  types.SupplyKeeper
+	keeper         Keeper
+	accountKeeper  types.AccountKeeper
+	supplyKeeper   types.SupplyKeeper
+	scheduleKeeper types.ScheduleKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, accountKeeper types.AccountKeeper, supplyKeeper types.SupplyKeeper) AppModule {
+func NewAppModule(keeper Keeper, accountKeeper types.AccountKeeper, supplyKeeper types.SupplyKeeper, scheduleKeeper types.ScheduleKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
 		accountKeeper:  accountKeeper,
 		supplyKeeper:   supplyKeeper,
+		scheduleKeeper: scheduleKeeper,
 	}
 }
 
@@ -101,14 +103,14 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 func (AppModule) Route() string { return RouterKey }
 
 // NewHandler returns an sdk.Handler for the bank module.
-func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.keeper, am.supplyKeeper, am.accountKeeper) }
+func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.keeper, am.supplyKeeper, am.accountKeeper, am.scheduleKeeper) }
 
 // QuerierRoute returns the bank module's querier route name.
 func (AppModule) QuerierRoute() string { return RouterKey }
 
 // NewQuerierHandler returns the bank module sdk.Querier.
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return keeper.NewQuerier(am.keeper)
+	return keeper.NewQuerier(am.keeper, am.scheduleKeeper)
 }
 
 // InitGenesis performs genesis initialization for the bank module. It returns
@@ -0,0 +1,138 @@
+package bank
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/bank/internal/keeper"
+	"github.com/arterynetwork/artr/x/bank/internal/types"
+)
+
+// Handle MsgCreateStandingOrder.
+func handleMsgCreateStandingOrder(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, schk types.ScheduleKeeper, msg types.MsgCreateStandingOrder) (*sdk.Result, error) {
+	if min := k.GetMinStandingOrderInterval(ctx); msg.Interval < min {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "interval must be at least %d blocks", min)
+	}
+	if err := checkTransfer(ctx, k, ak, msg.Recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	start := msg.Start
+	if start == 0 {
+		start = uint64(ctx.BlockHeight()) + msg.Interval
+	}
+	data := types.NewStandingOrder(msg.Sender, msg.Recipient, msg.Amount).Bytes()
+	id, err := schk.ScheduleRecurringTask(ctx, types.StandingOrderHookName, &data, start, msg.Interval, msg.End)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStandingOrderCreated,
+			sdk.NewAttribute(types.AttributeKeyStandingOrder, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle MsgCancelStandingOrder.
+func handleMsgCancelStandingOrder(ctx sdk.Context, schk types.ScheduleKeeper, msg types.MsgCancelStandingOrder) (*sdk.Result, error) {
+	if _, err := getStandingOrder(ctx, schk, msg.Sender, msg.ID); err != nil {
+		return nil, err
+	}
+	if err := schk.CancelRecurringTask(ctx, msg.ID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStandingOrderCanceled,
+			sdk.NewAttribute(types.AttributeKeyStandingOrder, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// getStandingOrder returns a standing order by its ID, if it belongs to the sender
+func getStandingOrder(ctx sdk.Context, schk types.ScheduleKeeper, sender sdk.AccAddress, id uint64) (types.StandingOrder, error) {
+	rt, found := schk.GetRecurringTask(ctx, id)
+	if !found || rt.HandlerName != types.StandingOrderHookName {
+		return types.StandingOrder{}, sdkerrors.Wrapf(types.ErrNoStandingOrder, "id: %d", id)
+	}
+	order, err := types.StandingOrderFromBytes(rt.Data)
+	if err != nil {
+		return types.StandingOrder{}, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if !order.Sender.Equals(sender) {
+		return types.StandingOrder{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the standing order belongs to another account")
+	}
+	return order, nil
+}
+
+// NewStandingOrderHook returns a schedule hook performing a standing order transfer. A transfer that cannot be made
+// (e.g. due to insufficient funds) is skipped with a failure event, the order itself remains in force.
+func NewStandingOrderHook(k keeper.Keeper, sk types.SupplyKeeper, ak types.AccountKeeper) func(ctx sdk.Context, data []byte) {
+	return func(ctx sdk.Context, data []byte) {
+		order, err := types.StandingOrderFromBytes(data)
+		if err != nil {
+			panic(err)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := performStandingOrder(cacheCtx, k, sk, ak, order); err != nil {
+			logger(ctx).Info("standing order failed",
+				"sender", order.Sender,
+				"recipient", order.Recipient,
+				"amount", order.Amount,
+				"error", err,
+			)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeStandingOrderFailed,
+				sdk.NewAttribute(types.AttributeKeySender, order.Sender.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, order.Recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, order.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			return
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeStandingOrderExecuted,
+			sdk.NewAttribute(types.AttributeKeySender, order.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, order.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, order.Amount.String()),
+		))
+	}
+}
+
+func performStandingOrder(ctx sdk.Context, k keeper.Keeper, sk types.SupplyKeeper, ak types.AccountKeeper, order types.StandingOrder) error {
+	if err := checkTransfer(ctx, k, ak, order.Recipient, order.Amount); err != nil {
+		return err
+	}
+
+	amount := order.Amount.AmountOf(util.ConfigMainDenom)
+	if _, err := util.PayTxFee(ctx, k, sk, logger(ctx), order.Sender, order.Recipient, amount, types.StandingOrderFeeType); err != nil {
+		return err
+	}
+	return k.SendCoins(ctx, order.Sender, order.Recipient, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, amount)))
+}
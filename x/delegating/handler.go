@@ -10,12 +10,12 @@ import (
 )
 
 // NewHandler creates an sdk.Handler for all the delegating type messages
-func NewHandler(k Keeper, supplyKeeper types.SupplyKeeper) sdk.Handler {
+func NewHandler(k Keeper, bankKeeper types.BankKeeper, supplyKeeper types.SupplyKeeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
 		case MsgDelegate:
-			return handleMsgDelegate(ctx, k, supplyKeeper, msg)
+			return handleMsgDelegate(ctx, k, bankKeeper, supplyKeeper, msg)
 		case MsgRevoke:
 			return handleMsgRevoke(ctx, k, msg)
 		default:
@@ -25,7 +25,7 @@ func NewHandler(k Keeper, supplyKeeper types.SupplyKeeper) sdk.Handler {
 	}
 }
 
-func handleMsgDelegate(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, msg MsgDelegate) (*sdk.Result, error) {
+func handleMsgDelegate(ctx sdk.Context, k Keeper, bankKeeper types.BankKeeper, supplyKeeper types.SupplyKeeper, msg MsgDelegate) (*sdk.Result, error) {
 	amount := msg.MicroCoins
 	minCoins := sdk.NewInt(k.GetParams(ctx).MinDelegate)
 
@@ -33,7 +33,7 @@ func handleMsgDelegate(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeepe
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount is less than minimum allowed")
 	}
 
-	fee, err := util.PayTxFee(ctx, supplyKeeper, k.Logger(ctx), msg.Acc, msg.MicroCoins)
+	fee, err := util.PayTxFee(ctx, bankKeeper, supplyKeeper, k.Logger(ctx), msg.Acc, nil, msg.MicroCoins, msg.Type())
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +41,7 @@ func (s *HandlerSuite) SetupTest() {
 	s.k = s.app.GetDelegatingKeeper()
 	s.supplyKeeper = s.app.GetSupplyKeeper()
 	s.accKeeper = s.app.GetAccountKeeper()
-	s.handler = delegating.NewHandler(s.k, s.supplyKeeper)
+	s.handler = delegating.NewHandler(s.k, s.app.GetBankKeeper(), s.supplyKeeper)
 }
 
 func (s *HandlerSuite) TearDownTest() { s.cleanup() }
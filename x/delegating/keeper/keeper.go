@@ -119,18 +119,26 @@ func (k Keeper) Revoke(ctx sdk.Context, acc sdk.AccAddress, uartrs sdk.Int) erro
 		}
 	}
 
-	height := ctx.BlockHeight() + twoWeeks
-	item.Requests = append(item.Requests, types.RevokeRequest{
-		HeightToImplementAt: height,
-		MicroCoins:          uartrs,
-	})
+	req := types.RevokeRequest{MicroCoins: uartrs}
+	period := k.GetParams(ctx).RevokePeriod
+	implementAt := ctx.BlockTime().Add(period)
+	if period > 0 {
+		req.TimeToImplementAt = implementAt.Unix()
+	} else {
+		req.HeightToImplementAt = ctx.BlockHeight() + twoWeeks
+	}
+	item.Requests = append(item.Requests, req)
 	byteItem, err = k.cdc.MarshalBinaryLengthPrefixed(item)
 	if err != nil {
 		k.Logger(ctx).Error(err.Error())
 		return err
 	}
 	store.Set(byteKey, byteItem)
-	err = k.scheduleKeeper.ScheduleTask(ctx, uint64(height), types.RevokeHookName, &byteKey)
+	if period > 0 {
+		_, err = k.scheduleKeeper.ScheduleTaskAt(ctx, implementAt, types.RevokeHookName, &byteKey)
+	} else {
+		_, err = k.scheduleKeeper.ScheduleTask(ctx, uint64(req.HeightToImplementAt), types.RevokeHookName, &byteKey)
+	}
 	if err != nil {
 		k.Logger(ctx).Error(err.Error())
 		return err
@@ -310,6 +318,37 @@ func (k Keeper) GetAccumulation(ctx sdk.Context, acc sdk.AccAddress) (types.Quer
 	return result, nil
 }
 
+// MigrateAccount moves an account's delegation record (accrual cluster and pending revoke requests) to a new address.
+// Delegated coins themselves are supposed to be moved by the caller. Scheduled revoke tasks are *NOT* affected.
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	var (
+		store = ctx.KVStore(k.mainStoreKey)
+
+		item types.Record
+	)
+	if !store.Has([]byte(from)) {
+		return nil
+	}
+	if store.Has([]byte(to)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s already has a delegation record", to)
+	}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(store.Get([]byte(from)), &item); err != nil {
+		return err
+	}
+
+	if item.Cluster != never {
+		if err := k.dropFromCluster(ctx, item.Cluster, from); err != nil {
+			return err
+		}
+		if err := k.addToCluster(ctx, item.Cluster, to); err != nil {
+			return err
+		}
+	}
+	store.Delete([]byte(from))
+	store.Set([]byte(to), k.cdc.MustMarshalBinaryLengthPrefixed(item))
+	return nil
+}
+
 //----------------------------------------------------------------------------------------------------------------------
 // PRIVATE FUNCTIONS
 
@@ -331,25 +370,25 @@ func (k Keeper) performRevoking(ctx sdk.Context, payload []byte) error {
 	if err = k.cdc.UnmarshalBinaryLengthPrefixed(byteItem, &item); err != nil {
 		return err
 	}
-	sort.Slice(item.Requests, func(i, j int) bool {
+	sort.SliceStable(item.Requests, func(i, j int) bool {
 		return item.Requests[i].HeightToImplementAt < item.Requests[j].HeightToImplementAt
 	})
 
-	n := 0
+	pending := make([]types.RevokeRequest, 0, len(item.Requests))
 	for _, req := range item.Requests {
-		if req.HeightToImplementAt > ctx.BlockHeight() {
-			break
+		if !req.IsDue(ctx) {
+			pending = append(pending, req)
+			continue
 		}
 
 		if err = k.undelegate(ctx, acc, req.MicroCoins); err != nil {
 			return err
 		}
-		n += 1
 	}
-	if n == 0 {
+	if len(pending) == len(item.Requests) {
 		return nil
 	}
-	item.Requests = item.Requests[n:]
+	item.Requests = pending
 	if item.IsEmpty() {
 		mainStore.Delete(byteKey)
 	} else {
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -84,6 +85,46 @@ func (s *Suite) TestDelegatingAndRevoking() {
 	s.Empty(revoking)
 }
 
+func (s *Suite) TestRevoking_WallClock() {
+	user := app.DefaultGenesisUsers["user1"]
+	params := s.k.GetParams(s.ctx)
+	params.RevokePeriod = 14 * 24 * time.Hour
+	s.k.SetParams(s.ctx, params)
+
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockHeight(1).WithBlockTime(t0)
+
+	s.NoError(s.k.Delegate(s.ctx, user, sdk.NewInt(1000000000)))
+	s.NoError(s.k.Revoke(s.ctx, user, sdk.NewInt(850000000)))
+	revoking, err := s.k.GetRevoking(s.ctx, user)
+	s.NoError(err)
+	implementAt := t0.Add(14 * 24 * time.Hour)
+	s.Equal(
+		[]types.RevokeRequest{{
+			TimeToImplementAt: implementAt.Unix(),
+			MicroCoins:        sdk.NewInt(850000000),
+		}},
+		revoking,
+	)
+
+	s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(implementAt.Add(-time.Second))
+	s.nextBlock()
+	s.Equal(
+		sdk.NewCoins(sdk.NewCoin(util.ConfigRevokingDenom, sdk.NewInt(850000000))),
+		s.accKeeper.GetAccount(s.ctx, user).GetCoins(),
+	)
+
+	s.ctx = s.ctx.WithBlockTime(implementAt)
+	s.nextBlock()
+	s.Equal(
+		sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(850000000))),
+		s.accKeeper.GetAccount(s.ctx, user).GetCoins(),
+	)
+	revoking, err = s.k.GetRevoking(s.ctx, user)
+	s.NoError(err)
+	s.Empty(revoking)
+}
+
 func (s *Suite) TestAccrueAfterRevoke() {
 	user := app.DefaultGenesisUsers["user1"]
 	s.Equal(
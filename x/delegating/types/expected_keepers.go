@@ -1,11 +1,14 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	supply "github.com/cosmos/cosmos-sdk/x/supply/exported"
 
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/bank"
 	profile "github.com/arterynetwork/artr/x/profile/types"
 	referral "github.com/arterynetwork/artr/x/referral/types"
@@ -25,7 +28,8 @@ type AccountKeeper interface {
 }
 
 type ScheduleKeeper interface {
-	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) error
+	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) (uint64, error)
+	ScheduleTaskAt(ctx sdk.Context, t time.Time, event string, data *[]byte) (uint64, error)
 	GetParams(ctx sdk.Context) schedule.Params
 }
 
@@ -40,6 +44,7 @@ type BankKeeper interface {
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error)
 	InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) error
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error)
+	util.FeeKeeper
 }
 
 type ProfileKeeper interface {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -20,8 +22,9 @@ const (
 
 // Parameter store keys
 var (
-	KeyPercentage  = []byte("Percentage")
-	KeyMinDelegate = []byte("MinDelegate")
+	KeyPercentage   = []byte("Percentage")
+	KeyMinDelegate  = []byte("MinDelegate")
+	KeyRevokePeriod = []byte("RevokePeriod")
 )
 
 // ParamKeyTable for delegating module
@@ -51,13 +54,16 @@ func (p Percentage) Validate() error { return validatePercentage(p) }
 type Params struct {
 	Percentage  Percentage `json:"percentage" yaml:"percentage"`
 	MinDelegate int64      `json:"min_delegate" yaml:"min_delegate"`
+	// RevokePeriod is a wall-clock time revoking takes. Zero means it's measured in blocks (two weeks of them).
+	RevokePeriod time.Duration `json:"revoke_period" yaml:"revoke_period"`
 }
 
 // NewParams creates a new Params object
-func NewParams(percentage Percentage, minDelegate int64) Params {
+func NewParams(percentage Percentage, minDelegate int64, revokePeriod time.Duration) Params {
 	return Params{
-		Percentage:  percentage,
-		MinDelegate: minDelegate,
+		Percentage:   percentage,
+		MinDelegate:  minDelegate,
+		RevokePeriod: revokePeriod,
 	}
 }
 
@@ -66,6 +72,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyPercentage, &p.Percentage, validatePercentage),
 		params.NewParamSetPair(KeyMinDelegate, &p.MinDelegate, validateMinDelegate),
+		params.NewParamSetPair(KeyRevokePeriod, &p.RevokePeriod, validateRevokePeriod),
 	}
 }
 
@@ -79,6 +86,7 @@ func DefaultParams() Params {
 			DefaultHundredKPlusPercent,
 		),
 		DefaultMinDelegate,
+		0,
 	)
 }
 
@@ -89,6 +97,9 @@ func (p Params) Validate() error {
 	if err := validateMinDelegate(p.MinDelegate); err != nil {
 		return errors.Wrap(err, "invalid MinDelegate")
 	}
+	if err := validateRevokePeriod(p.RevokePeriod); err != nil {
+		return errors.Wrap(err, "invalid RevokePeriod")
+	}
 	return nil
 }
 
@@ -131,3 +142,14 @@ func validateMinDelegate(i interface{}) error {
 	}
 	return nil
 }
+
+func validateRevokePeriod(i interface{}) error {
+	rp, ok := i.(time.Duration)
+	if !ok {
+		return errors.Errorf("invalid RevokePeriod parameter type: %T", i)
+	}
+	if rp < 0 {
+		return errors.New("revoke period is negative")
+	}
+	return nil
+}
@@ -5,6 +5,17 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 type RevokeRequest struct {
 	HeightToImplementAt int64   `json:"height"`
 	MicroCoins          sdk.Int `json:"ucoins"`
+	// TimeToImplementAt (Unix time, in seconds) is set instead of HeightToImplementAt if the revoke period is
+	// measured in wall-clock time
+	TimeToImplementAt int64 `json:"time,omitempty"`
+}
+
+// IsDue checks if it's time to implement the request
+func (r RevokeRequest) IsDue(ctx sdk.Context) bool {
+	if r.TimeToImplementAt != 0 {
+		return ctx.BlockTime().Unix() >= r.TimeToImplementAt
+	}
+	return r.HeightToImplementAt <= ctx.BlockHeight()
 }
 
 type Record struct {
@@ -6,13 +6,16 @@ import (
 )
 
 const (
-	ModuleName        = types.ModuleName
-	RouterKey         = types.RouterKey
-	StoreKey          = types.StoreKey
-	DefaultParamspace = types.DefaultParamspace
-	QuerierRoute      = types.QuerierRoute
-	StartHookName     = types.StartHookName
-	ContinueHookName  = types.ContinueHookName
+	ModuleName          = types.ModuleName
+	RouterKey           = types.RouterKey
+	StoreKey            = types.StoreKey
+	DefaultParamspace   = types.DefaultParamspace
+	QuerierRoute        = types.QuerierRoute
+	StartHookName       = types.StartHookName
+	ContinueHookName    = types.ContinueHookName
+	AttestedHookName    = types.AttestedHookName
+	SnapshotHookName    = types.SnapshotHookName
+	ClaimsCloseHookName = types.ClaimsCloseHookName
 )
 
 var (
@@ -25,6 +28,13 @@ var (
 	ValidateGenesis     = types.ValidateGenesis
 	NewEarner           = types.NewEarner
 	NewPoints           = types.NewPoints
+	NewClaimPeriod      = types.NewClaimPeriod
+	NewSnapshot         = types.NewSnapshot
+	NewPeriodSummary    = types.NewPeriodSummary
+	NewPayout           = types.NewPayout
+	ClaimLeaf           = types.ClaimLeaf
+	ClaimTree           = types.ClaimTree
+	ClaimRoot           = types.ClaimRoot
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
@@ -34,13 +44,34 @@ var (
 	ErrLocked        = types.ErrLocked
 	ErrNotLocked     = types.ErrNotLocked
 	ErrNoMoney       = types.ErrNoMoney
+	ErrNoClaimPeriod = types.ErrNoClaimPeriod
+	ErrTooEarly      = types.ErrTooEarly
+	ErrClaimed       = types.ErrClaimed
+	ErrInvalidProof  = types.ErrInvalidProof
+
+	ErrAlreadyApproved     = types.ErrAlreadyApproved
+	ErrAttestationNotFound = types.ErrAttestationNotFound
+	ErrListChanged         = types.ErrListChanged
+
+	ErrSnapshotNotFound = types.ErrSnapshotNotFound
+	ErrSnapshotUsed     = types.ErrSnapshotUsed
+
+	ErrPeriodNotFound = types.ErrPeriodNotFound
+
+	ErrClaimPeriodOver = types.ErrClaimPeriodOver
+	ErrFundExhausted   = types.ErrFundExhausted
 )
 
 type (
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
-	Params       = types.Params
-	StateParams  = types.StateParams
-	Earner       = types.Earner
-	Points       = types.Points
+	Keeper        = keeper.Keeper
+	GenesisState  = types.GenesisState
+	Params        = types.Params
+	StateParams   = types.StateParams
+	Earner        = types.Earner
+	Points        = types.Points
+	ClaimPeriod   = types.ClaimPeriod
+	Attestation   = types.Attestation
+	Snapshot      = types.Snapshot
+	PeriodSummary = types.PeriodSummary
+	Payout        = types.Payout
 )
@@ -0,0 +1,11 @@
+package cli
+
+const (
+	FlagLimit = "limit"
+	FlagPage  = "page"
+
+	FlagSnapshot = "snapshot"
+
+	FlagLimitDefault = int(30)
+	FlagPageDefault  = int(1)
+)
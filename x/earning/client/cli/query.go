@@ -2,9 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -28,6 +32,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	earningQueryCmd.AddCommand(
 		flags.GetCommands(
 			getCmdParams(queryRoute, cdc),
+			getCmdClaimPeriod(queryRoute, cdc),
+			getCmdClaimed(queryRoute, cdc),
+			getCmdAttestations(queryRoute, cdc),
+			getCmdSnapshots(queryRoute, cdc),
+			getCmdSnapshot(queryRoute, cdc),
+			getCmdPeriods(queryRoute, cdc),
+			getCmdPeriod(queryRoute, cdc),
+			getCmdPayouts(queryRoute, cdc),
 		)...,
 	)
 
@@ -61,3 +73,210 @@ func getCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdClaimPeriod(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-period",
+		Short: "Get the current claim-based earning period",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryClaimPeriod))
+			if err != nil {
+				return err
+			}
+
+			var out types.ClaimPeriod
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdClaimed(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claimed [address]",
+		Short: "Check if an account has already claimed its earnings in the current claim period",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryClaimedParams(addr))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryClaimed), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryClaimedRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdAttestations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attestations",
+		Short: "Get earning periods waiting for signer approvals or for the challenge window to pass",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAttestations))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryAttestationsRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdSnapshots(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "snapshots",
+		Short: "Get VPN and storage fund snapshots taken at the end of billing periods",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySnapshots))
+			if err != nil {
+				return err
+			}
+
+			var out types.QuerySnapshotsRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdSnapshot(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "snapshot [id]",
+		Short: "Get a VPN and storage fund snapshot by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 0, 64)
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQuerySnapshotParams(id))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySnapshot), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Snapshot
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdPeriods(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "periods",
+		Short: "Query earning period history, the latest first",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz := cdc.MustMarshalJSON(types.NewQueryPeriodsParams(
+				int32(viper.GetInt(FlagLimit)),
+				int32(viper.GetInt(FlagPage)),
+			))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPeriods), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryPeriodsRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of history records per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
+
+func getCmdPeriod(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "period [id]",
+		Short: "Get an earning period summary by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 0, 64)
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryPeriodParams(id))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPeriod), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.PeriodSummary
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdPayouts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payouts [address]",
+		Short: "Query earnings paid to an account, the latest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryPayoutsParams(
+				addr,
+				int32(viper.GetInt(FlagLimit)),
+				int32(viper.GetInt(FlagPage)),
+			))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPayouts), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryPayoutsRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of history records per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
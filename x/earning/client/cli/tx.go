@@ -8,11 +8,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"encoding/hex"
+	"io/ioutil"
 	"strconv"
 
+	"github.com/tendermint/tendermint/crypto/merkle"
+
 	//"bufio"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -22,6 +27,7 @@ import (
 	//"github.com/cosmos/cosmos-sdk/x/auth"
 	//"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/arterynetwork/artr/x/earning/types"
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -39,6 +45,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdListEarners(cdc),
 		GetCmdRun(cdc),
 		GetCmdReset(cdc),
+		GetCmdRunClaims(cdc),
+		GetCmdClaim(cdc),
+		GetCmdChallenge(cdc),
 	)...)
 
 	return earningTxCmd
@@ -61,7 +70,7 @@ func GetCmdListEarners(cdc *codec.Codec) *cobra.Command {
 					vpn, storage int64
 					err          error
 				)
-				if address, err = sdk.AccAddressFromBech32(args[3*i]); err != nil {
+				if address, err = resolver.ResolveAddressCLI(cliCtx, args[3*i]); err != nil {
 					return err
 				}
 				if vpn, err = strconv.ParseInt(args[3*i+1], 0, 64); err != nil {
@@ -90,7 +99,7 @@ func GetCmdListEarners(cdc *codec.Codec) *cobra.Command {
 }
 
 func GetCmdRun(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "run <fund_part> <accounts_per_block> <total_vpn_points> <total_storage_points> <height>",
 		Short: "Lock earner list and schedule distribution for a specified block height",
 		Args:  cobra.ExactArgs(5),
@@ -125,7 +134,7 @@ func GetCmdRun(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgRun(cliCtx.GetFromAddress(), fundPart, perBlock, totalVpn, totalStorage, height)
+			msg := types.NewMsgRun(cliCtx.GetFromAddress(), fundPart, perBlock, totalVpn, totalStorage, height, viper.GetUint64(FlagSnapshot))
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -133,6 +142,8 @@ func GetCmdRun(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().Uint64(FlagSnapshot, 0, "ID of the fund snapshot the period is paid from (required if snapshots are taken)")
+	return cmd
 }
 
 func GetCmdReset(cdc *codec.Codec) *cobra.Command {
@@ -154,3 +165,113 @@ func GetCmdReset(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdRunClaims(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run-claims <fund_part> <root_hex> <total_vpn_points> <total_storage_points> <height>",
+		Short: "Lock funds for an earning period paid by claims, earners can claim from a specified block height",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var (
+				fundPart     util.Fraction
+				root         []byte
+				totalVpn     int64
+				totalStorage int64
+				height       int64
+				err          error
+			)
+			if fundPart, err = util.ParseFraction(args[0]); err != nil {
+				return err
+			}
+			if root, err = hex.DecodeString(args[1]); err != nil {
+				return err
+			}
+			if totalVpn, err = strconv.ParseInt(args[2], 0, 64); err != nil {
+				return err
+			}
+			if totalStorage, err = strconv.ParseInt(args[3], 0, 64); err != nil {
+				return err
+			}
+			if height, err = strconv.ParseInt(args[4], 0, 64); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRunClaims(cliCtx.GetFromAddress(), fundPart, root, totalVpn, totalStorage, height, viper.GetUint64(FlagSnapshot))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Uint64(FlagSnapshot, 0, "ID of the fund snapshot the period is paid from (required if snapshots are taken)")
+	return cmd
+}
+
+func GetCmdClaim(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim <vpn_points> <storage_points> <proof_file>",
+		Short: "Claim earnings for the current claim period, the proof file contains a Merkle proof in JSON",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var (
+				vpn, storage int64
+				proof        merkle.SimpleProof
+				err          error
+			)
+			if vpn, err = strconv.ParseInt(args[0], 0, 64); err != nil {
+				return err
+			}
+			if storage, err = strconv.ParseInt(args[1], 0, 64); err != nil {
+				return err
+			}
+			bz, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			if err = cdc.UnmarshalJSON(bz, &proof); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimEarning(cliCtx.GetFromAddress(), vpn, storage, proof)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdChallenge(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "challenge <hash_hex>",
+		Short: "Discard an earning period waiting for approvals or for the challenge window to pass",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChallenge(cliCtx.GetFromAddress(), hash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -16,15 +16,30 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		panic(err)
 	}
 	k.SetState(ctx, data.State)
+	if data.ClaimPeriod != nil {
+		k.InitClaims(ctx, *data.ClaimPeriod, data.Claimed, data.Migrated)
+	}
+	k.InitAttestations(ctx, data.Attestations)
+	k.InitSnapshots(ctx, data.Snapshots)
+	k.InitHistory(ctx, data.Periods, data.Payouts, data.CurrentPeriod)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	periods, payouts := k.ExportHistory(ctx)
 	return NewGenesisState(
 		k.GetParams(ctx),
 		k.GetState(ctx),
 		k.GetEarners(ctx),
+		k.ExportClaimPeriod(ctx),
+		k.GetClaimed(ctx),
+		k.GetMigrated(ctx),
+		k.GetAttestations(ctx),
+		k.GetSnapshots(ctx),
+		periods,
+		payouts,
+		k.GetCurrentPeriod(ctx),
 	)
 }
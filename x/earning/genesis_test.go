@@ -79,6 +79,7 @@ func (s Suite) TestLocked() {
 		2,
 		earning.NewPoints(30, 45),
 		10,
+		0,
 	); err != nil {
 		panic(err)
 	}
@@ -105,6 +106,7 @@ func (s Suite) TestSecondPage() {
 		2,
 		earning.NewPoints(30, 45),
 		2,
+		0,
 	); err != nil {
 		panic(err)
 	}
@@ -129,6 +131,102 @@ func (s *Suite) TestParams() {
 	s.checkExportImport()
 }
 
+func (s Suite) TestClaimPeriod() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user2 := app.DefaultGenesisUsers["user2"]
+	if err := s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, user1, 5*util.GBSize); err != nil {
+		panic(err)
+	}
+	earners := []earning.Earner{
+		earning.NewEarner(user1, 10, 0),
+		earning.NewEarner(user2, 0, 15),
+	}
+	root, proofs := earning.ClaimTree(earners)
+	if err := s.k.RunClaims(s.ctx, util.NewFraction(7, 30), root, earning.NewPoints(10, 15), 1, 0); err == nil {
+		panic("claims are expected to be too late")
+	}
+	if err := s.k.RunClaims(s.ctx, util.NewFraction(7, 30), root, earning.NewPoints(10, 15), 2, 0); err != nil {
+		panic(err)
+	}
+	if err := s.k.ClaimEarning(s.ctx.WithBlockHeight(2), earners[1], *proofs[1]); err != nil {
+		panic(err)
+	}
+	if err := s.k.MigrateAccount(s.ctx, user1, app.DefaultGenesisUsers["user3"]); err != nil {
+		panic(err)
+	}
+	s.checkExportImport()
+}
+
+func (s Suite) TestAttestations() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user2 := app.DefaultGenesisUsers["user2"]
+	s.k.SetParams(s.ctx, earning.Params{
+		Signers:         []sdk.AccAddress{user1, user2},
+		MinApprovals:    2,
+		ChallengeWindow: 3,
+	})
+	if err := s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user1, 10, 5)}); err != nil {
+		panic(err)
+	}
+	if err := s.k.ApproveRun(s.ctx, user1, util.NewFraction(1, 3), 5, earning.NewPoints(10, 5), 10, 0); err != nil {
+		panic(err)
+	}
+	if err := s.k.ApproveRun(s.ctx, user1, util.NewFraction(1, 4), 5, earning.NewPoints(10, 5), 10, 0); err != nil {
+		panic(err)
+	}
+	if err := s.k.ApproveRun(s.ctx, user2, util.NewFraction(1, 4), 5, earning.NewPoints(10, 5), 10, 0); err != nil {
+		panic(err)
+	}
+	s.checkExportImport()
+}
+
+func (s Suite) TestSnapshots() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	s.k.SetParams(s.ctx, earning.Params{
+		Signers:        []sdk.AccAddress{user1},
+		SnapshotPeriod: 5,
+	})
+	if err := s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, user1, 5*util.GBSize); err != nil {
+		panic(err)
+	}
+	s.k.TakeSnapshot(s.ctx)
+	s.k.TakeSnapshot(s.ctx)
+	if err := s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user1, 10, 5)}); err != nil {
+		panic(err)
+	}
+	if err := s.k.Run(s.ctx, util.NewFraction(1, 3), 5, earning.NewPoints(10, 5), 10, 2); err != nil {
+		panic(err)
+	}
+	s.checkExportImport()
+}
+
+func (s Suite) TestHistory() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user2 := app.DefaultGenesisUsers["user2"]
+	if err := s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, user1, 5*util.GBSize); err != nil {
+		panic(err)
+	}
+	earners := []earning.Earner{
+		earning.NewEarner(user1, 10, 0),
+		earning.NewEarner(user2, 0, 15),
+	}
+	root, proofs := earning.ClaimTree(earners)
+	if err := s.k.RunClaims(s.ctx, util.NewFraction(7, 30), root, earning.NewPoints(10, 15), 2, 0); err != nil {
+		panic(err)
+	}
+	if err := s.k.ClaimEarning(s.ctx.WithBlockHeight(2), earners[1], *proofs[1]); err != nil {
+		panic(err)
+	}
+	s.k.Reset(s.ctx.WithBlockHeight(3))
+	if err := s.k.RunClaims(s.ctx.WithBlockHeight(3), util.NewFraction(7, 30), root, earning.NewPoints(10, 15), 4, 0); err != nil {
+		panic(err)
+	}
+	if err := s.k.ClaimEarning(s.ctx.WithBlockHeight(4), earners[0], *proofs[0]); err != nil {
+		panic(err)
+	}
+	s.checkExportImport()
+}
+
 func (s Suite) checkExportImport() {
 	s.app.CheckExportImport(s.T(),
 		[]string{
@@ -137,7 +235,7 @@ func (s Suite) checkExportImport() {
 			params.StoreKey,
 		},
 		map[string]app.Decoder{
-			earning.StoreKey:  app.AccAddressDecoder,
+			earning.StoreKey:  app.DummyDecoder,
 			schedule.StoreKey: app.Uint64Decoder,
 			params.StoreKey:   app.DummyDecoder,
 		},
@@ -1,6 +1,7 @@
 package earning
 
 import (
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/earning/types"
 	"fmt"
 
@@ -12,6 +13,10 @@ import (
 func NewHandler(k Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		if msg, ok := msg.(types.MsgClaimEarning); ok {
+			// claims are sent by earners themselves
+			return handleMsgClaimEarning(ctx, k, msg)
+		}
 		if err := verifySignature(ctx, k, msg); err != nil {
 			return nil, err
 		}
@@ -22,6 +27,10 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgRun(ctx, k, msg)
 		case types.MsgReset:
 			return handleMsgReset(ctx, k, msg)
+		case types.MsgRunClaims:
+			return handleMsgRunClaims(ctx, k, msg)
+		case types.MsgChallenge:
+			return handleMsgChallenge(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -54,7 +63,13 @@ func handleMsgListEarners(ctx sdk.Context, k Keeper, msg types.MsgListEarners) (
 }
 
 func handleMsgRun(ctx sdk.Context, k Keeper, msg types.MsgRun) (*sdk.Result, error) {
-	err := k.Run(
+	run := k.Run
+	if k.GetParams(ctx).RequiresAttestation() {
+		run = func(ctx sdk.Context, fundPart util.Fraction, perBlock uint16, total types.Points, height int64, snapshotID uint64) error {
+			return k.ApproveRun(ctx, msg.Sender, fundPart, perBlock, total, height, snapshotID)
+		}
+	}
+	err := run(
 		ctx,
 		msg.FundPart,
 		msg.AccountPerBlock,
@@ -63,6 +78,7 @@ func handleMsgRun(ctx sdk.Context, k Keeper, msg types.MsgRun) (*sdk.Result, err
 			Storage: msg.TotalStoragePoints,
 		},
 		msg.Height,
+		msg.SnapshotID,
 	)
 	if err != nil {
 		return nil, err
@@ -71,8 +87,55 @@ func handleMsgRun(ctx sdk.Context, k Keeper, msg types.MsgRun) (*sdk.Result, err
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
-func handleMsgReset(ctx sdk.Context, k Keeper, _ types.MsgReset) (*sdk.Result, error) {
-	k.Reset(ctx)
+func handleMsgReset(ctx sdk.Context, k Keeper, msg types.MsgReset) (*sdk.Result, error) {
+	if k.GetParams(ctx).RequiresAttestation() {
+		if err := k.ApproveReset(ctx, msg.Sender); err != nil {
+			return nil, err
+		}
+	} else {
+		k.Reset(ctx)
+	}
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 
 }
+
+func handleMsgRunClaims(ctx sdk.Context, k Keeper, msg types.MsgRunClaims) (*sdk.Result, error) {
+	run := k.RunClaims
+	if k.GetParams(ctx).RequiresAttestation() {
+		run = func(ctx sdk.Context, fundPart util.Fraction, root []byte, total types.Points, height int64, snapshotID uint64) error {
+			return k.ApproveRunClaims(ctx, msg.Sender, fundPart, root, total, height, snapshotID)
+		}
+	}
+	err := run(
+		ctx,
+		msg.FundPart,
+		msg.Root,
+		types.Points{
+			Vpn:     msg.TotalVpnPoints,
+			Storage: msg.TotalStoragePoints,
+		},
+		msg.Height,
+		msg.SnapshotID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgClaimEarning(ctx sdk.Context, k Keeper, msg types.MsgClaimEarning) (*sdk.Result, error) {
+	if err := k.ClaimEarning(ctx, msg.Earner(), msg.Proof); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgChallenge(ctx sdk.Context, k Keeper, msg types.MsgChallenge) (*sdk.Result, error) {
+	if err := k.Challenge(ctx, msg.Sender, msg.Hash); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
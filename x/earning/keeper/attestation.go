@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/earning/types"
+)
+
+// ApproveRun is what a signer's MsgRun does if earning periods must be attested. The pending earner list is
+// approved as it is at the moment.
+func (k Keeper) ApproveRun(ctx sdk.Context, signer sdk.AccAddress, fundPart util.Fraction, perBlock uint16, total types.Points, height int64, snapshotID uint64) error {
+	return k.approve(ctx, signer, types.Attestation{
+		Kind:     types.AttestationKindList,
+		ListHash: types.ClaimRoot(k.GetEarners(ctx)),
+		FundPart: fundPart,
+		Total:    total,
+		PerBlock: perBlock,
+		Height:   height,
+
+		SnapshotID: snapshotID,
+	})
+}
+
+// ApproveRunClaims is what a signer's MsgRunClaims does if earning periods must be attested
+func (k Keeper) ApproveRunClaims(ctx sdk.Context, signer sdk.AccAddress, fundPart util.Fraction, root []byte, total types.Points, height int64, snapshotID uint64) error {
+	return k.approve(ctx, signer, types.Attestation{
+		Kind:     types.AttestationKindClaims,
+		ListHash: root,
+		FundPart: fundPart,
+		Total:    total,
+		Height:   height,
+
+		SnapshotID: snapshotID,
+	})
+}
+
+// ApproveReset is what a signer's MsgReset does if earning periods must be attested, so that a single signer
+// cannot drop a period in progress
+func (k Keeper) ApproveReset(ctx sdk.Context, signer sdk.AccAddress) error {
+	return k.approve(ctx, signer, types.Attestation{Kind: types.AttestationKindReset})
+}
+
+func (k Keeper) approve(ctx sdk.Context, signer sdk.AccAddress, a types.Attestation) error {
+	params := k.GetParams(ctx)
+	if a.Kind != types.AttestationKindReset {
+		if k.GetState(ctx).Locked || k.HasClaimPeriod(ctx) {
+			return types.ErrLocked
+		}
+		if ctx.BlockHeight()+params.ChallengeWindow >= a.Height {
+			return types.ErrTooLate
+		}
+	}
+
+	hash := a.Hash()
+	if stored, found := k.GetAttestation(ctx, hash); found {
+		a = stored
+	}
+	if a.IsApprovedBy(signer) {
+		return types.ErrAlreadyApproved
+	}
+	a.Approvals = append(a.Approvals, signer)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeApprove,
+		sdk.NewAttribute(types.AttributeKeyHash, fmt.Sprintf("%X", hash)),
+		sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+		sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", len(a.Approvals))),
+	))
+
+	if a.Approved == 0 && k.countApprovals(params, a) >= params.Quorum() {
+		a.Approved = ctx.BlockHeight()
+		k.setAttestation(ctx, hash, a)
+		if params.ChallengeWindow == 0 {
+			return k.runAttested(ctx, hash)
+		}
+		payload := hash
+		if _, err := k.scheduleKeeper.ScheduleTask(ctx, uint64(ctx.BlockHeight()+params.ChallengeWindow), types.AttestedHookName, &payload); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	k.setAttestation(ctx, hash, a)
+	return nil
+}
+
+// Challenge discards an attestation, so that its earning period is never run
+func (k Keeper) Challenge(ctx sdk.Context, signer sdk.AccAddress, hash []byte) error {
+	if _, found := k.GetAttestation(ctx, hash); !found {
+		return sdkerrors.Wrapf(types.ErrAttestationNotFound, "%X", hash)
+	}
+	ctx.KVStore(k.storeKey).Delete(attestationKey(hash))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeChallenge,
+		sdk.NewAttribute(types.AttributeKeyHash, fmt.Sprintf("%X", hash)),
+		sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+	))
+	return nil
+}
+
+// PerformAttested runs an approved earning period after the challenge window passes (unless it's challenged)
+func (k Keeper) PerformAttested(ctx sdk.Context, payload []byte) {
+	if _, found := k.GetAttestation(ctx, payload); !found {
+		// challenged
+		return
+	}
+	// a failed run must not leave half-done changes (e.g. a used snapshot) behind
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.runAttested(cacheCtx, payload); err != nil {
+		ctx.KVStore(k.storeKey).Delete(attestationKey(payload))
+		k.Logger(ctx).Error("cannot run attested earning period", "hash", fmt.Sprintf("%X", payload), "error", err)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAttestationFailed,
+			sdk.NewAttribute(types.AttributeKeyHash, fmt.Sprintf("%X", payload)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		))
+		return
+	}
+	write()
+}
+
+// runAttested runs an approved earning period. The attestation is removed anyway, and all the other ones are
+// removed on success, as they are obsolete.
+func (k Keeper) runAttested(ctx sdk.Context, hash []byte) error {
+	a, _ := k.GetAttestation(ctx, hash)
+	ctx.KVStore(k.storeKey).Delete(attestationKey(hash))
+
+	var err error
+	switch a.Kind {
+	case types.AttestationKindList:
+		if !bytes.Equal(types.ClaimRoot(k.GetEarners(ctx)), a.ListHash) {
+			return types.ErrListChanged
+		}
+		err = k.Run(ctx, a.FundPart, a.PerBlock, a.Total, a.Height, a.SnapshotID)
+	case types.AttestationKindClaims:
+		err = k.RunClaims(ctx, a.FundPart, a.ListHash, a.Total, a.Height, a.SnapshotID)
+	case types.AttestationKindReset:
+		k.Reset(ctx)
+	default:
+		err = fmt.Errorf("unknown attestation kind: %s", a.Kind)
+	}
+	if err != nil {
+		return err
+	}
+
+	k.clearAttestations(ctx)
+	return nil
+}
+
+// countApprovals counts approvals of current signers only (a signer could be removed after approving)
+func (k Keeper) countApprovals(params types.Params, a types.Attestation) int {
+	n := 0
+	for _, signer := range params.Signers {
+		if a.IsApprovedBy(signer) {
+			n++
+		}
+	}
+	return n
+}
+
+func (k Keeper) GetAttestation(ctx sdk.Context, hash []byte) (types.Attestation, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(attestationKey(hash))
+	if bz == nil {
+		return types.Attestation{}, false
+	}
+	var a types.Attestation
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &a)
+	return a, true
+}
+
+// GetAttestations returns all the earning periods waiting for approvals or for the challenge window to pass
+func (k Keeper) GetAttestations(ctx sdk.Context) []types.Attestation {
+	var result []types.Attestation
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AttestationPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var a types.Attestation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &a)
+		result = append(result, a)
+	}
+	return result
+}
+
+func (k Keeper) InitAttestations(ctx sdk.Context, attestations []types.Attestation) {
+	for _, a := range attestations {
+		k.setAttestation(ctx, a.Hash(), a)
+	}
+}
+
+func (k Keeper) setAttestation(ctx sdk.Context, hash []byte, a types.Attestation) {
+	ctx.KVStore(k.storeKey).Set(attestationKey(hash), k.cdc.MustMarshalBinaryLengthPrefixed(a))
+}
+
+func (k Keeper) clearAttestations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	it := sdk.KVStorePrefixIterator(store, types.AttestationPrefix)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func attestationKey(hash []byte) []byte {
+	return append(append([]byte(nil), types.AttestationPrefix...), hash...)
+}
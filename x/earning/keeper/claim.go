@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/earning/types"
+)
+
+// RunClaims locks funds for an earning period paid by claims. Earners can claim from the height on, until the
+// claim window passes.
+func (k Keeper) RunClaims(ctx sdk.Context, fundPart util.Fraction, root []byte, total types.Points, height int64, snapshotID uint64) error {
+	if ctx.BlockHeight() >= height {
+		return types.ErrTooLate
+	}
+	if k.GetState(ctx).Locked || k.HasClaimPeriod(ctx) {
+		return types.ErrLocked
+	}
+
+	vpnPointCost, storagePointCost, err := k.lockFunds(ctx, fundPart, total, snapshotID)
+	if err != nil {
+		return err
+	}
+	end := height + k.GetParams(ctx).ClaimPeriodDuration()
+	k.SetClaimPeriod(ctx, types.NewClaimPeriod(root, vpnPointCost, storagePointCost, total, height, end))
+	k.openPeriod(ctx, types.NewPeriodSummary(types.AttestationKindClaims, vpnPointCost, storagePointCost, total, snapshotID, height))
+	if _, err := k.scheduleKeeper.ScheduleTask(ctx, uint64(end), types.ClaimsCloseHookName, &noPayload); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PerformCloseClaims closes the claim period when its claim window passes
+func (k Keeper) PerformCloseClaims(ctx sdk.Context, _ []byte) {
+	period, found := k.GetClaimPeriod(ctx)
+	if !found || period.End > ctx.BlockHeight() {
+		// the period has been reset, and maybe another one has been run since then
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFinish))
+	k.closeClaimPeriod(ctx)
+	k.finishPeriod(ctx)
+}
+
+// ClaimEarning pays an earner for the current claim period, if the earner record is proven to be in the list
+func (k Keeper) ClaimEarning(ctx sdk.Context, earner types.Earner, proof merkle.SimpleProof) error {
+	period, found := k.GetClaimPeriod(ctx)
+	if !found {
+		return types.ErrNoClaimPeriod
+	}
+	if ctx.BlockHeight() < period.Start {
+		return sdkerrors.Wrapf(types.ErrTooEarly, "claims are accepted from block %d", period.Start)
+	}
+	if ctx.BlockHeight() >= period.End {
+		return sdkerrors.Wrapf(types.ErrClaimPeriodOver, "claims were accepted until block %d", period.End)
+	}
+	if k.IsClaimed(ctx, earner.Account) {
+		return types.ErrClaimed
+	}
+	if err := proof.Verify(period.Root, types.ClaimLeaf(earner)); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidProof, err.Error())
+	}
+
+	vpnAmt := period.VpnPointCost.MulInt64(earner.Vpn).Int64()
+	storageAmt := period.StoragePointCost.MulInt64(earner.Storage).Int64()
+	if period.Paid.Vpn+vpnAmt > period.Fund.Vpn || period.Paid.Storage+storageAmt > period.Fund.Storage {
+		// the root must be wrong, as points of all the earners cannot exceed the total
+		return types.ErrFundExhausted
+	}
+	period.Paid.Vpn += vpnAmt
+	period.Paid.Storage += storageAmt
+	k.SetClaimPeriod(ctx, period)
+
+	recipient := k.claimRecipient(ctx, earner.Account)
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(vpnAmt+storageAmt)))); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEarn,
+		sdk.NewAttribute(types.AttributeKeyAddress, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyVpn, fmt.Sprintf("%d", vpnAmt)),
+		sdk.NewAttribute(types.AttributeKeyStorage, fmt.Sprintf("%d", storageAmt)),
+	))
+	k.recordPayout(ctx, recipient, vpnAmt, storageAmt)
+	k.setClaimed(ctx, earner.Account)
+	return nil
+}
+
+func (k Keeper) GetClaimPeriod(ctx sdk.Context) (types.ClaimPeriod, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClaimPeriodKey)
+	if bz == nil {
+		return types.ClaimPeriod{}, false
+	}
+	var period types.ClaimPeriod
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &period)
+	return period, true
+}
+
+func (k Keeper) SetClaimPeriod(ctx sdk.Context, period types.ClaimPeriod) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClaimPeriodKey, k.cdc.MustMarshalBinaryLengthPrefixed(period))
+}
+
+func (k Keeper) HasClaimPeriod(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ClaimPeriodKey)
+}
+
+// IsClaimed checks if an account has already claimed its earnings in the current claim period
+func (k Keeper) IsClaimed(ctx sdk.Context, acc sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(claimedKey(acc))
+}
+
+// GetClaimed returns all accounts which have already claimed their earnings in the current claim period
+func (k Keeper) GetClaimed(ctx sdk.Context) []sdk.AccAddress {
+	var result []sdk.AccAddress
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ClaimedPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		result = append(result, sdk.AccAddress(it.Key()[len(types.ClaimedPrefix):]))
+	}
+	return result
+}
+
+func (k Keeper) setClaimed(ctx sdk.Context, acc sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(claimedKey(acc), []byte{0x01})
+}
+
+// GetMigrated returns accounts migrated during the current claim period
+func (k Keeper) GetMigrated(ctx sdk.Context) []types.Migration {
+	var result []types.Migration
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MigratedPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		result = append(result, types.Migration{
+			From: sdk.AccAddress(it.Key()[len(types.MigratedPrefix):]),
+			To:   sdk.AccAddress(it.Value()),
+		})
+	}
+	return result
+}
+
+func (k Keeper) setMigrated(ctx sdk.Context, from, to sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(migratedKey(from), to)
+}
+
+// claimRecipient returns an address an earner's claim is paid to, i.e. the earner's latest address if it's been
+// migrated (maybe several times) during the claim period
+func (k Keeper) claimRecipient(ctx sdk.Context, acc sdk.AccAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	for {
+		bz := store.Get(migratedKey(acc))
+		if bz == nil {
+			return acc
+		}
+		acc = bz
+	}
+}
+
+// closeClaimPeriod removes the current claim period, unclaimed funds stay on the module account for the
+// next period
+func (k Keeper) closeClaimPeriod(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	for _, prefix := range [][]byte{types.ClaimedPrefix, types.MigratedPrefix} {
+		it := sdk.KVStorePrefixIterator(store, prefix)
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		it.Close()
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.ClaimPeriodKey)
+}
+
+// ExportClaimPeriod returns the current claim period or nil, if there is none
+func (k Keeper) ExportClaimPeriod(ctx sdk.Context) *types.ClaimPeriod {
+	period, found := k.GetClaimPeriod(ctx)
+	if !found {
+		return nil
+	}
+	return &period
+}
+
+func (k Keeper) InitClaims(ctx sdk.Context, period types.ClaimPeriod, claimed []sdk.AccAddress, migrated []types.Migration) {
+	k.SetClaimPeriod(ctx, period)
+	for _, acc := range claimed {
+		k.setClaimed(ctx, acc)
+	}
+	for _, m := range migrated {
+		k.setMigrated(ctx, m.From, m.To)
+	}
+}
+
+func claimedKey(acc sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.ClaimedPrefix...), acc...)
+}
+
+func migratedKey(acc sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.MigratedPrefix...), acc...)
+}
@@ -9,10 +9,10 @@ import (
 func (k Keeper) GetEarners(ctx sdk.Context) []types.Earner {
 	var result []types.Earner
 	store := ctx.KVStore(k.storeKey)
-	it := store.Iterator(nil, nil)
+	it := sdk.KVStorePrefixIterator(store, types.EarnerPrefix)
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
-		acc := sdk.AccAddress(it.Key())
+		acc := sdk.AccAddress(it.Key()[len(types.EarnerPrefix):])
 		var points types.Points
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &points)
 		result = append(result, types.Earner{
@@ -22,3 +22,20 @@ func (k Keeper) GetEarners(ctx sdk.Context) []types.Earner {
 	}
 	return result
 }
+
+// MigrateEarners moves the pending earner list stored before key prefixes were introduced (the whole store
+// used to be the list) under EarnerPrefix.
+func (k Keeper) MigrateEarners(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys, values [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	it.Close()
+	for i, key := range keys {
+		store.Delete(key)
+		store.Set(earnerKey(key), values[i])
+	}
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/earning/types"
+)
+
+func (k Keeper) GetPeriod(ctx sdk.Context, id uint64) (types.PeriodSummary, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(periodKey(id))
+	if bz == nil {
+		return types.PeriodSummary{}, false
+	}
+	var period types.PeriodSummary
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &period)
+	return period, true
+}
+
+// GetPeriodHistory returns a page of earning period summaries, the latest first
+func (k Keeper) GetPeriodHistory(ctx sdk.Context, limit int32, page int32) []types.PeriodSummary {
+	it := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.PeriodPrefix)
+	defer it.Close()
+
+	periods := make([]types.PeriodSummary, 0)
+	start := limit * (page - 1)
+	end := limit * page
+
+	for current := int32(0); it.Valid() && (current < end); it.Next() {
+		if current < start {
+			current++
+			continue
+		}
+		current++
+		var period types.PeriodSummary
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &period)
+		periods = append(periods, period)
+	}
+
+	return periods
+}
+
+// GetPayoutHistory returns a page of an earner's payouts, the latest first
+func (k Keeper) GetPayoutHistory(ctx sdk.Context, acc sdk.AccAddress, limit int32, page int32) []types.Payout {
+	it := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), payoutHistoryKey(acc))
+	defer it.Close()
+
+	payouts := make([]types.Payout, 0)
+	start := limit * (page - 1)
+	end := limit * page
+
+	for current := int32(0); it.Valid() && (current < end); it.Next() {
+		if current < start {
+			current++
+			continue
+		}
+		current++
+		var payout types.Payout
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &payout)
+		payouts = append(payouts, payout)
+	}
+
+	return payouts
+}
+
+// GetCurrentPeriod returns an ID of the earning period in progress, zero if there is none
+func (k Keeper) GetCurrentPeriod(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.CurrentPeriodKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ExportHistory returns all earning period summaries and payouts
+func (k Keeper) ExportHistory(ctx sdk.Context) (periods []types.PeriodSummary, payouts []types.Payout) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PeriodPrefix)
+	for ; it.Valid(); it.Next() {
+		var period types.PeriodSummary
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &period)
+		periods = append(periods, period)
+	}
+	it.Close()
+
+	it = sdk.KVStorePrefixIterator(store, types.PayoutPrefix)
+	for ; it.Valid(); it.Next() {
+		var payout types.Payout
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &payout)
+		payouts = append(payouts, payout)
+	}
+	it.Close()
+
+	return periods, payouts
+}
+
+func (k Keeper) InitHistory(ctx sdk.Context, periods []types.PeriodSummary, payouts []types.Payout, current uint64) {
+	var next uint64 = 1
+	for _, period := range periods {
+		k.setPeriod(ctx, period)
+		if period.ID >= next {
+			next = period.ID + 1
+		}
+	}
+	k.setUint64(ctx, types.NextPeriodIDKey, next)
+	for _, payout := range payouts {
+		k.setPayout(ctx, payout)
+	}
+	if current != 0 {
+		k.setUint64(ctx, types.CurrentPeriodKey, current)
+	}
+}
+
+// openPeriod starts a history record of a new earning period
+func (k Keeper) openPeriod(ctx sdk.Context, period types.PeriodSummary) {
+	store := ctx.KVStore(k.storeKey)
+	period.ID = 1
+	if bz := store.Get(types.NextPeriodIDKey); bz != nil {
+		period.ID = binary.BigEndian.Uint64(bz)
+	}
+	k.setUint64(ctx, types.NextPeriodIDKey, period.ID+1)
+	k.setPeriod(ctx, period)
+	k.setUint64(ctx, types.CurrentPeriodKey, period.ID)
+}
+
+// recordPayout adds a payout to the history and to the current period summary. Payouts of a distribution started
+// before the history was kept (i.e. with no current period) aren't recorded, for payouts are keyed by period.
+func (k Keeper) recordPayout(ctx sdk.Context, acc sdk.AccAddress, vpnAmt int64, storageAmt int64) {
+	id := k.GetCurrentPeriod(ctx)
+	if id == 0 {
+		return
+	}
+	if period, found := k.GetPeriod(ctx, id); found {
+		period.Payouts++
+		period.Paid += vpnAmt + storageAmt
+		k.setPeriod(ctx, period)
+	}
+	k.setPayout(ctx, types.NewPayout(id, ctx.BlockHeight(), acc, vpnAmt, storageAmt))
+}
+
+// finishPeriod completes the current period summary, if any
+func (k Keeper) finishPeriod(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	id := k.GetCurrentPeriod(ctx)
+	if id == 0 {
+		return
+	}
+	store.Delete(types.CurrentPeriodKey)
+
+	period, found := k.GetPeriod(ctx, id)
+	if !found {
+		return
+	}
+	period.Finish = ctx.BlockHeight()
+	period.Residual = k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	k.setPeriod(ctx, period)
+}
+
+// movePayouts moves an earner's payout history to a new address
+func (k Keeper) movePayouts(ctx sdk.Context, from, to sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	var (
+		keys    [][]byte
+		payouts []types.Payout
+	)
+	it := sdk.KVStorePrefixIterator(store, payoutHistoryKey(from))
+	for ; it.Valid(); it.Next() {
+		var payout types.Payout
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &payout)
+		keys = append(keys, it.Key())
+		payouts = append(payouts, payout)
+	}
+	it.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		payouts[i].Account = to
+		k.setPayout(ctx, payouts[i])
+	}
+}
+
+func (k Keeper) setPeriod(ctx sdk.Context, period types.PeriodSummary) {
+	ctx.KVStore(k.storeKey).Set(periodKey(period.ID), k.cdc.MustMarshalBinaryLengthPrefixed(period))
+}
+
+func (k Keeper) setPayout(ctx sdk.Context, payout types.Payout) {
+	ctx.KVStore(k.storeKey).Set(payoutKey(payout.Account, payout.Period), k.cdc.MustMarshalBinaryLengthPrefixed(payout))
+}
+
+func (k Keeper) setUint64(ctx sdk.Context, key []byte, value uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, value)
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+func periodKey(id uint64) []byte {
+	key := make([]byte, len(types.PeriodPrefix)+8)
+	copy(key, types.PeriodPrefix)
+	binary.BigEndian.PutUint64(key[len(types.PeriodPrefix):], id)
+	return key
+}
+
+func payoutHistoryKey(acc sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.PayoutPrefix...), acc...)
+}
+
+func payoutKey(acc sdk.AccAddress, period uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, period)
+	return append(payoutHistoryKey(acc), bz...)
+}
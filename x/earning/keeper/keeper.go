@@ -60,35 +60,56 @@ func (k Keeper) ListEarners(ctx sdk.Context, items []types.Earner) error {
 	return nil
 }
 
-func (k Keeper) Run(ctx sdk.Context, fundPart util.Fraction, perBlock uint16, total types.Points, height int64) error {
+func (k Keeper) Run(ctx sdk.Context, fundPart util.Fraction, perBlock uint16, total types.Points, height int64, snapshotID uint64) error {
 	if ctx.BlockHeight() >= height {
 		return types.ErrTooLate
 	}
-
-	//TODO: Get VPN/Storage account states for a specific moment of the time
-	vpnFund := fundPart.MulInt64(k.supplyKeeper.GetModuleAccount(ctx, vpn.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()).Int64()
-	storageFund := fundPart.MulInt64(k.supplyKeeper.GetModuleAccount(ctx, storage.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()).Int64()
-	if vpnFund == 0 && storageFund == 0 {
-		return types.ErrNoMoney
-	}
-	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, vpn.ModuleName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(vpnFund)))); err != nil {
-		return err
+	if k.HasClaimPeriod(ctx) {
+		return types.ErrLocked
 	}
-	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, storage.ModuleName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(storageFund)))); err != nil {
+
+	vpnPointCost, storagePointCost, err := k.lockFunds(ctx, fundPart, total, snapshotID)
+	if err != nil {
 		return err
 	}
-	residualQuotient := util.NewFraction(k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64(), vpnFund+storageFund).Reduce()
-	vpnPointCost := util.NewFraction(vpnFund, total.Vpn).Mul(residualQuotient)
-	storagePointCost := util.NewFraction(storageFund, total.Storage).Mul(residualQuotient)
 	k.SetState(ctx, types.NewStateLocked(vpnPointCost, storagePointCost, perBlock))
-	if err := k.scheduleKeeper.ScheduleTask(ctx, uint64(height), types.StartHookName, &noPayload); err != nil {
+	k.openPeriod(ctx, types.NewPeriodSummary(types.AttestationKindList, vpnPointCost, storagePointCost, total, snapshotID, height))
+	if _, err := k.scheduleKeeper.ScheduleTask(ctx, uint64(height), types.StartHookName, &noPayload); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// lockFunds moves the fund part of VPN and storage module accounts (as of the snapshot) to the earning one and
+// returns point costs
+func (k Keeper) lockFunds(ctx sdk.Context, fundPart util.Fraction, total types.Points, snapshotID uint64) (vpnPointCost util.Fraction, storagePointCost util.Fraction, err error) {
+	vpnBalance, storageBalance, err := k.snapshotFunds(ctx, snapshotID)
+	if err != nil {
+		return util.Fraction{}, util.Fraction{}, err
+	}
+	vpnFund := fundPart.MulInt64(vpnBalance).Int64()
+	storageFund := fundPart.MulInt64(storageBalance).Int64()
+	if vpnFund == 0 && storageFund == 0 {
+		return util.Fraction{}, util.Fraction{}, types.ErrNoMoney
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, vpn.ModuleName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(vpnFund)))); err != nil {
+		return util.Fraction{}, util.Fraction{}, err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, storage.ModuleName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(storageFund)))); err != nil {
+		return util.Fraction{}, util.Fraction{}, err
+	}
+	residualQuotient := util.NewFraction(k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64(), vpnFund+storageFund).Reduce()
+	vpnPointCost = util.NewFraction(vpnFund, total.Vpn).Mul(residualQuotient)
+	storagePointCost = util.NewFraction(storageFund, total.Storage).Mul(residualQuotient)
+	return vpnPointCost, storagePointCost, nil
+}
+
 func (k Keeper) Reset(ctx sdk.Context) {
+	k.stopContinuing(ctx)
+	k.finishPeriod(ctx)
+	k.closeClaimPeriod(ctx)
+	k.clearAttestations(ctx)
 	k.clear(ctx)
 	k.SetState(ctx, types.NewStateUnlocked())
 }
@@ -112,13 +133,54 @@ func (k Keeper) PerformStart(ctx sdk.Context, _ []byte) error {
 
 func (k Keeper) PerformContinue(ctx sdk.Context, _ []byte) error { return k.proceed(ctx) }
 
+// MigrateAccount moves the account's earning data to a new address: listed points, payout history and a signer
+// seat (with approvals of pending attestations). If a claim period is open, the account's claim is paid to the new
+// address.
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	if k.has(ctx, from) {
+		points, err := k.get(ctx, from)
+		if err != nil {
+			return err
+		}
+		k.delete(ctx, from)
+		k.set(ctx, to, points)
+	}
+
+	k.movePayouts(ctx, from, to)
+
+	params := k.GetParams(ctx)
+	for i, signer := range params.Signers {
+		if signer.Equals(from) {
+			params.Signers[i] = to
+			k.SetParams(ctx, params)
+			for _, a := range k.GetAttestations(ctx) {
+				if a.IsApprovedBy(from) {
+					hash := a.Hash()
+					for j, acc := range a.Approvals {
+						if acc.Equals(from) {
+							a.Approvals[j] = to
+						}
+					}
+					k.setAttestation(ctx, hash, a)
+				}
+			}
+			break
+		}
+	}
+
+	if k.HasClaimPeriod(ctx) {
+		k.setMigrated(ctx, from, to)
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------------------------------------
 
 var noPayload []byte = nil
 
 func (k Keeper) has(ctx sdk.Context, key sdk.AccAddress) bool {
 	store := ctx.KVStore(k.storeKey)
-	byteKey := []byte(key)
+	byteKey := earnerKey(key)
 	return store.Has(byteKey)
 }
 
@@ -126,7 +188,7 @@ func (k Keeper) has(ctx sdk.Context, key sdk.AccAddress) bool {
 func (k Keeper) get(ctx sdk.Context, key sdk.AccAddress) (types.Points, error) {
 	store := ctx.KVStore(k.storeKey)
 	var item types.Points
-	byteKey := []byte(key)
+	byteKey := earnerKey(key)
 	err := k.cdc.UnmarshalBinaryLengthPrefixed(store.Get(byteKey), &item)
 	if err != nil {
 		return types.Points{}, err
@@ -137,18 +199,18 @@ func (k Keeper) get(ctx sdk.Context, key sdk.AccAddress) (types.Points, error) {
 func (k Keeper) set(ctx sdk.Context, key sdk.AccAddress, value types.Points) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(value)
-	store.Set([]byte(key), bz)
+	store.Set(earnerKey(key), bz)
 }
 
 func (k Keeper) delete(ctx sdk.Context, key sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete([]byte(key))
+	store.Delete(earnerKey(key))
 }
 
 func (k Keeper) clear(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	var keys [][]byte
-	it := store.Iterator(nil, nil)
+	it := sdk.KVStorePrefixIterator(store, types.EarnerPrefix)
 	for ; it.Valid(); it.Next() {
 		keys = append(keys, it.Key())
 	}
@@ -165,7 +227,7 @@ func (k Keeper) proceed(ctx sdk.Context) error {
 	}
 	page := make([]types.Earner, 0, p.ItemsPerBlock)
 	store := ctx.KVStore(k.storeKey)
-	it := store.Iterator(nil, nil)
+	it := sdk.KVStorePrefixIterator(store, types.EarnerPrefix)
 	for i := uint16(0); i < p.ItemsPerBlock && it.Valid(); i++ {
 		var points types.Points
 		if err := k.cdc.UnmarshalBinaryLengthPrefixed(it.Value(), &points); err != nil {
@@ -174,7 +236,7 @@ func (k Keeper) proceed(ctx sdk.Context) error {
 		}
 		page = append(page, types.Earner{
 			Points:  points,
-			Account: it.Key(),
+			Account: it.Key()[len(types.EarnerPrefix):],
 		})
 		it.Next()
 	}
@@ -192,16 +254,40 @@ func (k Keeper) proceed(ctx sdk.Context) error {
 			sdk.NewAttribute(types.AttributeKeyVpn, fmt.Sprintf("%d", vpnAmt)),
 			sdk.NewAttribute(types.AttributeKeyStorage, fmt.Sprintf("%d", storageAmt)),
 		))
+		k.recordPayout(ctx, item.Account, vpnAmt, storageAmt)
 		k.delete(ctx, item.Account)
 	}
 
 	if finished {
 		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFinish))
 		k.SetState(ctx, types.NewStateUnlocked())
+		k.stopContinuing(ctx)
+		k.finishPeriod(ctx)
 	} else {
-		if err := k.scheduleKeeper.ScheduleTask(ctx, uint64(ctx.BlockHeight()+1), types.ContinueHookName, &noPayload); err != nil {
+		if err := k.keepContinuing(ctx); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// keepContinuing makes sure the continue hook is performed every block until the distribution is finished
+func (k Keeper) keepContinuing(ctx sdk.Context) error {
+	if len(k.scheduleKeeper.GetRecurringTasksByHandler(ctx, types.ContinueHookName)) != 0 {
+		return nil
+	}
+	_, err := k.scheduleKeeper.ScheduleRecurringTask(ctx, types.ContinueHookName, &noPayload, uint64(ctx.BlockHeight()+1), 1, 0)
+	return err
+}
+
+func (k Keeper) stopContinuing(ctx sdk.Context) {
+	for _, rt := range k.scheduleKeeper.GetRecurringTasksByHandler(ctx, types.ContinueHookName) {
+		if err := k.scheduleKeeper.CancelRecurringTask(ctx, rt.ID); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func earnerKey(acc sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.EarnerPrefix...), acc...)
+}
@@ -12,10 +12,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/arterynetwork/artr/app"
 	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/earning"
+	"github.com/arterynetwork/artr/x/earning/types"
 	"github.com/arterynetwork/artr/x/referral"
 	"github.com/arterynetwork/artr/x/storage"
 	"github.com/arterynetwork/artr/x/vpn"
@@ -89,7 +91,7 @@ func (s *Suite) TestFlow() {
 		earning.NewEarner(user3, 0, 20),
 	}, s.k.GetEarners(s.ctx))
 
-	s.NoError(s.k.Run(s.ctx, util.NewFraction(1, 4), 2, earning.NewPoints(40, 60), 5))
+	s.NoError(s.k.Run(s.ctx, util.NewFraction(1, 4), 2, earning.NewPoints(40, 60), 5, 0))
 	s.Equal(
 		earning.ErrLocked,
 		s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user5, 3, 3)}),
@@ -165,7 +167,7 @@ func (s *Suite) TestReset() {
 
 	// Locked
 	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user2, 10, 0)}))
-	s.NoError(s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(10, 0), 100))
+	s.NoError(s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(10, 0), 100, 0))
 	s.k.Reset(s.ctx)
 	s.Empty(s.k.GetEarners(s.ctx))
 	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user2, 10, 0)}))
@@ -175,12 +177,12 @@ func (s *Suite) TestNoMoney() {
 	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(app.DefaultGenesisUsers["user2"], 10, 0)}))
 	s.Equal(
 		earning.ErrNoMoney,
-		s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(10, 0), 100),
+		s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(10, 0), 100, 0),
 	)
 
 	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 10*util.GBSize))
 	s.NoError(
-		s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(10, 0), 100),
+		s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(10, 0), 100, 0),
 	)
 }
 
@@ -198,7 +200,7 @@ func (s *Suite) TestLowMoney() {
 		earning.NewEarner(user2, 1, 0),
 		earning.NewEarner(user3, 0, 1),
 	}))
-	s.NoError(s.k.Run(s.ctx, prettyMuch, 100, earning.NewPoints(1, 1), 2))
+	s.NoError(s.k.Run(s.ctx, prettyMuch, 100, earning.NewPoints(1, 1), 2, 0))
 	s.nextBlock()
 
 	s.Equal(int64(1), s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, vpn.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64())
@@ -210,9 +212,9 @@ func (s *Suite) TestLowMoney() {
 	}))
 	s.Equal(
 		earning.ErrNoMoney,
-		s.k.Run(s.ctx, prettyMuch, 100, earning.NewPoints(2, 2), 3),
+		s.k.Run(s.ctx, prettyMuch, 100, earning.NewPoints(2, 2), 3, 0),
 	)
-	s.NoError(s.k.Run(s.ctx, util.FractionInt(1), 100, earning.NewPoints(2, 2), 3))
+	s.NoError(s.k.Run(s.ctx, util.FractionInt(1), 100, earning.NewPoints(2, 2), 3, 0))
 	s.nextBlock()
 
 	// Assert nothing's payed, but list's unlocked
@@ -229,9 +231,9 @@ func (s *Suite) TestHeightCheck() {
 	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], util.GBSize))
 	s.nextBlock()
 	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user2, 1, 1)}))
-	s.Equal(earning.ErrTooLate, s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(1, 1), 2))
+	s.Equal(earning.ErrTooLate, s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(1, 1), 2, 0))
 	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user3, 1, 1)}))
-	s.NoError(s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(2, 2), 3))
+	s.NoError(s.k.Run(s.ctx, util.NewFraction(7, 30), 100, earning.NewPoints(2, 2), 3, 0))
 }
 
 func (s *Suite) TestEmptyList() {
@@ -242,7 +244,7 @@ func (s *Suite) TestEmptyList() {
 	vpnFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, vpn.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
 	storageFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, storage.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
 	quarter := util.NewFraction(1, 4)
-	s.NoError(s.k.Run(s.ctx, quarter, 100, earning.NewPoints(0, 0), 2))
+	s.NoError(s.k.Run(s.ctx, quarter, 100, earning.NewPoints(0, 0), 2, 0))
 	s.nextBlock()
 
 	s.Equal(
@@ -256,7 +258,7 @@ func (s *Suite) TestEmptyList() {
 		earning.NewEarner(user2, 1, 1),
 		earning.NewEarner(user3, 1, 1),
 	}))
-	s.NoError(s.k.Run(s.ctx, quarter, 100, earning.NewPoints(2, 2), 3))
+	s.NoError(s.k.Run(s.ctx, quarter, 100, earning.NewPoints(2, 2), 3, 0))
 	s.nextBlock()
 
 	frac := util.NewFraction(7, 32) // (1/4 + 1/4 * (1 - 1/4)) / 2
@@ -270,6 +272,414 @@ func (s *Suite) TestEmptyList() {
 	)
 }
 
+func (s *Suite) TestClaims() {
+	user2 := app.DefaultGenesisUsers["user2"]
+	user3 := app.DefaultGenesisUsers["user3"]
+	user4 := app.DefaultGenesisUsers["user4"]
+
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+	vpnFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, vpn.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	storageFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, storage.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	user2amt := s.accKeeper.GetAccount(s.ctx, user2).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	user4amt := s.accKeeper.GetAccount(s.ctx, user4).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+
+	earners := []earning.Earner{
+		earning.NewEarner(user2, 10, 0),
+		earning.NewEarner(user3, 0, 20),
+		earning.NewEarner(user4, 30, 40),
+	}
+	root, proofs := earning.ClaimTree(earners)
+
+	s.Equal(earning.ErrNoClaimPeriod, s.k.ClaimEarning(s.ctx, earners[0], *proofs[0]))
+	s.NoError(s.k.RunClaims(s.ctx, util.NewFraction(1, 4), root, earning.NewPoints(40, 60), 3, 0))
+	s.Equal(earning.ErrLocked, s.k.RunClaims(s.ctx, util.NewFraction(1, 4), root, earning.NewPoints(40, 60), 3, 0))
+	s.Equal(earning.ErrLocked, s.k.Run(s.ctx, util.NewFraction(1, 4), 2, earning.NewPoints(40, 60), 3, 0))
+	s.True(earning.ErrTooEarly.Is(s.k.ClaimEarning(s.ctx, earners[0], *proofs[0])))
+
+	s.nextBlock()
+	s.nextBlock()
+	s.NoError(s.k.ClaimEarning(s.ctx, earners[0], *proofs[0]))
+	s.Equal(
+		user2amt+util.NewFraction(1, 16).MulInt64(vpnFund).Int64(),
+		s.accKeeper.GetAccount(s.ctx, user2).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+	s.Equal(earning.ErrClaimed, s.k.ClaimEarning(s.ctx, earners[0], *proofs[0]))
+	s.True(s.k.IsClaimed(s.ctx, user2))
+
+	s.True(earning.ErrInvalidProof.Is(s.k.ClaimEarning(s.ctx, earning.NewEarner(user4, 300, 40), *proofs[2])))
+	s.True(earning.ErrInvalidProof.Is(s.k.ClaimEarning(s.ctx, earners[2], *proofs[1])))
+	s.NoError(s.k.ClaimEarning(s.ctx, earners[2], *proofs[2]))
+	s.Equal(
+		user4amt+util.NewFraction(3, 16).MulInt64(vpnFund).Int64()+util.NewFraction(1, 6).MulInt64(storageFund).Int64(),
+		s.accKeeper.GetAccount(s.ctx, user4).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+
+	s.k.Reset(s.ctx)
+	s.False(s.k.IsClaimed(s.ctx, user2))
+	s.Equal(earning.ErrNoClaimPeriod, s.k.ClaimEarning(s.ctx, earners[1], *proofs[1]))
+}
+
+func (s *Suite) TestClaims_Window() {
+	user2 := app.DefaultGenesisUsers["user2"]
+	user3 := app.DefaultGenesisUsers["user3"]
+	params := s.k.GetParams(s.ctx)
+	params.ClaimWindow = 3
+	s.k.SetParams(s.ctx, params)
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+
+	earners := []earning.Earner{
+		earning.NewEarner(user2, 10, 10),
+		earning.NewEarner(user3, 10, 10),
+	}
+	root, proofs := earning.ClaimTree(earners)
+	s.NoError(s.k.RunClaims(s.ctx, util.NewFraction(1, 4), root, earning.NewPoints(20, 20), 3, 0))
+	period, _ := s.k.GetClaimPeriod(s.ctx)
+	s.Equal(int64(6), period.End)
+
+	for s.ctx.BlockHeight() < 5 {
+		s.nextBlock()
+	}
+	s.NoError(s.k.ClaimEarning(s.ctx, earners[0], *proofs[0]))
+
+	s.nextBlock()
+	s.False(s.k.HasClaimPeriod(s.ctx))
+	s.Empty(s.k.GetClaimed(s.ctx))
+	s.Equal(earning.ErrNoClaimPeriod, s.k.ClaimEarning(s.ctx, earners[1], *proofs[1]))
+	summary, _ := s.k.GetPeriod(s.ctx, 1)
+	s.Equal(int64(6), summary.Finish)
+	s.Zero(s.k.GetCurrentPeriod(s.ctx))
+}
+
+func (s *Suite) TestClaims_FundExhausted() {
+	user2 := app.DefaultGenesisUsers["user2"]
+	user3 := app.DefaultGenesisUsers["user3"]
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+
+	// the root doesn't match the total, there are twice as many points in the list
+	earners := []earning.Earner{
+		earning.NewEarner(user2, 10, 10),
+		earning.NewEarner(user3, 10, 10),
+	}
+	root, proofs := earning.ClaimTree(earners)
+	s.NoError(s.k.RunClaims(s.ctx, util.NewFraction(1, 4), root, earning.NewPoints(10, 10), 2, 0))
+	s.nextBlock()
+
+	s.NoError(s.k.ClaimEarning(s.ctx, earners[0], *proofs[0]))
+	user3amt := s.accKeeper.GetAccount(s.ctx, user3).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	s.Equal(earning.ErrFundExhausted, s.k.ClaimEarning(s.ctx, earners[1], *proofs[1]))
+	s.Equal(user3amt, s.accKeeper.GetAccount(s.ctx, user3).GetCoins().AmountOf(util.ConfigMainDenom).Int64())
+
+	period, _ := s.k.GetClaimPeriod(s.ctx)
+	s.Equal(period.Fund, period.Paid)
+}
+
+func (s *Suite) TestHistory() {
+	user2 := app.DefaultGenesisUsers["user2"]
+	user3 := app.DefaultGenesisUsers["user3"]
+	user4 := app.DefaultGenesisUsers["user4"]
+
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+	vpnFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, vpn.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	storageFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, storage.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{
+		earning.NewEarner(user2, 10, 0),
+		earning.NewEarner(user3, 0, 20),
+		earning.NewEarner(user4, 30, 40),
+	}))
+	s.NoError(s.k.Run(s.ctx, util.NewFraction(1, 4), 2, earning.NewPoints(40, 60), 5, 0))
+	periods := s.k.GetPeriodHistory(s.ctx, 10, 1)
+	s.Len(periods, 1)
+	s.Equal(uint64(1), periods[0].ID)
+	s.Equal(int64(5), periods[0].Start)
+	s.Zero(periods[0].Finish)
+	s.Equal(uint64(1), s.k.GetCurrentPeriod(s.ctx))
+
+	for h := 2; h <= 6; h++ {
+		s.nextBlock()
+	}
+	user2vpn := util.NewFraction(1, 16).MulInt64(vpnFund).Int64()
+	user3storage := util.NewFraction(1, 12).MulInt64(storageFund).Int64()
+	user4vpn := util.NewFraction(3, 16).MulInt64(vpnFund).Int64()
+	user4storage := util.NewFraction(1, 6).MulInt64(storageFund).Int64()
+
+	period, found := s.k.GetPeriod(s.ctx, 1)
+	s.True(found)
+	s.Equal(int64(6), period.Finish)
+	s.Equal(uint64(3), period.Payouts)
+	s.Equal(user2vpn+user3storage+user4vpn+user4storage, period.Paid)
+	s.Equal(
+		s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, earning.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+		period.Residual,
+	)
+	s.Zero(s.k.GetCurrentPeriod(s.ctx))
+	s.Equal([]earning.Payout{earning.NewPayout(1, 5, user4, user4vpn, user4storage)}, s.k.GetPayoutHistory(s.ctx, user4, 10, 1))
+	s.Equal([]earning.Payout{earning.NewPayout(1, 6, user3, 0, user3storage)}, s.k.GetPayoutHistory(s.ctx, user3, 10, 1))
+
+	earners := []earning.Earner{earning.NewEarner(user4, 30, 40)}
+	root, proofs := earning.ClaimTree(earners)
+	s.NoError(s.k.RunClaims(s.ctx, util.NewFraction(1, 4), root, earning.NewPoints(30, 40), 7, 0))
+	s.nextBlock()
+	s.NoError(s.k.ClaimEarning(s.ctx, earners[0], *proofs[0]))
+
+	payouts := s.k.GetPayoutHistory(s.ctx, user4, 10, 1)
+	s.Len(payouts, 2)
+	s.Equal(uint64(2), payouts[0].Period)
+	s.Equal(uint64(1), payouts[1].Period)
+	s.Equal(payouts[1:], s.k.GetPayoutHistory(s.ctx, user4, 1, 2))
+
+	periods = s.k.GetPeriodHistory(s.ctx, 1, 1)
+	s.Len(periods, 1)
+	s.Equal(uint64(2), periods[0].ID)
+	s.Equal(uint64(1), periods[0].Payouts)
+	s.Zero(periods[0].Finish)
+
+	s.k.Reset(s.ctx)
+	period, _ = s.k.GetPeriod(s.ctx, 2)
+	s.Equal(int64(7), period.Finish)
+	s.Zero(s.k.GetCurrentPeriod(s.ctx))
+}
+
+func (s *Suite) TestHistory_NoPeriod() {
+	user2 := app.DefaultGenesisUsers["user2"]
+
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user2, 10, 10)}))
+	balance := s.accKeeper.GetAccount(s.ctx, user2).GetCoins().AmountOf(util.ConfigMainDenom)
+	s.NoError(s.k.Run(s.ctx, util.NewFraction(1, 4), 2, earning.NewPoints(10, 10), 3, 0))
+	// as if the distribution was started before the upgrade
+	s.ctx.KVStore(s.storeKey).Delete(types.CurrentPeriodKey)
+
+	for h := 2; h <= 3; h++ {
+		s.nextBlock()
+	}
+	s.True(s.accKeeper.GetAccount(s.ctx, user2).GetCoins().AmountOf(util.ConfigMainDenom).GT(balance))
+	s.Empty(s.k.GetPayoutHistory(s.ctx, user2, 10, 1))
+	period, _ := s.k.GetPeriod(s.ctx, 1)
+	s.Zero(period.Payouts)
+
+	_, payouts := s.k.ExportHistory(s.ctx)
+	s.Empty(payouts)
+}
+
+func (s *Suite) TestAttestation() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user2 := app.DefaultGenesisUsers["user2"]
+	user3 := app.DefaultGenesisUsers["user3"]
+	user4 := app.DefaultGenesisUsers["user4"]
+	s.setAttestationParams(user1, user2, user3)
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{
+		earning.NewEarner(user2, 10, 0),
+		earning.NewEarner(user4, 30, 40),
+	}))
+
+	s.Equal(earning.ErrTooLate, s.k.ApproveRun(s.ctx, user1, util.NewFraction(1, 4), 2, earning.NewPoints(40, 40), 3, 0))
+	s.NoError(s.k.ApproveRun(s.ctx, user1, util.NewFraction(1, 4), 2, earning.NewPoints(40, 40), 10, 0))
+	s.Equal(earning.ErrAlreadyApproved, s.k.ApproveRun(s.ctx, user1, util.NewFraction(1, 4), 2, earning.NewPoints(40, 40), 10, 0))
+	// Another proposal doesn't add up to the first one
+	s.NoError(s.k.ApproveRun(s.ctx, user2, util.NewFraction(1, 5), 2, earning.NewPoints(40, 40), 10, 0))
+	s.Len(s.k.GetAttestations(s.ctx), 2)
+	s.False(s.k.GetState(s.ctx).Locked)
+
+	s.NoError(s.k.ApproveRun(s.ctx, user3, util.NewFraction(1, 4), 2, earning.NewPoints(40, 40), 10, 0))
+	s.nextBlock()
+	s.False(s.k.GetState(s.ctx).Locked, "challenge window isn't over")
+
+	s.nextBlock()
+	s.True(s.k.GetState(s.ctx).Locked)
+	s.Empty(s.k.GetAttestations(s.ctx))
+}
+
+func (s *Suite) TestAttestation_Challenge() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user2 := app.DefaultGenesisUsers["user2"]
+	user4 := app.DefaultGenesisUsers["user4"]
+	s.setAttestationParams(user1, user2)
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user4, 30, 40)}))
+	s.NoError(s.k.ApproveRun(s.ctx, user1, util.NewFraction(1, 4), 2, earning.NewPoints(30, 40), 10, 0))
+	s.NoError(s.k.ApproveRun(s.ctx, user2, util.NewFraction(1, 4), 2, earning.NewPoints(30, 40), 10, 0))
+	attestations := s.k.GetAttestations(s.ctx)
+	s.Len(attestations, 1)
+	s.Equal(int64(1), attestations[0].Approved)
+
+	s.True(earning.ErrAttestationNotFound.Is(s.k.Challenge(s.ctx, user1, make([]byte, 32))))
+	s.NoError(s.k.Challenge(s.ctx, user1, attestations[0].Hash()))
+	s.Empty(s.k.GetAttestations(s.ctx))
+
+	s.nextBlock()
+	s.nextBlock()
+	s.False(s.k.GetState(s.ctx).Locked)
+}
+
+func (s *Suite) TestAttestation_ListChanged() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user2 := app.DefaultGenesisUsers["user2"]
+	user4 := app.DefaultGenesisUsers["user4"]
+	user5 := app.DefaultGenesisUsers["user5"]
+	s.setAttestationParams(user1, user2)
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user4, 30, 40)}))
+	s.NoError(s.k.ApproveRun(s.ctx, user1, util.NewFraction(1, 4), 2, earning.NewPoints(30, 40), 10, 0))
+	s.NoError(s.k.ApproveRun(s.ctx, user2, util.NewFraction(1, 4), 2, earning.NewPoints(30, 40), 10, 0))
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user5, 3, 3)}))
+
+	s.nextBlock()
+	_, bbr := s.nextBlock()
+	s.False(s.k.GetState(s.ctx).Locked)
+	s.Empty(s.k.GetAttestations(s.ctx))
+
+	failed := false
+	for _, ev := range bbr.Events {
+		if ev.Type == types.EventTypeAttestationFailed {
+			failed = true
+		}
+	}
+	s.True(failed)
+}
+
+func (s *Suite) TestAttestation_Reset() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user2 := app.DefaultGenesisUsers["user2"]
+	user4 := app.DefaultGenesisUsers["user4"]
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user4, 30, 40)}))
+	s.NoError(s.k.Run(s.ctx, util.NewFraction(1, 4), 2, earning.NewPoints(30, 40), 10, 0))
+	s.setAttestationParams(user1, user2)
+	handler := earning.NewHandler(s.k)
+
+	_, err := handler(s.ctx, types.NewMsgReset(user1))
+	s.NoError(err)
+	s.True(s.k.GetState(s.ctx).Locked, "a single signer cannot reset")
+	_, err = handler(s.ctx, types.NewMsgReset(user1))
+	s.Equal(earning.ErrAlreadyApproved, err)
+
+	_, err = handler(s.ctx, types.NewMsgReset(user2))
+	s.NoError(err)
+	s.nextBlock()
+	s.True(s.k.GetState(s.ctx).Locked, "challenge window isn't over")
+
+	s.nextBlock()
+	s.False(s.k.GetState(s.ctx).Locked)
+	s.Empty(s.k.GetEarners(s.ctx))
+	s.Empty(s.k.GetAttestations(s.ctx))
+}
+
+func (s *Suite) TestSnapshots() {
+	user2 := app.DefaultGenesisUsers["user2"]
+	params := s.k.GetParams(s.ctx)
+	params.SnapshotPeriod = 3
+	s.k.SetParams(s.ctx, params)
+
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+	vpnFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, vpn.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	storageFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, storage.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user2, 10, 10)}))
+	s.True(earning.ErrSnapshotNotFound.Is(s.k.Run(s.ctx, util.NewFraction(1, 2), 100, earning.NewPoints(10, 10), 10, 0)))
+	s.True(earning.ErrSnapshotNotFound.Is(s.k.Run(s.ctx, util.NewFraction(1, 2), 100, earning.NewPoints(10, 10), 10, 1)))
+
+	s.nextBlock()
+	s.nextBlock()
+	s.Empty(s.k.GetSnapshots(s.ctx))
+	s.nextBlock()
+	s.Equal([]earning.Snapshot{earning.NewSnapshot(1, 4, s.ctx.BlockTime().Unix(), vpnFund, storageFund)}, s.k.GetSnapshots(s.ctx))
+
+	// Live balances change after the snapshot is taken, but it doesn't matter
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+	s.NoError(s.k.Run(s.ctx, util.NewFraction(1, 2), 100, earning.NewPoints(10, 10), 10, 1))
+	s.Equal(
+		util.NewFraction(1, 2).MulInt64(vpnFund).Int64()+util.NewFraction(1, 2).MulInt64(storageFund).Int64(),
+		s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, earning.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+	snapshot, found := s.k.GetSnapshot(s.ctx, 1)
+	s.True(found)
+	s.True(snapshot.Used)
+
+	s.k.Reset(s.ctx)
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user2, 10, 10)}))
+	s.True(earning.ErrSnapshotUsed.Is(s.k.Run(s.ctx, util.NewFraction(1, 2), 100, earning.NewPoints(10, 10), 10, 1)))
+
+	for i := 0; i < 3; i++ {
+		s.nextBlock()
+	}
+	s.Len(s.k.GetSnapshots(s.ctx), 2)
+
+	params.SnapshotPeriod = 0
+	s.k.SetParams(s.ctx, params)
+	for i := 0; i < 3; i++ {
+		s.nextBlock()
+	}
+	s.Len(s.k.GetSnapshots(s.ctx), 2)
+}
+
+func (s *Suite) TestMigrateAccount() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user2 := app.DefaultGenesisUsers["user2"]
+	user3 := app.DefaultGenesisUsers["user3"]
+	user4 := app.DefaultGenesisUsers["user4"]
+	_, _, newUser1 := authtypes.KeyTestPubAddr()
+	_, _, newUser4 := authtypes.KeyTestPubAddr()
+	s.setAttestationParams(user1, user2, user3)
+
+	s.NoError(s.k.ListEarners(s.ctx, []earning.Earner{earning.NewEarner(user4, 10, 0)}))
+	s.NoError(s.k.MigrateAccount(s.ctx, user4, newUser4))
+	s.Equal([]earning.Earner{earning.NewEarner(newUser4, 10, 0)}, s.k.GetEarners(s.ctx))
+
+	s.NoError(s.k.ApproveRun(s.ctx, user1, util.NewFraction(1, 4), 2, earning.NewPoints(10, 0), 10, 0))
+	s.NoError(s.k.MigrateAccount(s.ctx, user1, newUser1))
+	s.Equal([]sdk.AccAddress{newUser1, user2, user3}, s.k.GetParams(s.ctx).Signers)
+	s.Equal(earning.ErrAlreadyApproved, s.k.ApproveRun(s.ctx, newUser1, util.NewFraction(1, 4), 2, earning.NewPoints(10, 0), 10, 0))
+	s.NoError(s.k.ApproveRun(s.ctx, user3, util.NewFraction(1, 4), 2, earning.NewPoints(10, 0), 10, 0))
+	a := s.k.GetAttestations(s.ctx)
+	s.Len(a, 1)
+	s.NotZero(a[0].Approved)
+	s.Empty(s.k.GetMigrated(s.ctx), "no claim period is open")
+}
+
+func (s *Suite) TestMigrateAccount_Claims() {
+	user2 := app.DefaultGenesisUsers["user2"]
+	user4 := app.DefaultGenesisUsers["user4"]
+	_, _, newUser2 := authtypes.KeyTestPubAddr()
+	_, _, newerUser2 := authtypes.KeyTestPubAddr()
+
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, app.DefaultGenesisUsers["user13"], 100*util.GBSize))
+	vpnFund := s.app.GetSupplyKeeper().GetModuleAccount(s.ctx, vpn.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+
+	earners := []earning.Earner{
+		earning.NewEarner(user2, 10, 0),
+		earning.NewEarner(user4, 30, 40),
+	}
+	root, proofs := earning.ClaimTree(earners)
+	s.NoError(s.k.RunClaims(s.ctx, util.NewFraction(1, 4), root, earning.NewPoints(40, 40), 3, 0))
+	s.nextBlock()
+	s.nextBlock()
+
+	s.NoError(s.k.MigrateAccount(s.ctx, user2, newUser2))
+	s.NoError(s.k.MigrateAccount(s.ctx, newUser2, newerUser2))
+	s.NoError(s.k.ClaimEarning(s.ctx, earners[0], *proofs[0]))
+	s.Equal(
+		util.NewFraction(1, 16).MulInt64(vpnFund).Int64(),
+		s.accKeeper.GetAccount(s.ctx, newerUser2).GetCoins().AmountOf(util.ConfigMainDenom).Int64(),
+	)
+	s.Equal(earning.ErrClaimed, s.k.ClaimEarning(s.ctx, earners[0], *proofs[0]))
+
+	s.k.Reset(s.ctx)
+	s.Empty(s.k.GetMigrated(s.ctx))
+}
+
+func (s *Suite) setAttestationParams(signers ...sdk.AccAddress) {
+	params := s.k.GetParams(s.ctx)
+	params.Signers = signers
+	params.MinApprovals = 2
+	params.ChallengeWindow = 2
+	s.k.SetParams(s.ctx, params)
+}
+
 var bbHeader = abci.RequestBeginBlock{
 	Header: abci.Header{
 		ProposerAddress: sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey).Address().Bytes(),
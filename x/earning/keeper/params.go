@@ -18,6 +18,7 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.Logger(ctx).Debug("SetParams", "params", params)
 	k.paramspace.SetParamSet(ctx, &params)
+	k.scheduleSnapshots(ctx, params.SnapshotPeriod)
 }
 
 func (k Keeper) GetState(ctx sdk.Context) (state types.StateParams) {
@@ -15,6 +15,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryParams:
 			return queryParams(ctx, k)
+		case types.QueryClaimPeriod:
+			return queryClaimPeriod(ctx, k)
+		case types.QueryClaimed:
+			return queryClaimed(ctx, req, k)
+		case types.QueryAttestations:
+			return queryAttestations(ctx, k)
+		case types.QuerySnapshots:
+			return querySnapshots(ctx, k)
+		case types.QuerySnapshot:
+			return querySnapshot(ctx, req, k)
+		case types.QueryPeriods:
+			return queryPeriods(ctx, req, k)
+		case types.QueryPeriod:
+			return queryPeriod(ctx, req, k)
+		case types.QueryPayouts:
+			return queryPayouts(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown delegating query endpoint")
 		}
@@ -31,3 +47,128 @@ func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+func queryClaimPeriod(ctx sdk.Context, k Keeper) ([]byte, error) {
+	period, found := k.GetClaimPeriod(ctx)
+	if !found {
+		return nil, types.ErrNoClaimPeriod
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, period)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryClaimed(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryClaimedParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryClaimedRes{Claimed: k.IsClaimed(ctx, params.Account)})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAttestations(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryAttestationsRes(k.GetAttestations(ctx)))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySnapshots(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QuerySnapshotsRes(k.GetSnapshots(ctx)))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySnapshot(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySnapshotParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	snapshot, found := k.GetSnapshot(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrSnapshotNotFound, "%d", params.ID)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, snapshot)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPeriods(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPeriodsParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryPeriodsRes{
+		Periods: k.GetPeriodHistory(ctx, params.Limit, params.Page),
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryPeriod(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPeriodParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	period, found := k.GetPeriod(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPeriodNotFound, "%d", params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, period)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryPayouts(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPayoutsParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryPayoutsRes{
+		Payouts: k.GetPayoutHistory(ctx, params.Account, params.Limit, params.Page),
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
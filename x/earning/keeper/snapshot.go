@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/earning/types"
+	"github.com/arterynetwork/artr/x/storage"
+	"github.com/arterynetwork/artr/x/vpn"
+)
+
+// TakeSnapshot stores the current VPN and storage module account balances
+func (k Keeper) TakeSnapshot(ctx sdk.Context) types.Snapshot {
+	vpnBalance, storageBalance := k.fundBalances(ctx)
+	snapshot := types.NewSnapshot(k.nextSnapshotID(ctx), ctx.BlockHeight(), ctx.BlockTime().Unix(), vpnBalance, storageBalance)
+	k.setSnapshot(ctx, snapshot)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSnapshot,
+		sdk.NewAttribute(types.AttributeKeySnapshot, fmt.Sprintf("%d", snapshot.ID)),
+		sdk.NewAttribute(types.AttributeKeyVpn, fmt.Sprintf("%d", snapshot.Vpn)),
+		sdk.NewAttribute(types.AttributeKeyStorage, fmt.Sprintf("%d", snapshot.Storage)),
+	))
+	return snapshot
+}
+
+// PerformSnapshot is a hook performed at the end of each billing period
+func (k Keeper) PerformSnapshot(ctx sdk.Context, _ []byte) {
+	snapshot := k.TakeSnapshot(ctx)
+	k.Logger(ctx).Info("fund snapshot taken", "id", snapshot.ID, "vpn", snapshot.Vpn, "storage", snapshot.Storage)
+}
+
+func (k Keeper) GetSnapshot(ctx sdk.Context, id uint64) (types.Snapshot, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(snapshotKey(id))
+	if bz == nil {
+		return types.Snapshot{}, false
+	}
+	var snapshot types.Snapshot
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &snapshot)
+	return snapshot, true
+}
+
+// GetSnapshots returns all fund snapshots ordered by ID
+func (k Keeper) GetSnapshots(ctx sdk.Context) []types.Snapshot {
+	var result []types.Snapshot
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SnapshotPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var snapshot types.Snapshot
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &snapshot)
+		result = append(result, snapshot)
+	}
+	return result
+}
+
+func (k Keeper) InitSnapshots(ctx sdk.Context, snapshots []types.Snapshot) {
+	var next uint64 = 1
+	for _, snapshot := range snapshots {
+		k.setSnapshot(ctx, snapshot)
+		if snapshot.ID >= next {
+			next = snapshot.ID + 1
+		}
+	}
+	k.setUint64(ctx, types.NextSnapshotIDKey, next)
+}
+
+// scheduleSnapshots makes sure fund snapshots are taken every snapshot period (and aren't taken if it's zero)
+func (k Keeper) scheduleSnapshots(ctx sdk.Context, period int64) {
+	scheduled := false
+	for _, rt := range k.scheduleKeeper.GetRecurringTasksByHandler(ctx, types.SnapshotHookName) {
+		if !scheduled && period > 0 && rt.Interval == uint64(period) {
+			scheduled = true
+			continue
+		}
+		if err := k.scheduleKeeper.CancelRecurringTask(ctx, rt.ID); err != nil {
+			panic(err)
+		}
+	}
+	if scheduled || period == 0 {
+		return
+	}
+	start := uint64(ctx.BlockHeight() + period)
+	if _, err := k.scheduleKeeper.ScheduleRecurringTask(ctx, types.SnapshotHookName, &noPayload, start, uint64(period), 0); err != nil {
+		panic(err)
+	}
+}
+
+// snapshotFunds returns the VPN and storage fund balances an earning period is paid from, and marks the
+// snapshot used. Zero snapshot ID means live balances, it's allowed only if no snapshots are taken.
+func (k Keeper) snapshotFunds(ctx sdk.Context, id uint64) (vpnBalance int64, storageBalance int64, err error) {
+	if id == 0 {
+		if k.GetParams(ctx).SnapshotPeriod != 0 {
+			return 0, 0, sdkerrors.Wrap(types.ErrSnapshotNotFound, "snapshot ID is required")
+		}
+		vpnBalance, storageBalance = k.fundBalances(ctx)
+		return vpnBalance, storageBalance, nil
+	}
+
+	snapshot, found := k.GetSnapshot(ctx, id)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrSnapshotNotFound, "%d", id)
+	}
+	if snapshot.Used {
+		return 0, 0, sdkerrors.Wrapf(types.ErrSnapshotUsed, "%d", id)
+	}
+	snapshot.Used = true
+	k.setSnapshot(ctx, snapshot)
+	return snapshot.Vpn, snapshot.Storage, nil
+}
+
+func (k Keeper) fundBalances(ctx sdk.Context) (vpnBalance int64, storageBalance int64) {
+	vpnBalance = k.supplyKeeper.GetModuleAccount(ctx, vpn.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	storageBalance = k.supplyKeeper.GetModuleAccount(ctx, storage.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+	return vpnBalance, storageBalance
+}
+
+func (k Keeper) setSnapshot(ctx sdk.Context, snapshot types.Snapshot) {
+	ctx.KVStore(k.storeKey).Set(snapshotKey(snapshot.ID), k.cdc.MustMarshalBinaryLengthPrefixed(snapshot))
+}
+
+func (k Keeper) nextSnapshotID(ctx sdk.Context) uint64 {
+	var id uint64 = 1
+	if bz := ctx.KVStore(k.storeKey).Get(types.NextSnapshotIDKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	k.setUint64(ctx, types.NextSnapshotIDKey, id+1)
+	return id
+}
+
+func snapshotKey(id uint64) []byte {
+	key := make([]byte, len(types.SnapshotPrefix)+8)
+	copy(key, types.SnapshotPrefix)
+	binary.BigEndian.PutUint64(key[len(types.SnapshotPrefix):], id)
+	return key
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/util"
+)
+
+const (
+	// AttestationKindList is for earning periods paid from the pending earner list (MsgRun)
+	AttestationKindList = "list"
+	// AttestationKindClaims is for earning periods paid by claims (MsgRunClaims)
+	AttestationKindClaims = "claims"
+	// AttestationKindReset is for resetting the module state (MsgReset), it has no other fields
+	AttestationKindReset = "reset"
+)
+
+// Attestation is an earning period waiting for enough signer approvals (and then for the challenge window to
+// pass) before it's run. Signers approve the same period by sending the same MsgRun (or MsgRunClaims). A reset
+// is attested the same way.
+type Attestation struct {
+	Kind string `json:"kind"`
+	// ListHash is the Merkle root of the earner list (see ClaimTree), for the "list" kind it's calculated from
+	// the pending list at the moment of approval
+	ListHash []byte        `json:"list_hash"`
+	FundPart util.Fraction `json:"fund_part"`
+	Total    Points        `json:"total"`
+	PerBlock uint16        `json:"per_block,omitempty"`
+	Height   int64         `json:"height"`
+	// SnapshotID refers to fund balances the period is paid from, zero means live balances
+	SnapshotID uint64 `json:"snapshot_id,omitempty"`
+
+	Approvals []sdk.AccAddress `json:"approvals"`
+	// Approved is a block height the attestation got enough approvals at, zero if it's still pending
+	Approved int64 `json:"approved,omitempty"`
+}
+
+type attestationSubject struct {
+	Kind     string
+	ListHash []byte
+	FundPart util.Fraction
+	Total    Points
+	PerBlock uint16
+	Height   int64
+	// SnapshotID is the last one, so that hashes of periods paid from live balances stay the same
+	SnapshotID uint64
+}
+
+// Hash identifies the approved earning period, i.e. it doesn't depend on approvals
+func (a Attestation) Hash() []byte {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(attestationSubject{
+		Kind:     a.Kind,
+		ListHash: a.ListHash,
+		FundPart: a.FundPart,
+		Total:    a.Total,
+		PerBlock: a.PerBlock,
+		Height:   a.Height,
+
+		SnapshotID: a.SnapshotID,
+	}))
+}
+
+// IsApprovedBy checks if the signer has already approved the attestation
+func (a Attestation) IsApprovedBy(signer sdk.AccAddress) bool {
+	for _, acc := range a.Approvals {
+		if acc.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a Attestation) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`
+Hash: %X
+Kind: %s
+ListHash: %X
+FundPart: %v
+TotalVpn: %d
+TotalStorage: %d
+PerBlock: %d
+Height: %d
+SnapshotID: %d
+Approvals: %v
+Approved: %d
+`, a.Hash(), a.Kind, a.ListHash, a.FundPart, a.Total.Vpn, a.Total.Storage, a.PerBlock, a.Height, a.SnapshotID, a.Approvals, a.Approved))
+}
+
+type QueryAttestationsRes []Attestation
+
+func (res QueryAttestationsRes) String() string {
+	lines := make([]string, len(res))
+	for i, a := range res {
+		lines[i] = a.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
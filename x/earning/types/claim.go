@@ -0,0 +1,94 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/util"
+)
+
+// ClaimPeriod is an earning period paid by claims. Instead of a full earner list, only a Merkle root of it is
+// stored, and every earner claims their earnings with a proof.
+type ClaimPeriod struct {
+	Root             []byte        `json:"root"`
+	VpnPointCost     util.Fraction `json:"vpn_point_cost"`
+	StoragePointCost util.Fraction `json:"storage_point_cost"`
+	Total            Points        `json:"total"`
+	// Start is a block height claims are accepted from
+	Start int64 `json:"start"`
+	// End is a block height the period is closed at, unclaimed funds stay on the module account for the next one
+	End int64 `json:"end"`
+	// Fund is how much the period pays in total (VPN and storage separately), Paid is how much is claimed so far
+	Fund Points `json:"fund"`
+	Paid Points `json:"paid"`
+}
+
+func NewClaimPeriod(root []byte, vpnPointCost util.Fraction, storagePointCost util.Fraction, total Points, start int64, end int64) ClaimPeriod {
+	return ClaimPeriod{
+		Root:             root,
+		VpnPointCost:     vpnPointCost,
+		StoragePointCost: storagePointCost,
+		Total:            total,
+		Start:            start,
+		End:              end,
+		Fund: NewPoints(
+			vpnPointCost.MulInt64(total.Vpn).Int64(),
+			storagePointCost.MulInt64(total.Storage).Int64(),
+		),
+	}
+}
+
+func (p ClaimPeriod) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`
+Root: %X
+VpnPointCost: %v
+StoragePointCost: %v
+TotalVpn: %d
+TotalStorage: %d
+Start: %d
+End: %d
+VpnFund: %d
+StorageFund: %d
+VpnPaid: %d
+StoragePaid: %d
+`, p.Root, p.VpnPointCost, p.StoragePointCost, p.Total.Vpn, p.Total.Storage, p.Start, p.End, p.Fund.Vpn, p.Fund.Storage, p.Paid.Vpn, p.Paid.Storage))
+}
+
+// Migration is an account moved to a new address during a claim period, its earnings are paid to the new address
+type Migration struct {
+	From sdk.AccAddress `json:"from"`
+	To   sdk.AccAddress `json:"to"`
+}
+
+// ClaimLeaf returns a Merkle tree leaf for an earner: VPN points (8 bytes, big endian), storage points
+// (8 bytes, big endian) and the account address bytes.
+func ClaimLeaf(earner Earner) []byte {
+	leaf := make([]byte, 16+len(earner.Account))
+	binary.BigEndian.PutUint64(leaf[0:8], uint64(earner.Vpn))
+	binary.BigEndian.PutUint64(leaf[8:16], uint64(earner.Storage))
+	copy(leaf[16:], earner.Account)
+	return leaf
+}
+
+// ClaimTree builds a Merkle tree of an earner list. Returns its root and proofs for every earner.
+func ClaimTree(earners []Earner) (root []byte, proofs []*merkle.SimpleProof) {
+	leaves := make([][]byte, len(earners))
+	for i, earner := range earners {
+		leaves[i] = ClaimLeaf(earner)
+	}
+	return merkle.SimpleProofsFromByteSlices(leaves)
+}
+
+// ClaimRoot returns a Merkle root of an earner list, the same one ClaimTree does
+func ClaimRoot(earners []Earner) []byte {
+	leaves := make([][]byte, len(earners))
+	for i, earner := range earners {
+		leaves[i] = ClaimLeaf(earner)
+	}
+	return merkle.SimpleHashFromByteSlices(leaves)
+}
@@ -9,6 +9,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgListEarners{}, "earning/listEarners", nil)
 	cdc.RegisterConcrete(MsgRun{}, "earning/run", nil)
 	cdc.RegisterConcrete(MsgReset{}, "earning/reset", nil)
+	cdc.RegisterConcrete(MsgRunClaims{}, "earning/runClaims", nil)
+	cdc.RegisterConcrete(MsgClaimEarning{}, "earning/claim", nil)
+	cdc.RegisterConcrete(MsgChallenge{}, "earning/challenge", nil)
 }
 
 // ModuleCdc defines the module codec
@@ -10,4 +10,20 @@ var (
 	ErrLocked        = sdkerrors.Register(ModuleName, 3, "earner list is locked")
 	ErrNotLocked     = sdkerrors.Register(ModuleName, 4, "earner list is not locked")
 	ErrNoMoney       = sdkerrors.Register(ModuleName, 5, "there are no coins in VPN&storage module accounts")
+	ErrNoClaimPeriod = sdkerrors.Register(ModuleName, 6, "no claim period is open")
+	ErrTooEarly      = sdkerrors.Register(ModuleName, 7, "too early, claims are not accepted yet")
+	ErrClaimed       = sdkerrors.Register(ModuleName, 8, "earnings are already claimed")
+	ErrInvalidProof  = sdkerrors.Register(ModuleName, 9, "invalid Merkle proof")
+
+	ErrAlreadyApproved     = sdkerrors.Register(ModuleName, 10, "earning period is already approved by the signer")
+	ErrAttestationNotFound = sdkerrors.Register(ModuleName, 11, "attestation not found")
+	ErrListChanged         = sdkerrors.Register(ModuleName, 12, "earner list has changed since it was approved")
+
+	ErrSnapshotNotFound = sdkerrors.Register(ModuleName, 13, "fund snapshot not found")
+	ErrSnapshotUsed     = sdkerrors.Register(ModuleName, 14, "fund snapshot is already used")
+
+	ErrPeriodNotFound = sdkerrors.Register(ModuleName, 15, "earning period not found")
+
+	ErrClaimPeriodOver = sdkerrors.Register(ModuleName, 16, "claim period is over")
+	ErrFundExhausted   = sdkerrors.Register(ModuleName, 17, "claim period fund is exhausted")
 )
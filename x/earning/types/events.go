@@ -6,9 +6,20 @@ const (
 	EventTypeFinish = "finish-paying-earnings"
 	EventTypeEarn   = "earn"
 
+	EventTypeApprove           = "approve-earnings"
+	EventTypeChallenge         = "challenge-earnings"
+	EventTypeAttestationFailed = "earnings-attestation-failed"
+	EventTypeSnapshot          = "earning-fund-snapshot"
+
 	AttributeKeyAddress = "address"
 	AttributeKeyVpn     = "vpn"
 	AttributeKeyStorage = "storage"
 
+	AttributeKeyHash      = "hash"
+	AttributeKeySigner    = "signer"
+	AttributeKeyApprovals = "approvals"
+	AttributeKeyError     = "error"
+	AttributeKeySnapshot  = "snapshot"
+
 	AttributeValueCategory = ModuleName
 )
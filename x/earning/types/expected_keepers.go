@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	supply "github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/arterynetwork/artr/x/schedule"
 )
 
 // ParamSubspace defines the expected Subspace interfacace
@@ -22,5 +24,8 @@ type SupplyKeeper interface {
 }
 
 type ScheduleKeeper interface {
-	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) error
+	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) (uint64, error)
+	ScheduleRecurringTask(ctx sdk.Context, event string, data *[]byte, start, interval, end uint64) (uint64, error)
+	GetRecurringTasksByHandler(ctx sdk.Context, handler string) []schedule.RecurringTask
+	CancelRecurringTask(ctx sdk.Context, id uint64) error
 }
@@ -1,20 +1,46 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // GenesisState - all earning state that must be provided at genesis
 type GenesisState struct {
 	Params  Params      `json:"params"`
 	State   StateParams `json:"state,omitempty"`
 	Earners []Earner    `json:"earners,omitempty"`
+	// ClaimPeriod is the current claim-based earning period, if any
+	ClaimPeriod *ClaimPeriod     `json:"claim_period,omitempty"`
+	Claimed     []sdk.AccAddress `json:"claimed,omitempty"`
+	Migrated    []Migration      `json:"migrated,omitempty"`
+	// Attestations are earning periods waiting for signer approvals
+	Attestations []Attestation `json:"attestations,omitempty"`
+	// Snapshots are VPN and storage fund balances at the end of billing periods
+	Snapshots []Snapshot `json:"snapshots,omitempty"`
+	// Periods and Payouts are earning history
+	Periods []PeriodSummary `json:"periods,omitempty"`
+	Payouts []Payout        `json:"payouts,omitempty"`
+	// CurrentPeriod is an ID of the earning period in progress, zero if there is none
+	CurrentPeriod uint64 `json:"current_period,omitempty"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, state StateParams, earners []Earner) GenesisState {
+func NewGenesisState(params Params, state StateParams, earners []Earner, claimPeriod *ClaimPeriod, claimed []sdk.AccAddress, migrated []Migration, attestations []Attestation, snapshots []Snapshot, periods []PeriodSummary, payouts []Payout, currentPeriod uint64) GenesisState {
 	return GenesisState{
-		Params:  params,
-		State:   state,
-		Earners: earners[:],
+		Params:       params,
+		State:        state,
+		Earners:      earners[:],
+		ClaimPeriod:  claimPeriod,
+		Claimed:      claimed,
+		Migrated:     migrated,
+		Attestations: attestations,
+		Snapshots:    snapshots,
+
+		Periods:       periods,
+		Payouts:       payouts,
+		CurrentPeriod: currentPeriod,
 	}
 }
 
@@ -42,5 +68,77 @@ func ValidateGenesis(data GenesisState) error {
 			return fmt.Errorf("storage points must be non-negative")
 		}
 	}
+	if data.ClaimPeriod == nil && len(data.Claimed) != 0 {
+		return fmt.Errorf("claimed accounts without a claim period")
+	}
+	if data.ClaimPeriod == nil && len(data.Migrated) != 0 {
+		return fmt.Errorf("migrated accounts without a claim period")
+	}
+	if data.ClaimPeriod != nil {
+		if err := validatePointCost(data.ClaimPeriod.VpnPointCost); err != nil {
+			return err
+		}
+		if err := validatePointCost(data.ClaimPeriod.StoragePointCost); err != nil {
+			return err
+		}
+		if data.ClaimPeriod.End <= data.ClaimPeriod.Start {
+			return fmt.Errorf("claim period must end after its start")
+		}
+		if data.ClaimPeriod.Paid.Vpn > data.ClaimPeriod.Fund.Vpn || data.ClaimPeriod.Paid.Storage > data.ClaimPeriod.Fund.Storage {
+			return fmt.Errorf("claim period paid more than its fund")
+		}
+	}
+	for i, acc := range data.Claimed {
+		if acc.Empty() {
+			return fmt.Errorf("claimed account is empty (#%d)", i)
+		}
+	}
+	for i, m := range data.Migrated {
+		if m.From.Empty() || m.To.Empty() {
+			return fmt.Errorf("migrated account is empty (#%d)", i)
+		}
+	}
+	for i, a := range data.Attestations {
+		if a.Kind != AttestationKindList && a.Kind != AttestationKindClaims && a.Kind != AttestationKindReset {
+			return fmt.Errorf("unknown attestation kind %q (#%d)", a.Kind, i)
+		}
+		if len(a.Approvals) == 0 {
+			return fmt.Errorf("attestation has no approvals (#%d)", i)
+		}
+	}
+	snapshotIDs := make(map[uint64]bool, len(data.Snapshots))
+	for i, s := range data.Snapshots {
+		if s.ID == 0 {
+			return fmt.Errorf("snapshot ID is zero (#%d)", i)
+		}
+		if snapshotIDs[s.ID] {
+			return fmt.Errorf("duplicate snapshot ID %d (#%d)", s.ID, i)
+		}
+		snapshotIDs[s.ID] = true
+		if s.Vpn < 0 || s.Storage < 0 {
+			return fmt.Errorf("snapshot balances must be non-negative (#%d)", i)
+		}
+	}
+	periodIDs := make(map[uint64]bool, len(data.Periods))
+	for i, p := range data.Periods {
+		if p.ID == 0 {
+			return fmt.Errorf("period ID is zero (#%d)", i)
+		}
+		if periodIDs[p.ID] {
+			return fmt.Errorf("duplicate period ID %d (#%d)", p.ID, i)
+		}
+		periodIDs[p.ID] = true
+	}
+	if data.CurrentPeriod != 0 && !periodIDs[data.CurrentPeriod] {
+		return fmt.Errorf("current period %d not found", data.CurrentPeriod)
+	}
+	for i, p := range data.Payouts {
+		if p.Account.Empty() {
+			return fmt.Errorf("payout account is empty (#%d)", i)
+		}
+		if p.Period != 0 && !periodIDs[p.Period] {
+			return fmt.Errorf("payout period %d not found (#%d)", p.Period, i)
+		}
+	}
 	return nil
 }
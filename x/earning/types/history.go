@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/util"
+)
+
+// PeriodSummary is a history record of an earning period
+type PeriodSummary struct {
+	ID uint64 `json:"id"`
+	// Kind is how earnings are paid, either AttestationKindList (by the pending list) or AttestationKindClaims
+	Kind             string        `json:"kind"`
+	VpnPointCost     util.Fraction `json:"vpn_point_cost"`
+	StoragePointCost util.Fraction `json:"storage_point_cost"`
+	Total            Points        `json:"total"`
+	SnapshotID       uint64        `json:"snapshot_id,omitempty"`
+	// Start is a block height earnings are paid (or claims are accepted) from
+	Start int64 `json:"start"`
+	// Finish is a block height the period is finished (or reset) at, zero if it's still in progress
+	Finish int64 `json:"finish,omitempty"`
+	// Payouts is how many earners are paid
+	Payouts uint64 `json:"payouts"`
+	// Paid is how many uARTRs are paid in total
+	Paid int64 `json:"paid"`
+	// Residual is how many uARTRs are left on the module account when the period is finished
+	Residual int64 `json:"residual,omitempty"`
+}
+
+func NewPeriodSummary(kind string, vpnPointCost util.Fraction, storagePointCost util.Fraction, total Points, snapshotID uint64, start int64) PeriodSummary {
+	return PeriodSummary{
+		Kind:             kind,
+		VpnPointCost:     vpnPointCost,
+		StoragePointCost: storagePointCost,
+		Total:            total,
+		SnapshotID:       snapshotID,
+		Start:            start,
+	}
+}
+
+func (p PeriodSummary) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`
+ID: %d
+Kind: %s
+VpnPointCost: %v
+StoragePointCost: %v
+TotalVpn: %d
+TotalStorage: %d
+SnapshotID: %d
+Start: %d
+Finish: %d
+Payouts: %d
+Paid: %d
+Residual: %d
+`, p.ID, p.Kind, p.VpnPointCost, p.StoragePointCost, p.Total.Vpn, p.Total.Storage, p.SnapshotID, p.Start, p.Finish, p.Payouts, p.Paid, p.Residual))
+}
+
+// Payout is a history record of earnings paid to an earner. Period is zero for payouts of a period started
+// before the history was kept.
+type Payout struct {
+	Period  uint64         `json:"period"`
+	Height  int64          `json:"height"`
+	Account sdk.AccAddress `json:"account"`
+	Vpn     int64          `json:"vpn"`
+	Storage int64          `json:"storage"`
+}
+
+func NewPayout(period uint64, height int64, acc sdk.AccAddress, vpn int64, storage int64) Payout {
+	return Payout{
+		Period:  period,
+		Height:  height,
+		Account: acc,
+		Vpn:     vpn,
+		Storage: storage,
+	}
+}
+
+func (p Payout) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`
+Period: %d
+Height: %d
+Account: %s
+Vpn: %d
+Storage: %d
+`, p.Period, p.Height, p.Account, p.Vpn, p.Storage))
+}
+
+type QueryPeriodsRes struct {
+	Periods []PeriodSummary `json:"periods" yaml:"periods"`
+}
+
+func (res QueryPeriodsRes) String() string {
+	lines := make([]string, len(res.Periods))
+	for i, p := range res.Periods {
+		lines[i] = p.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
+
+type QueryPayoutsRes struct {
+	Payouts []Payout `json:"payouts" yaml:"payouts"`
+}
+
+func (res QueryPayoutsRes) String() string {
+	lines := make([]string, len(res.Payouts))
+	for i, p := range res.Payouts {
+		lines[i] = p.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
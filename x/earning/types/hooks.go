@@ -2,3 +2,6 @@ package types
 
 const StartHookName = "earning/start"
 const ContinueHookName = "earning/continue"
+const AttestedHookName = "earning/attested"
+const SnapshotHookName = "earning/snapshot"
+const ClaimsCloseHookName = "earning/claims-close"
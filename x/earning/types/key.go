@@ -13,3 +13,28 @@ const (
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
 )
+
+var (
+	// EarnerPrefix is a prefix for the pending earner list (account -> points)
+	EarnerPrefix = []byte{0x00}
+	// ClaimPeriodKey is a key for the current claim-based earning period
+	ClaimPeriodKey = []byte{0x01}
+	// ClaimedPrefix is a prefix for accounts which have already claimed their earnings in the current claim period
+	ClaimedPrefix = []byte{0x02}
+	// AttestationPrefix is a prefix for earning periods waiting for signer approvals (hash -> attestation)
+	AttestationPrefix = []byte{0x03}
+	// SnapshotPrefix is a prefix for fund snapshots (ID -> snapshot)
+	SnapshotPrefix = []byte{0x04}
+	// NextSnapshotIDKey is a key for the next fund snapshot ID
+	NextSnapshotIDKey = []byte{0x05}
+	// PeriodPrefix is a prefix for earning period history (ID -> summary)
+	PeriodPrefix = []byte{0x06}
+	// PayoutPrefix is a prefix for payout history (account | period ID -> payout)
+	PayoutPrefix = []byte{0x07}
+	// NextPeriodIDKey is a key for the next earning period ID
+	NextPeriodIDKey = []byte{0x08}
+	// CurrentPeriodKey is a key for the ID of the earning period in progress, if any
+	CurrentPeriodKey = []byte{0x09}
+	// MigratedPrefix is a prefix for accounts migrated during the current claim period (old address -> new one)
+	MigratedPrefix = []byte{0x0A}
+)
@@ -4,12 +4,17 @@ import (
 	"github.com/arterynetwork/artr/util"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // verify interface at compile time
 var _ MsgEarningCommandI = &MsgListEarners{}
 var _ MsgEarningCommandI = &MsgRun{}
 var _ MsgEarningCommandI = &MsgReset{}
+var _ MsgEarningCommandI = &MsgRunClaims{}
+var _ sdk.Msg = &MsgClaimEarning{}
+var _ MsgEarningCommandI = &MsgChallenge{}
 
 type MsgEarningCommandI interface {
 	sdk.Msg
@@ -28,12 +33,41 @@ type MsgRun struct {
 	TotalVpnPoints     int64          `json:"total_vpn"`
 	TotalStoragePoints int64          `json:"total_storage"`
 	Height             int64          `json:"height"`
+	// SnapshotID refers to fund balances the period is paid from, zero means live balances
+	SnapshotID uint64 `json:"snapshot_id,omitempty"`
 }
 
 type MsgReset struct {
 	Sender sdk.AccAddress `json:"sender"`
 }
 
+// MsgRunClaims locks funds for an earning period paid by claims. Only a Merkle root of the earner list
+// (see ClaimTree) is submitted.
+type MsgRunClaims struct {
+	Sender             sdk.AccAddress `json:"sender"`
+	FundPart           util.Fraction  `json:"fund_part"`
+	Root               []byte         `json:"root"`
+	TotalVpnPoints     int64          `json:"total_vpn"`
+	TotalStoragePoints int64          `json:"total_storage"`
+	Height             int64          `json:"height"`
+	// SnapshotID refers to fund balances the period is paid from, zero means live balances
+	SnapshotID uint64 `json:"snapshot_id,omitempty"`
+}
+
+// MsgClaimEarning is sent by an earner to get paid for the current claim period
+type MsgClaimEarning struct {
+	Account       sdk.AccAddress     `json:"account"`
+	VpnPoints     int64              `json:"vpn"`
+	StoragePoints int64              `json:"storage"`
+	Proof         merkle.SimpleProof `json:"proof"`
+}
+
+// MsgChallenge discards an attestation before its earning period is run
+type MsgChallenge struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Hash   []byte         `json:"hash"`
+}
+
 // NewMsg<Action> creates a new Msg<Action> instance
 func NewMsgListEarners(sender sdk.AccAddress, earners []Earner) MsgListEarners {
 	return MsgListEarners{
@@ -42,13 +76,14 @@ func NewMsgListEarners(sender sdk.AccAddress, earners []Earner) MsgListEarners {
 	}
 }
 
-func NewMsgRun(sender sdk.AccAddress, fundPart util.Fraction, perBlock uint16, totalVpn int64, totalStorage int64, height int64) MsgRun {
+func NewMsgRun(sender sdk.AccAddress, fundPart util.Fraction, perBlock uint16, totalVpn int64, totalStorage int64, height int64, snapshotID uint64) MsgRun {
 	return MsgRun{
 		FundPart:           fundPart,
 		AccountPerBlock:    perBlock,
 		TotalVpnPoints:     totalVpn,
 		TotalStoragePoints: totalStorage,
 		Height:             height,
+		SnapshotID:         snapshotID,
 		Sender:             sender,
 	}
 }
@@ -57,9 +92,40 @@ func NewMsgReset(sender sdk.AccAddress) MsgReset {
 	return MsgReset{sender}
 }
 
+func NewMsgRunClaims(sender sdk.AccAddress, fundPart util.Fraction, root []byte, totalVpn int64, totalStorage int64, height int64, snapshotID uint64) MsgRunClaims {
+	return MsgRunClaims{
+		Sender:             sender,
+		FundPart:           fundPart,
+		Root:               root,
+		TotalVpnPoints:     totalVpn,
+		TotalStoragePoints: totalStorage,
+		Height:             height,
+		SnapshotID:         snapshotID,
+	}
+}
+
+func NewMsgClaimEarning(account sdk.AccAddress, vpn int64, storage int64, proof merkle.SimpleProof) MsgClaimEarning {
+	return MsgClaimEarning{
+		Account:       account,
+		VpnPoints:     vpn,
+		StoragePoints: storage,
+		Proof:         proof,
+	}
+}
+
 const ListEarnersConst = "list-earners"
 const RunConst = "run"
 const ResetConst = "reset"
+const RunClaimsConst = "run-claims"
+const ClaimEarningConst = "claim"
+const ChallengeConst = "challenge"
+
+func NewMsgChallenge(sender sdk.AccAddress, hash []byte) MsgChallenge {
+	return MsgChallenge{
+		Sender: sender,
+		Hash:   hash,
+	}
+}
 
 // nolint
 func (msg MsgListEarners) GetSender() sdk.AccAddress    { return msg.Sender }
@@ -149,3 +215,94 @@ func (msg MsgReset) ValidateBasic() error {
 	}
 	return nil
 }
+
+// nolint
+func (msg MsgRunClaims) GetSender() sdk.AccAddress    { return msg.Sender }
+func (msg MsgRunClaims) Route() string                { return RouterKey }
+func (msg MsgRunClaims) Type() string                 { return RunClaimsConst }
+func (msg MsgRunClaims) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Sender} }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRunClaims) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRunClaims) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return fmt.Errorf("missing sender")
+	}
+	if !msg.FundPart.IsPositive() {
+		return fmt.Errorf("fund part must be positive")
+	}
+	if msg.FundPart.GT(util.FractionInt(1)) {
+		return fmt.Errorf("fund part must be less than or equal 1")
+	}
+	if len(msg.Root) != tmhash.Size {
+		return fmt.Errorf("root must be %d bytes long", tmhash.Size)
+	}
+	if msg.TotalVpnPoints < 0 {
+		return fmt.Errorf("total VPN points must be non-negative")
+	}
+	if msg.TotalStoragePoints < 0 {
+		return fmt.Errorf("total storage points must be non-negative")
+	}
+	return nil
+}
+
+// nolint
+func (msg MsgClaimEarning) Route() string                { return RouterKey }
+func (msg MsgClaimEarning) Type() string                 { return ClaimEarningConst }
+func (msg MsgClaimEarning) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Account} }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgClaimEarning) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgClaimEarning) ValidateBasic() error {
+	if msg.Account.Empty() {
+		return fmt.Errorf("missing account")
+	}
+	if msg.VpnPoints < 0 {
+		return fmt.Errorf("vpn points must be non-negative")
+	}
+	if msg.StoragePoints < 0 {
+		return fmt.Errorf("storage points must be non-negative")
+	}
+	if err := msg.Proof.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid proof: %w", err)
+	}
+	return nil
+}
+
+// Earner returns the earner record the message claims for
+func (msg MsgClaimEarning) Earner() Earner {
+	return NewEarner(msg.Account, msg.VpnPoints, msg.StoragePoints)
+}
+
+// nolint
+func (msg MsgChallenge) GetSender() sdk.AccAddress    { return msg.Sender }
+func (msg MsgChallenge) Route() string                { return RouterKey }
+func (msg MsgChallenge) Type() string                 { return ChallengeConst }
+func (msg MsgChallenge) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Sender} }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgChallenge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgChallenge) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return fmt.Errorf("missing sender")
+	}
+	if len(msg.Hash) != tmhash.Size {
+		return fmt.Errorf("hash must be %d bytes long", tmhash.Size)
+	}
+	return nil
+}
@@ -14,12 +14,19 @@ import (
 // Default parameter namespace
 const (
 	DefaultParamspace = ModuleName
+
+	// DefaultClaimWindow is a claim period duration used if the ClaimWindow param is not set
+	DefaultClaimWindow int64 = util.BlocksOneMonth
 )
 
 // Parameter store keys
 var (
 	// default paramspace keys
-	KeySigners = []byte("Signers")
+	KeySigners         = []byte("Signers")
+	KeyMinApprovals    = []byte("MinApprovals")
+	KeyChallengeWindow = []byte("ChallengeWindow")
+	KeySnapshotPeriod  = []byte("SnapshotPeriod")
+	KeyClaimWindow     = []byte("ClaimWindow")
 
 	// state paramspace keys
 	KeyLocked           = []byte("Locked")
@@ -38,6 +45,18 @@ func ParamKeyTable() params.KeyTable {
 // Params - used for initializing default parameter for earning at genesis
 type Params struct {
 	Signers []sdk.AccAddress `json:"signers" yaml:"signers"`
+	// MinApprovals is how many signers must approve the same earning period before it's run. Zero or one means
+	// a single signer is enough.
+	MinApprovals uint32 `json:"min_approvals,omitempty" yaml:"min_approvals,omitempty"`
+	// ChallengeWindow is how many blocks an approved earning period waits before it's run, so that any signer
+	// can challenge it
+	ChallengeWindow int64 `json:"challenge_window,omitempty" yaml:"challenge_window,omitempty"`
+	// SnapshotPeriod is a billing period duration (in blocks), VPN and storage fund balances are snapshotted at the
+	// end of each one. Zero means no snapshots are taken and earning periods are paid from live balances.
+	SnapshotPeriod int64 `json:"snapshot_period,omitempty" yaml:"snapshot_period,omitempty"`
+	// ClaimWindow is how many blocks earners can claim their earnings for, counting from a claim period start.
+	// Zero means DefaultClaimWindow.
+	ClaimWindow int64 `json:"claim_window,omitempty" yaml:"claim_window,omitempty"`
 }
 
 // StateParams - used for storing keeper inner state and exporting it to genesis if needed
@@ -62,7 +81,40 @@ func NewStateUnlocked() StateParams {
 	return StateParams{}
 }
 
-func (p Params) String() string { return fmt.Sprintf("Signers: %v", p.Signers) }
+func (p Params) String() string {
+	return fmt.Sprintf(`
+Signers: %v
+MinApprovals: %d
+ChallengeWindow: %d
+SnapshotPeriod: %d
+ClaimWindow: %d
+`, p.Signers, p.MinApprovals, p.ChallengeWindow, p.SnapshotPeriod, p.ClaimWindow)
+}
+
+// RequiresAttestation checks if earning periods must be attested (rather than run by a single signer at once)
+func (p Params) RequiresAttestation() bool {
+	return p.MinApprovals > 1 || p.ChallengeWindow > 0
+}
+
+// ClaimPeriodDuration returns how many blocks a claim period lasts
+func (p Params) ClaimPeriodDuration() int64 {
+	if p.ClaimWindow > 0 {
+		return p.ClaimWindow
+	}
+	return DefaultClaimWindow
+}
+
+// Quorum returns how many signer approvals are enough to run an earning period
+func (p Params) Quorum() int {
+	n := int(p.MinApprovals)
+	if n < 1 {
+		n = 1
+	}
+	if n > len(p.Signers) {
+		n = len(p.Signers)
+	}
+	return n
+}
 
 // String implements the stringer interface for Params
 func (p StateParams) String() string {
@@ -77,6 +129,10 @@ ItemsPerBlock: %d
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeySigners, &p.Signers, validateSigners),
+		params.NewParamSetPair(KeyMinApprovals, &p.MinApprovals, validateMinApprovals),
+		params.NewParamSetPair(KeyChallengeWindow, &p.ChallengeWindow, validateChallengeWindow),
+		params.NewParamSetPair(KeySnapshotPeriod, &p.SnapshotPeriod, validateSnapshotPeriod),
+		params.NewParamSetPair(KeyClaimWindow, &p.ClaimWindow, validateClaimWindow),
 	}
 }
 
@@ -100,6 +156,61 @@ func (p Params) Validate() error {
 	if err := validateSigners(p.Signers); err != nil {
 		return err
 	}
+	if err := validateMinApprovals(p.MinApprovals); err != nil {
+		return err
+	}
+	if int(p.MinApprovals) > len(p.Signers) {
+		return fmt.Errorf("min approvals (%d) exceeds signer count (%d)", p.MinApprovals, len(p.Signers))
+	}
+	if err := validateChallengeWindow(p.ChallengeWindow); err != nil {
+		return err
+	}
+	if err := validateSnapshotPeriod(p.SnapshotPeriod); err != nil {
+		return err
+	}
+	if err := validateClaimWindow(p.ClaimWindow); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateMinApprovals(value interface{}) error {
+	if _, ok := value.(uint32); !ok {
+		return fmt.Errorf("unexpected MinApprovals type: %T", value)
+	}
+	return nil
+}
+
+func validateChallengeWindow(value interface{}) error {
+	n, ok := value.(int64)
+	if !ok {
+		return fmt.Errorf("unexpected ChallengeWindow type: %T", value)
+	}
+	if n < 0 {
+		return fmt.Errorf("challenge window must be non-negative")
+	}
+	return nil
+}
+
+func validateSnapshotPeriod(value interface{}) error {
+	n, ok := value.(int64)
+	if !ok {
+		return fmt.Errorf("unexpected SnapshotPeriod type: %T", value)
+	}
+	if n < 0 {
+		return fmt.Errorf("snapshot period must be non-negative")
+	}
+	return nil
+}
+
+func validateClaimWindow(value interface{}) error {
+	n, ok := value.(int64)
+	if !ok {
+		return fmt.Errorf("unexpected ClaimWindow type: %T", value)
+	}
+	if n < 0 {
+		return fmt.Errorf("claim window must be non-negative")
+	}
 	return nil
 }
 
@@ -1,5 +1,69 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
-	QueryParams = "params"
+	QueryParams       = "params"
+	QueryClaimPeriod  = "claim_period"
+	QueryClaimed      = "claimed"
+	QueryAttestations = "attestations"
+	QuerySnapshots    = "snapshots"
+	QuerySnapshot     = "snapshot"
+	QueryPeriods      = "periods"
+	QueryPeriod       = "period"
+	QueryPayouts      = "payouts"
 )
+
+type QueryClaimedParams struct {
+	Account sdk.AccAddress `json:"account"`
+}
+
+func NewQueryClaimedParams(acc sdk.AccAddress) QueryClaimedParams {
+	return QueryClaimedParams{Account: acc}
+}
+
+type QueryClaimedRes struct {
+	Claimed bool `json:"claimed"`
+}
+
+type QuerySnapshotParams struct {
+	ID uint64 `json:"id"`
+}
+
+func NewQuerySnapshotParams(id uint64) QuerySnapshotParams {
+	return QuerySnapshotParams{ID: id}
+}
+
+type QueryPeriodsParams struct {
+	Limit int32 `json:"limit" yaml:"limit"`
+	Page  int32 `json:"page" yaml:"page"`
+}
+
+func NewQueryPeriodsParams(limit int32, page int32) QueryPeriodsParams {
+	return QueryPeriodsParams{
+		Limit: limit,
+		Page:  page,
+	}
+}
+
+type QueryPeriodParams struct {
+	ID uint64 `json:"id"`
+}
+
+func NewQueryPeriodParams(id uint64) QueryPeriodParams {
+	return QueryPeriodParams{ID: id}
+}
+
+type QueryPayoutsParams struct {
+	Account sdk.AccAddress `json:"account" yaml:"account"`
+	Limit   int32          `json:"limit" yaml:"limit"`
+	Page    int32          `json:"page" yaml:"page"`
+}
+
+func NewQueryPayoutsParams(acc sdk.AccAddress, limit int32, page int32) QueryPayoutsParams {
+	return QueryPayoutsParams{
+		Account: acc,
+		Limit:   limit,
+		Page:    page,
+	}
+}
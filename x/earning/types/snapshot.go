@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Snapshot is VPN and storage module account balances at the end of a billing period. Earning periods are
+// paid from a snapshot rather than from live balances, so that payouts don't depend on transaction ordering.
+type Snapshot struct {
+	ID uint64 `json:"id"`
+	// Height is a block height the snapshot is taken at (before any transaction of the block)
+	Height int64 `json:"height"`
+	// Time is a block time (Unix seconds) the snapshot is taken at
+	Time    int64 `json:"time"`
+	Vpn     int64 `json:"vpn"`
+	Storage int64 `json:"storage"`
+	// Used is set when an earning period is paid from the snapshot, it can be used only once
+	Used bool `json:"used,omitempty"`
+}
+
+func NewSnapshot(id uint64, height int64, time int64, vpn int64, storage int64) Snapshot {
+	return Snapshot{
+		ID:      id,
+		Height:  height,
+		Time:    time,
+		Vpn:     vpn,
+		Storage: storage,
+	}
+}
+
+func (s Snapshot) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`
+ID: %d
+Height: %d
+Time: %d
+Vpn: %d
+Storage: %d
+Used: %t
+`, s.ID, s.Height, s.Time, s.Vpn, s.Storage, s.Used))
+}
+
+type QuerySnapshotsRes []Snapshot
+
+func (res QuerySnapshotsRes) String() string {
+	lines := make([]string, len(res))
+	for i, s := range res {
+		lines[i] = s.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
@@ -0,0 +1,47 @@
+package escrow
+
+import (
+	"github.com/arterynetwork/artr/x/escrow/keeper"
+	"github.com/arterynetwork/artr/x/escrow/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	RouterKey    = types.RouterKey
+	StoreKey     = types.StoreKey
+	QuerierRoute = types.QuerierRoute
+
+	EventTypeCreated  = types.EventTypeCreated
+	EventTypeReleased = types.EventTypeReleased
+	EventTypeRefunded = types.EventTypeRefunded
+)
+
+var (
+	// functions aliases
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	RegisterCodec       = types.RegisterCodec
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+	NewEscrow           = types.NewEscrow
+	NewMsgCreateEscrow  = types.NewMsgCreateEscrow
+	NewMsgReleaseEscrow = types.NewMsgReleaseEscrow
+	NewMsgRefundEscrow  = types.NewMsgRefundEscrow
+	ErrNoEscrow         = types.ErrNoEscrow
+	ErrNotExpired       = types.ErrNotExpired
+	ErrExpired          = types.ErrExpired
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+)
+
+type (
+	Keeper           = keeper.Keeper
+	GenesisState     = types.GenesisState
+	Escrow           = types.Escrow
+	Escrows          = types.Escrows
+	MsgCreateEscrow  = types.MsgCreateEscrow
+	MsgReleaseEscrow = types.MsgReleaseEscrow
+	MsgRefundEscrow  = types.MsgRefundEscrow
+)
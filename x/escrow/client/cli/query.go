@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/arterynetwork/artr/x/escrow/types"
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	escrowQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	escrowQueryCmd.AddCommand(
+		flags.GetCommands(
+			getCmdEscrow(queryRoute, cdc),
+			getCmdByParty(queryRoute, cdc),
+		)...,
+	)
+
+	return escrowQueryCmd
+}
+
+func getCmdEscrow(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <id>",
+		Short: "Query an escrow by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryEscrowParams(id))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEscrow), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Escrow
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdByParty(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "by-party <address>",
+		Short: "Query escrows the account is the payer, the recipient or the arbiter of",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryByPartyParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryByParty), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Escrows
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
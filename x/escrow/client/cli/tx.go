@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/arterynetwork/artr/x/escrow/types"
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	escrowTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	escrowTxCmd.AddCommand(flags.PostCommands(
+		GetCmdCreate(cdc),
+		GetCmdRelease(cdc),
+		GetCmdRefund(cdc),
+	)...)
+
+	return escrowTxCmd
+}
+
+func GetCmdCreate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <payer_key_or_address> <recipient> <amount> <expires_at>",
+		Short: "Lock coins for a recipient until the <expires_at> block height",
+		Long: "Lock coins for a recipient. The payer (or an arbiter, if any) can release them to the recipient. " +
+			"The arbiter can refund them to the payer at any time, the payer can do that after the escrow expires.",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := resolver.ResolveAddressCLI(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiresAt, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var arbiter sdk.AccAddress
+			if ref := viper.GetString(FlagArbiter); ref != "" {
+				arbiter, err = resolver.ResolveAddressCLI(cliCtx, ref)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateEscrow(cliCtx.GetFromAddress(), recipient, arbiter, amount, expiresAt)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagArbiter, "", "An account that can release or refund the escrow")
+
+	return cmd
+}
+
+func GetCmdRelease(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "release <payer_or_arbiter_key_or_address> <id>",
+		Short: "Send escrowed coins to the recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseEscrow(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRefund(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "refund <payer_or_arbiter_key_or_address> <id>",
+		Short: "Return escrowed coins to the payer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundEscrow(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/arterynetwork/artr/x/escrow/types"
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/escrow/escrows",
+		createEscrowHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/escrow/escrows/{id}/release",
+		releaseEscrowHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/escrow/escrows/{id}/refund",
+		refundEscrowHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+// CreateEscrowReq defines the properties of an escrow creation request's body. The recipient and the arbiter can be
+// referred to by an address, a @nickname or a card number.
+type CreateEscrowReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Recipient string       `json:"recipient" yaml:"recipient"`
+	Arbiter   string       `json:"arbiter,omitempty" yaml:"arbiter,omitempty"`
+	Amount    sdk.Coins    `json:"amount" yaml:"amount"`
+	ExpiresAt int64        `json:"expires_at" yaml:"expires_at"`
+}
+
+func createEscrowHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateEscrowReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		payer, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		recipient, ok := resolver.ResolveAddressREST(w, cliCtx, req.Recipient)
+		if !ok {
+			return
+		}
+		var arbiter sdk.AccAddress
+		if req.Arbiter != "" {
+			if arbiter, ok = resolver.ResolveAddressREST(w, cliCtx, req.Arbiter); !ok {
+				return
+			}
+		}
+
+		msg := types.NewMsgCreateEscrow(payer, recipient, arbiter, req.Amount, req.ExpiresAt)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// EscrowReq defines the properties of an escrow release or refund request's body
+type EscrowReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+func releaseEscrowHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return escrowHandlerFn(cliCtx, func(sender sdk.AccAddress, id uint64) sdk.Msg {
+		return types.NewMsgReleaseEscrow(sender, id)
+	})
+}
+
+func refundEscrowHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return escrowHandlerFn(cliCtx, func(sender sdk.AccAddress, id uint64) sdk.Msg {
+		return types.NewMsgRefundEscrow(sender, id)
+	})
+}
+
+func escrowHandlerFn(cliCtx context.CLIContext, newMsg func(sender sdk.AccAddress, id uint64) sdk.Msg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req EscrowReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := newMsg(sender, id)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,18 @@
+package escrow
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initialize the escrow store from a genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.InitEscrows(ctx, data.Escrows)
+	k.SetNextID(ctx, data.NextID)
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
+	return NewGenesisState(k.ExportEscrows(ctx), k.GetNextID(ctx))
+}
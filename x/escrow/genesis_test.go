@@ -0,0 +1,88 @@
+// +build testing
+
+package escrow_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/app"
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/escrow"
+)
+
+func TestEscrowGenesis(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+type Suite struct {
+	suite.Suite
+
+	app     *app.ArteryApp
+	cleanup func()
+	ctx     sdk.Context
+	k       escrow.Keeper
+}
+
+func (s *Suite) SetupTest() {
+	s.app, s.cleanup = app.NewAppFromGenesis(nil)
+	s.ctx = s.app.NewContext(true, abci.Header{Height: 1})
+	s.k = s.app.GetEscrowKeeper()
+}
+
+func (s *Suite) TearDownTest() {
+	s.cleanup()
+}
+
+func (s Suite) TestCleanGenesis() {
+	s.checkExportImport()
+}
+
+func (s Suite) TestFullData() {
+	_, err := s.k.CreateEscrow(s.ctx, escrow.NewEscrow(
+		app.DefaultGenesisUsers["user1"],
+		app.DefaultGenesisUsers["user2"],
+		nil,
+		util.Uartrs(100_000000),
+		100,
+	))
+	s.NoError(err)
+	id, err := s.k.CreateEscrow(s.ctx, escrow.NewEscrow(
+		app.DefaultGenesisUsers["user3"],
+		app.DefaultGenesisUsers["user4"],
+		app.DefaultGenesisUsers["user5"],
+		util.Uartrs(200_000000),
+		200,
+	))
+	s.NoError(err)
+	s.NoError(s.k.RefundEscrow(s.ctx, app.DefaultGenesisUsers["user5"], id))
+	_, err = s.k.CreateEscrow(s.ctx, escrow.NewEscrow(
+		app.DefaultGenesisUsers["user3"],
+		app.DefaultGenesisUsers["user4"],
+		app.DefaultGenesisUsers["user5"],
+		util.Uartrs(300_000000),
+		300,
+	))
+	s.NoError(err)
+	s.checkExportImport()
+}
+
+func (s Suite) checkExportImport() {
+	s.app.CheckExportImport(s.T(),
+		[]string{
+			escrow.StoreKey,
+		},
+		map[string]app.Decoder{
+			escrow.StoreKey: app.DummyDecoder,
+		},
+		map[string]app.Decoder{
+			escrow.StoreKey: app.DummyDecoder,
+		},
+		make(map[string][][]byte, 0),
+	)
+}
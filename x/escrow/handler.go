@@ -0,0 +1,53 @@
+package escrow
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/x/escrow/types"
+)
+
+// NewHandler creates an sdk.Handler for all the escrow type messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgCreateEscrow:
+			return handleMsgCreateEscrow(ctx, k, msg)
+		case types.MsgReleaseEscrow:
+			return handleMsgReleaseEscrow(ctx, k, msg)
+		case types.MsgRefundEscrow:
+			return handleMsgRefundEscrow(ctx, k, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleMsgCreateEscrow(ctx sdk.Context, k Keeper, msg types.MsgCreateEscrow) (*sdk.Result, error) {
+	id, err := k.CreateEscrow(ctx, types.NewEscrow(msg.Payer, msg.Recipient, msg.Arbiter, msg.Amount, msg.ExpiresAt))
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.Result{
+		Data:   sdk.Uint64ToBigEndian(id),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgReleaseEscrow(ctx sdk.Context, k Keeper, msg types.MsgReleaseEscrow) (*sdk.Result, error) {
+	if err := k.ReleaseEscrow(ctx, msg.Sender, msg.ID); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRefundEscrow(ctx sdk.Context, k Keeper, msg types.MsgRefundEscrow) (*sdk.Result, error) {
+	if err := k.RefundEscrow(ctx, msg.Sender, msg.ID); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
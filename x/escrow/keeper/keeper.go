@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/bank"
+	"github.com/arterynetwork/artr/x/escrow/types"
+)
+
+// Keeper of the escrow store
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	supplyKeeper types.SupplyKeeper
+	bankKeeper   types.BankKeeper
+}
+
+// NewKeeper creates an escrow keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, supplyKeeper types.SupplyKeeper, bankKeeper types.BankKeeper) Keeper {
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		supplyKeeper: supplyKeeper,
+		bankKeeper:   bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetEscrow returns an escrow by its ID (if any)
+func (k Keeper) GetEscrow(ctx sdk.Context, id uint64) (types.Escrow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EscrowKey(id))
+	if bz == nil {
+		return types.Escrow{}, false
+	}
+	var escrow types.Escrow
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &escrow)
+	return escrow, true
+}
+
+// IterateEscrows calls cb for every escrow in the ID order until it returns true
+func (k Keeper) IterateEscrows(ctx sdk.Context, cb func(escrow types.Escrow) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.EscrowPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var escrow types.Escrow
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &escrow)
+		if cb(escrow) {
+			break
+		}
+	}
+}
+
+// GetEscrowsByParty returns all escrows the account is the payer, the recipient or the arbiter of, ordered by ID
+func (k Keeper) GetEscrowsByParty(ctx sdk.Context, addr sdk.AccAddress) types.Escrows {
+	var ids []uint64
+	for _, prefix := range partyIndexPrefixes {
+		ids = append(ids, k.getIndexedIDs(ctx, types.PartyIndexKey(prefix, addr))...)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	result := make(types.Escrows, 0, len(ids))
+	for i, id := range ids {
+		if i > 0 && ids[i-1] == id {
+			continue
+		}
+		if escrow, found := k.GetEscrow(ctx, id); found {
+			result = append(result, escrow)
+		}
+	}
+	return result
+}
+
+// CreateEscrow moves the escrow amount from the payer to the module account. The usual transfer fee is charged.
+// The recipient must accept transfers. Returns the new escrow ID.
+func (k Keeper) CreateEscrow(ctx sdk.Context, escrow types.Escrow) (uint64, error) {
+	if err := escrow.Validate(); err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if escrow.IsExpired(ctx.BlockHeight()) {
+		return 0, sdkerrors.Wrapf(types.ErrExpired, "%d <= %d", escrow.ExpiresAt, ctx.BlockHeight())
+	}
+	if err := k.checkReceiveEnabled(ctx, escrow.Recipient); err != nil {
+		return 0, err
+	}
+
+	if _, err := util.PayTxFee(ctx, k.bankKeeper, k.supplyKeeper, k.Logger(ctx), escrow.Payer, nil, escrow.Amount.AmountOf(util.ConfigMainDenom), types.CreateEscrowConst); err != nil {
+		return 0, err
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, escrow.Payer, types.ModuleName, escrow.Amount); err != nil {
+		return 0, err
+	}
+
+	escrow.ID = k.nextID(ctx)
+	k.setEscrow(ctx, escrow)
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyEscrow, strconv.FormatUint(escrow.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPayer, escrow.Payer.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, escrow.Recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+	}
+	if !escrow.Arbiter.Empty() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyArbiter, escrow.Arbiter.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCreated, attrs...))
+	return escrow.ID, nil
+}
+
+// ReleaseEscrow sends the escrowed coins to the recipient. Only the payer or the arbiter can do that. If the recipient
+// has stopped accepting transfers since the escrow was created, it cannot be released (but can be refunded).
+func (k Keeper) ReleaseEscrow(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoEscrow, "id: %d", id)
+	}
+	if !escrow.Payer.Equals(sender) && !isArbiter(escrow, sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the payer or the arbiter can release an escrow")
+	}
+	if err := k.checkReceiveEnabled(ctx, escrow.Recipient); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, escrow.Recipient, escrow.Amount); err != nil {
+		return err
+	}
+	k.deleteEscrow(ctx, escrow)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReleased,
+		sdk.NewAttribute(types.AttributeKeyEscrow, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, escrow.Recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+	))
+	return nil
+}
+
+// RefundEscrow returns the escrowed coins to the payer. The arbiter can do that at any time, the payer can do that
+// only after the escrow is expired.
+func (k Keeper) RefundEscrow(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoEscrow, "id: %d", id)
+	}
+	if !isArbiter(escrow, sender) {
+		if !escrow.Payer.Equals(sender) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the payer or the arbiter can refund an escrow")
+		}
+		if !escrow.IsExpired(ctx.BlockHeight()) {
+			return sdkerrors.Wrapf(types.ErrNotExpired, "expires at %d", escrow.ExpiresAt)
+		}
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, escrow.Payer, escrow.Amount); err != nil {
+		return err
+	}
+	k.deleteEscrow(ctx, escrow)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefunded,
+		sdk.NewAttribute(types.AttributeKeyEscrow, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyPayer, escrow.Payer.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+	))
+	return nil
+}
+
+// MigrateAccount moves the account's escrows (as any party) to a new address
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	for _, escrow := range k.GetEscrowsByParty(ctx, from) {
+		if escrow.Payer.Equals(from) {
+			escrow.Payer = to
+		}
+		if escrow.Recipient.Equals(from) {
+			escrow.Recipient = to
+		}
+		if escrow.Arbiter.Equals(from) {
+			escrow.Arbiter = to
+		}
+		k.setEscrow(ctx, escrow)
+	}
+	return nil
+}
+
+func (k Keeper) GetNextID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextID(ctx sdk.Context, id uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	ctx.KVStore(k.storeKey).Set(types.NextIDKey, bz)
+}
+
+func (k Keeper) InitEscrows(ctx sdk.Context, escrows []types.Escrow) {
+	for _, escrow := range escrows {
+		k.setEscrow(ctx, escrow)
+	}
+}
+
+func (k Keeper) ExportEscrows(ctx sdk.Context) []types.Escrow {
+	var result []types.Escrow
+	k.IterateEscrows(ctx, func(escrow types.Escrow) (stop bool) {
+		result = append(result, escrow)
+		return false
+	})
+	return result
+}
+
+func (k Keeper) nextID(ctx sdk.Context) uint64 {
+	id := k.GetNextID(ctx)
+	k.SetNextID(ctx, id+1)
+	return id
+}
+
+// setEscrow saves an escrow and puts it to the party indexes (removing the previous version's entries, if any)
+func (k Keeper) setEscrow(ctx sdk.Context, escrow types.Escrow) {
+	if prev, found := k.GetEscrow(ctx, escrow.ID); found {
+		k.deleteIndexEntries(ctx, prev)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EscrowKey(escrow.ID), k.cdc.MustMarshalBinaryLengthPrefixed(escrow))
+	k.setIndexEntries(ctx, escrow)
+}
+
+func (k Keeper) deleteEscrow(ctx sdk.Context, escrow types.Escrow) {
+	ctx.KVStore(k.storeKey).Delete(types.EscrowKey(escrow.ID))
+	k.deleteIndexEntries(ctx, escrow)
+}
+
+var partyIndexPrefixes = [][]byte{types.PayerIndexPrefix, types.RecipientIndexPrefix, types.ArbiterIndexPrefix}
+
+func (k Keeper) setIndexEntries(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.PartyIndexEntryKey(types.PayerIndexPrefix, escrow.Payer, escrow.ID), []byte{})
+	store.Set(types.PartyIndexEntryKey(types.RecipientIndexPrefix, escrow.Recipient, escrow.ID), []byte{})
+	if !escrow.Arbiter.Empty() {
+		store.Set(types.PartyIndexEntryKey(types.ArbiterIndexPrefix, escrow.Arbiter, escrow.ID), []byte{})
+	}
+}
+
+func (k Keeper) deleteIndexEntries(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.PartyIndexEntryKey(types.PayerIndexPrefix, escrow.Payer, escrow.ID))
+	store.Delete(types.PartyIndexEntryKey(types.RecipientIndexPrefix, escrow.Recipient, escrow.ID))
+	if !escrow.Arbiter.Empty() {
+		store.Delete(types.PartyIndexEntryKey(types.ArbiterIndexPrefix, escrow.Arbiter, escrow.ID))
+	}
+}
+
+func (k Keeper) getIndexedIDs(ctx sdk.Context, prefix []byte) []uint64 {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+
+	var result []uint64
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		result = append(result, binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+	return result
+}
+
+func isArbiter(escrow types.Escrow, addr sdk.AccAddress) bool {
+	return !escrow.Arbiter.Empty() && escrow.Arbiter.Equals(addr)
+}
+
+func (k Keeper) checkReceiveEnabled(ctx sdk.Context, recipient sdk.AccAddress) error {
+	if k.bankKeeper.GetTransferSettings(ctx, recipient).ReceiveDisabled {
+		return sdkerrors.Wrap(bank.ErrReceiveDisabled, recipient.String())
+	}
+	return nil
+}
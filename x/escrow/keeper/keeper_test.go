@@ -0,0 +1,217 @@
+// +build testing
+
+package keeper_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/arterynetwork/artr/app"
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/bank"
+	"github.com/arterynetwork/artr/x/escrow"
+)
+
+func TestEscrowKeeper(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+type Suite struct {
+	suite.Suite
+
+	app          *app.ArteryApp
+	cleanup      func()
+	ctx          sdk.Context
+	k            escrow.Keeper
+	accKeeper    auth.AccountKeeper
+	supplyKeeper supply.Keeper
+	handler      sdk.Handler
+}
+
+func (s *Suite) SetupTest() {
+	s.app, s.cleanup = app.NewAppFromGenesis(nil)
+	s.ctx = s.app.NewContext(true, abci.Header{Height: 1})
+	s.k = s.app.GetEscrowKeeper()
+	s.accKeeper = s.app.GetAccountKeeper()
+	s.supplyKeeper = s.app.GetSupplyKeeper()
+	s.handler = escrow.NewHandler(s.k)
+}
+
+func (s *Suite) TearDownTest() {
+	s.cleanup()
+}
+
+func (s Suite) TestRelease() {
+	payer := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+
+	id := s.create(escrow.NewMsgCreateEscrow(payer, recipient, nil, util.Uartrs(100_000000), 10))
+	s.Equal(int64(899_700000), s.balance(payer)) // = 1000(from genesis) - 100 * 100.3%
+	s.Equal(int64(100_000000), s.supplyKeeper.GetModuleAccount(s.ctx, escrow.ModuleName).GetCoins().AmountOf(util.ConfigMainDenom).Int64())
+
+	_, err := s.handler(s.ctx, escrow.NewMsgReleaseEscrow(recipient, id))
+	s.Error(err)
+
+	res, err := s.handler(s.ctx, escrow.NewMsgReleaseEscrow(payer, id))
+	s.NoError(err)
+	s.True(hasEvent(res.Events, escrow.EventTypeReleased))
+	s.Equal(int64(1_100_000000), s.balance(recipient))
+	s.True(s.supplyKeeper.GetModuleAccount(s.ctx, escrow.ModuleName).GetCoins().IsZero())
+
+	_, found := s.k.GetEscrow(s.ctx, id)
+	s.False(found)
+	_, err = s.handler(s.ctx, escrow.NewMsgReleaseEscrow(payer, id))
+	s.True(escrow.ErrNoEscrow.Is(err))
+}
+
+func (s Suite) TestArbiter() {
+	payer := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+	arbiter := app.DefaultGenesisUsers["user5"]
+
+	first := s.create(escrow.NewMsgCreateEscrow(payer, recipient, arbiter, util.Uartrs(100_000000), 10))
+	second := s.create(escrow.NewMsgCreateEscrow(payer, recipient, arbiter, util.Uartrs(200_000000), 10))
+	s.Equal(int64(699_100000), s.balance(payer)) // = 1000(from genesis) - 300 * 100.3%
+
+	s.Len(s.k.GetEscrowsByParty(s.ctx, arbiter), 2)
+	s.Empty(s.k.GetEscrowsByParty(s.ctx, app.DefaultGenesisUsers["user6"]))
+
+	_, err := s.handler(s.ctx, escrow.NewMsgReleaseEscrow(arbiter, first))
+	s.NoError(err)
+	s.Equal(int64(1_100_000000), s.balance(recipient))
+
+	_, err = s.handler(s.ctx, escrow.NewMsgRefundEscrow(recipient, second))
+	s.Error(err)
+	res, err := s.handler(s.ctx, escrow.NewMsgRefundEscrow(arbiter, second))
+	s.NoError(err)
+	s.True(hasEvent(res.Events, escrow.EventTypeRefunded))
+	s.Equal(int64(899_100000), s.balance(payer))
+	s.Equal(int64(1_100_000000), s.balance(recipient))
+}
+
+func (s Suite) TestRefund_Expiry() {
+	payer := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+
+	id := s.create(escrow.NewMsgCreateEscrow(payer, recipient, nil, util.Uartrs(100_000000), 10))
+
+	s.ctx = s.ctx.WithBlockHeight(9)
+	_, err := s.handler(s.ctx, escrow.NewMsgRefundEscrow(payer, id))
+	s.True(escrow.ErrNotExpired.Is(err))
+
+	s.ctx = s.ctx.WithBlockHeight(10)
+	_, err = s.handler(s.ctx, escrow.NewMsgRefundEscrow(payer, id))
+	s.NoError(err)
+	s.Equal(int64(999_700000), s.balance(payer)) // only the fee is lost
+	s.Equal(int64(1_000_000000), s.balance(recipient))
+}
+
+func (s Suite) TestCreate_Invalid() {
+	payer := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+
+	_, err := s.handler(s.ctx, escrow.NewMsgCreateEscrow(payer, recipient, nil, util.Uartrs(100_000000), 1))
+	s.True(escrow.ErrExpired.Is(err))
+
+	_, err = s.handler(s.ctx, escrow.NewMsgCreateEscrow(payer, recipient, nil, util.Uartrs(1_000_000000), 10)) // all the money
+	s.Error(err)
+	s.Empty(s.k.GetEscrowsByParty(s.ctx, payer))
+
+	s.Error(escrow.NewMsgCreateEscrow(payer, recipient, payer, util.Uartrs(1), 10).ValidateBasic())
+	s.Error(escrow.NewMsgCreateEscrow(payer, payer, nil, util.Uartrs(1), 10).ValidateBasic())
+	s.Error(escrow.NewMsgCreateEscrow(payer, recipient, nil, sdk.NewCoins(sdk.NewInt64Coin("uartrd", 1)), 10).ValidateBasic())
+}
+
+func (s Suite) TestReceiveDisabled() {
+	payer := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+	bk := s.app.GetBankKeeper()
+
+	id := s.create(escrow.NewMsgCreateEscrow(payer, recipient, nil, util.Uartrs(100_000000), 10))
+	bk.SetTransferSettings(s.ctx, bank.NewTransferSettings(recipient, false, true))
+
+	_, err := s.handler(s.ctx, escrow.NewMsgCreateEscrow(payer, recipient, nil, util.Uartrs(100_000000), 10))
+	s.True(bank.ErrReceiveDisabled.Is(err))
+	_, err = s.handler(s.ctx, escrow.NewMsgReleaseEscrow(payer, id))
+	s.True(bank.ErrReceiveDisabled.Is(err))
+	s.Equal(int64(1_000_000000), s.balance(recipient))
+
+	bk.SetTransferSettings(s.ctx, bank.NewTransferSettings(recipient, false, false))
+	_, err = s.handler(s.ctx, escrow.NewMsgReleaseEscrow(payer, id))
+	s.NoError(err)
+	s.Equal(int64(1_100_000000), s.balance(recipient))
+}
+
+func (s Suite) TestMigrateAccount() {
+	payer := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+	newRecipient := app.NonExistingUser
+
+	id := s.create(escrow.NewMsgCreateEscrow(payer, recipient, nil, util.Uartrs(100_000000), 10))
+	s.NoError(s.k.MigrateAccount(s.ctx, recipient, newRecipient))
+
+	e, found := s.k.GetEscrow(s.ctx, id)
+	s.True(found)
+	s.Equal(newRecipient, e.Recipient)
+	s.Empty(s.k.GetEscrowsByParty(s.ctx, recipient))
+	s.Equal([]uint64{id}, escrowIDs(s.k.GetEscrowsByParty(s.ctx, newRecipient)))
+}
+
+func (s Suite) TestGetEscrowsByParty() {
+	payer := app.DefaultGenesisUsers["user3"]
+	recipient := app.DefaultGenesisUsers["user4"]
+	arbiter := app.DefaultGenesisUsers["user5"]
+
+	first := s.create(escrow.NewMsgCreateEscrow(payer, recipient, nil, util.Uartrs(100_000000), 10))
+	second := s.create(escrow.NewMsgCreateEscrow(recipient, payer, arbiter, util.Uartrs(100_000000), 10))
+	third := s.create(escrow.NewMsgCreateEscrow(payer, arbiter, nil, util.Uartrs(100_000000), 10))
+
+	s.Equal([]uint64{first, second, third}, escrowIDs(s.k.GetEscrowsByParty(s.ctx, payer)))
+	s.Equal([]uint64{first, second}, escrowIDs(s.k.GetEscrowsByParty(s.ctx, recipient)))
+	s.Equal([]uint64{second, third}, escrowIDs(s.k.GetEscrowsByParty(s.ctx, arbiter)))
+
+	_, err := s.handler(s.ctx, escrow.NewMsgReleaseEscrow(payer, first))
+	s.NoError(err)
+	_, err = s.handler(s.ctx, escrow.NewMsgRefundEscrow(arbiter, second))
+	s.NoError(err)
+
+	s.Equal([]uint64{third}, escrowIDs(s.k.GetEscrowsByParty(s.ctx, payer)))
+	s.Empty(s.k.GetEscrowsByParty(s.ctx, recipient))
+	s.Equal([]uint64{third}, escrowIDs(s.k.GetEscrowsByParty(s.ctx, arbiter)))
+}
+
+func (s Suite) create(msg escrow.MsgCreateEscrow) uint64 {
+	res, err := s.handler(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().True(hasEvent(res.Events, escrow.EventTypeCreated))
+	return binary.BigEndian.Uint64(res.Data)
+}
+
+func (s Suite) balance(addr sdk.AccAddress) int64 {
+	return s.accKeeper.GetAccount(s.ctx, addr).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+}
+
+func escrowIDs(escrows escrow.Escrows) []uint64 {
+	result := make([]uint64, 0, len(escrows))
+	for _, e := range escrows {
+		result = append(result, e.ID)
+	}
+	return result
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
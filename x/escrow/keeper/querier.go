@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/x/escrow/types"
+)
+
+// NewQuerier creates a new querier for escrow clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryEscrow:
+			return queryEscrow(ctx, req, k)
+		case types.QueryByParty:
+			return queryByParty(ctx, req, k)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown escrow query endpoint")
+		}
+	}
+}
+
+func queryEscrow(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryEscrowParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	escrow, found := k.GetEscrow(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoEscrow, "id: %d", params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, escrow)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryByParty(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryByPartyParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetEscrowsByParty(ctx, params.Address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateEscrow{}, "escrow/CreateEscrow", nil)
+	cdc.RegisterConcrete(MsgReleaseEscrow{}, "escrow/ReleaseEscrow", nil)
+	cdc.RegisterConcrete(MsgRefundEscrow{}, "escrow/RefundEscrow", nil)
+}
+
+// ModuleCdc defines the module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,9 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+var (
+	ErrNoEscrow   = sdkerrors.Register(ModuleName, 1, "escrow not found")
+	ErrNotExpired = sdkerrors.Register(ModuleName, 2, "escrow is not expired yet")
+	ErrExpired    = sdkerrors.Register(ModuleName, 3, "escrow expiration height has passed")
+)
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/util"
+)
+
+// Escrow is an amount locked by a payer for a recipient. It's released to the recipient by the payer or the arbiter,
+// or refunded to the payer by the arbiter at any time or by the payer after it's expired.
+type Escrow struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Payer     sdk.AccAddress `json:"payer" yaml:"payer"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	// Arbiter is optional
+	Arbiter sdk.AccAddress `json:"arbiter,omitempty" yaml:"arbiter,omitempty"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	// ExpiresAt is a block height the payer can take the money back since
+	ExpiresAt int64 `json:"expires_at" yaml:"expires_at"`
+}
+
+func NewEscrow(payer, recipient, arbiter sdk.AccAddress, amount sdk.Coins, expiresAt int64) Escrow {
+	return Escrow{
+		Payer:     payer,
+		Recipient: recipient,
+		Arbiter:   arbiter,
+		Amount:    amount,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// IsExpired checks if the escrow can be refunded by the payer at the height
+func (e Escrow) IsExpired(height int64) bool {
+	return height >= e.ExpiresAt
+}
+
+// IsParty checks if the account is the payer, the recipient or the arbiter of the escrow
+func (e Escrow) IsParty(addr sdk.AccAddress) bool {
+	return e.Payer.Equals(addr) || e.Recipient.Equals(addr) || (!e.Arbiter.Empty() && e.Arbiter.Equals(addr))
+}
+
+func (e Escrow) Validate() error {
+	if e.Payer.Empty() {
+		return fmt.Errorf("payer is missing")
+	}
+	if e.Recipient.Empty() {
+		return fmt.Errorf("recipient is missing")
+	}
+	if e.Payer.Equals(e.Recipient) {
+		return fmt.Errorf("payer and recipient are the same")
+	}
+	if !e.Arbiter.Empty() && (e.Arbiter.Equals(e.Payer) || e.Arbiter.Equals(e.Recipient)) {
+		return fmt.Errorf("arbiter must be a third party")
+	}
+	if !e.Amount.IsValid() || !e.Amount.IsAllPositive() {
+		return fmt.Errorf("invalid amount: %s", e.Amount)
+	}
+	for _, coin := range e.Amount {
+		if coin.Denom != util.ConfigMainDenom {
+			return fmt.Errorf("only %s can be escrowed", util.ConfigMainDenom)
+		}
+	}
+	if e.ExpiresAt <= 0 {
+		return fmt.Errorf("expiration height must be positive")
+	}
+	return nil
+}
+
+func (e Escrow) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("ID: %d\nPayer: %s\nRecipient: %s\n", e.ID, e.Payer, e.Recipient))
+	if !e.Arbiter.Empty() {
+		sb.WriteString(fmt.Sprintf("Arbiter: %s\n", e.Arbiter))
+	}
+	sb.WriteString(fmt.Sprintf("Amount: %s\nExpires at: %d", e.Amount, e.ExpiresAt))
+	return sb.String()
+}
+
+type Escrows []Escrow
+
+func (ee Escrows) String() string {
+	if len(ee) == 0 {
+		return "[]"
+	}
+	parts := make([]string, len(ee))
+	for i, e := range ee {
+		parts[i] = e.String()
+	}
+	return strings.Join(parts, "\n\n")
+}
@@ -0,0 +1,15 @@
+package types
+
+// escrow module event types
+const (
+	EventTypeCreated  = "escrow_created"
+	EventTypeReleased = "escrow_released"
+	EventTypeRefunded = "escrow_refunded"
+
+	AttributeKeyEscrow    = "escrow"
+	AttributeKeyPayer     = "payer"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyArbiter   = "arbiter"
+
+	AttributeValueCategory = ModuleName
+)
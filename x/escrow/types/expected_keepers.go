@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/bank"
+)
+
+type BankKeeper interface {
+	util.FeeKeeper
+	GetTransferSettings(ctx sdk.Context, addr sdk.AccAddress) bank.TransferSettings
+}
+
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
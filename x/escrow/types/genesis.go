@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - all escrow state that must be provided at genesis
+type GenesisState struct {
+	Escrows []Escrow `json:"escrows,omitempty" yaml:"escrows,omitempty"`
+	NextID  uint64   `json:"next_id" yaml:"next_id"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(escrows []Escrow, nextID uint64) GenesisState {
+	return GenesisState{
+		Escrows: escrows,
+		NextID:  nextID,
+	}
+}
+
+// DefaultGenesisState - default GenesisState used by Cosmos Hub
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		NextID: 1,
+	}
+}
+
+// ValidateGenesis validates the escrow genesis state
+func ValidateGenesis(data GenesisState) error {
+	if data.NextID == 0 {
+		return fmt.Errorf("next ID must be positive")
+	}
+	ids := make(map[uint64]bool, len(data.Escrows))
+	for _, escrow := range data.Escrows {
+		if escrow.ID == 0 || escrow.ID >= data.NextID {
+			return fmt.Errorf("invalid escrow ID %d (next ID is %d)", escrow.ID, data.NextID)
+		}
+		if ids[escrow.ID] {
+			return fmt.Errorf("duplicate escrow ID %d", escrow.ID)
+		}
+		ids[escrow.ID] = true
+		if err := escrow.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow %d: %w", escrow.ID, err)
+		}
+	}
+	return nil
+}
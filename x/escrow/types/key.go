@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "escrow"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querierer msgs
+	QuerierRoute = ModuleName
+)
+
+var (
+	EscrowPrefix = []byte{0x01}
+	NextIDKey    = []byte{0x02}
+	// PayerIndexPrefix is a prefix for the payer -> escrow IDs index
+	PayerIndexPrefix = []byte{0x03}
+	// RecipientIndexPrefix is a prefix for the recipient -> escrow IDs index
+	RecipientIndexPrefix = []byte{0x04}
+	// ArbiterIndexPrefix is a prefix for the arbiter -> escrow IDs index
+	ArbiterIndexPrefix = []byte{0x05}
+)
+
+// EscrowKey returns a store key of an escrow by its ID
+func EscrowKey(id uint64) []byte {
+	key := make([]byte, len(EscrowPrefix)+8)
+	copy(key, EscrowPrefix)
+	binary.BigEndian.PutUint64(key[len(EscrowPrefix):], id)
+	return key
+}
+
+// PartyIndexKey returns a store key prefix of the party's escrows in the index (one of PayerIndexPrefix,
+// RecipientIndexPrefix and ArbiterIndexPrefix)
+func PartyIndexKey(prefix []byte, addr sdk.AccAddress) []byte {
+	key := make([]byte, len(prefix)+len(addr))
+	copy(key, prefix)
+	copy(key[len(prefix):], addr)
+	return key
+}
+
+// PartyIndexEntryKey returns a store key of an escrow in the party's index
+func PartyIndexEntryKey(prefix []byte, addr sdk.AccAddress, id uint64) []byte {
+	key := PartyIndexKey(prefix, addr)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(key, bz...)
+}
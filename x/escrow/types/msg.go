@@ -0,0 +1,113 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgCreateEscrow locks the payer's coins for the recipient
+type MsgCreateEscrow struct {
+	Payer     sdk.AccAddress `json:"payer" yaml:"payer"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Arbiter   sdk.AccAddress `json:"arbiter,omitempty" yaml:"arbiter,omitempty"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	ExpiresAt int64          `json:"expires_at" yaml:"expires_at"`
+}
+
+func NewMsgCreateEscrow(payer, recipient, arbiter sdk.AccAddress, amount sdk.Coins, expiresAt int64) MsgCreateEscrow {
+	return MsgCreateEscrow{
+		Payer:     payer,
+		Recipient: recipient,
+		Arbiter:   arbiter,
+		Amount:    amount,
+		ExpiresAt: expiresAt,
+	}
+}
+
+const CreateEscrowConst = "create_escrow"
+
+func (msg MsgCreateEscrow) Route() string { return RouterKey }
+func (msg MsgCreateEscrow) Type() string  { return CreateEscrowConst }
+func (msg MsgCreateEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payer}
+}
+
+func (msg MsgCreateEscrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateEscrow) ValidateBasic() error {
+	escrow := NewEscrow(msg.Payer, msg.Recipient, msg.Arbiter, msg.Amount, msg.ExpiresAt)
+	if err := escrow.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// MsgReleaseEscrow sends escrowed coins to the recipient. It can be signed by the payer or the arbiter.
+type MsgReleaseEscrow struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	ID     uint64         `json:"id" yaml:"id"`
+}
+
+func NewMsgReleaseEscrow(sender sdk.AccAddress, id uint64) MsgReleaseEscrow {
+	return MsgReleaseEscrow{Sender: sender, ID: id}
+}
+
+const ReleaseEscrowConst = "release_escrow"
+
+func (msg MsgReleaseEscrow) Route() string { return RouterKey }
+func (msg MsgReleaseEscrow) Type() string  { return ReleaseEscrowConst }
+func (msg MsgReleaseEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgReleaseEscrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgReleaseEscrow) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender")
+	}
+	if msg.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing escrow ID")
+	}
+	return nil
+}
+
+// MsgRefundEscrow returns escrowed coins to the payer. It can be signed by the arbiter or, after the escrow is expired,
+// by the payer.
+type MsgRefundEscrow struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	ID     uint64         `json:"id" yaml:"id"`
+}
+
+func NewMsgRefundEscrow(sender sdk.AccAddress, id uint64) MsgRefundEscrow {
+	return MsgRefundEscrow{Sender: sender, ID: id}
+}
+
+const RefundEscrowConst = "refund_escrow"
+
+func (msg MsgRefundEscrow) Route() string { return RouterKey }
+func (msg MsgRefundEscrow) Type() string  { return RefundEscrowConst }
+func (msg MsgRefundEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgRefundEscrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRefundEscrow) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender")
+	}
+	if msg.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing escrow ID")
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Query endpoints supported by the escrow querier
+const (
+	QueryEscrow  = "escrow"
+	QueryByParty = "by_party"
+)
+
+type QueryEscrowParams struct {
+	ID uint64 `json:"id" yaml:"id"`
+}
+
+func NewQueryEscrowParams(id uint64) QueryEscrowParams {
+	return QueryEscrowParams{ID: id}
+}
+
+type QueryByPartyParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewQueryByPartyParams(addr sdk.AccAddress) QueryByPartyParams {
+	return QueryByPartyParams{Address: addr}
+}
@@ -45,6 +45,7 @@ var (
 	ErrJailPeriodNotOver = types.ErrJailPeriodNotOver
 	ErrBannedForLifetime = types.ErrBannedForLifetime
 	ErrAlreadyOn         = types.ErrAlreadyOn
+	ErrNodingOn          = types.ErrNodingOn
 )
 
 type (
@@ -787,6 +787,36 @@ func (k Keeper) GetBlocksProposedByAll(ctx sdk.Context) (heightsByAccAddress map
 	return heightsByAccAddress
 }
 
+// MigrateAccount moves the account's noding record (with its jail and ban state) to a new address. A validator must
+// be switched off and leave the validator set before its account is migrated.
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	if !k.has(ctx, from) {
+		return nil
+	}
+	data, err := k.Get(ctx, from)
+	if err != nil {
+		return err
+	}
+	if data.Status || data.LastPower != 0 {
+		return types.ErrNodingOn
+	}
+
+	ctx.KVStore(k.dataStoreKey).Delete(from)
+	if err := k.set(ctx, to, data); err != nil {
+		return err
+	}
+	if data.PubKey != "" {
+		consAddr := consAddressFromCryptoBubKey(cryptoPubKeyFromBech32(data.PubKey))
+		if operator, found := k.getNodeOperatorFromIndex(ctx, consAddr); found && from.Equals(sdk.AccAddress(operator)) {
+			k.addNodeOperatorToIndex(ctx, consAddr, to)
+		}
+	}
+	if data.LotteryNo != 0 {
+		k.addToIndex(ctx, k.lotteryKey(data.LotteryNo), to)
+	}
+	return nil
+}
+
 func (k Keeper) GeneralAmnesty(ctx sdk.Context) {
 	store := ctx.KVStore(k.dataStoreKey)
 	it := store.Iterator(nil, nil)
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/arterynetwork/artr/app"
 	"github.com/arterynetwork/artr/util"
@@ -480,6 +481,31 @@ func (s *BaseSuite) TearDownTest() {
 	s.cleanup()
 }
 
+func (s *Suite) TestMigrateAccount() {
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	_, _, newAddr := authtypes.KeyTestPubAddr()
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(2), pubkey))
+	s.nextBlock(pubkey, nil, nil)
+	s.Equal(noding.ErrNodingOn, s.k.MigrateAccount(s.ctx, s.user(2), newAddr))
+
+	s.NoError(s.k.SwitchOff(s.ctx, s.user(2)))
+	s.Equal(noding.ErrNodingOn, s.k.MigrateAccount(s.ctx, s.user(2), newAddr), "still in the validator set")
+	s.nextBlock(pubkey, nil, nil)
+	s.NoError(s.k.MigrateAccount(s.ctx, s.user(2), newAddr))
+
+	_, err := s.k.Get(s.ctx, s.user(2))
+	s.Equal(noding.ErrNotFound, err)
+	_, err = s.k.Get(s.ctx, newAddr)
+	s.NoError(err)
+	acc, found, _, err := s.k.GetValidatorByConsAddr(s.ctx, sdk.GetConsAddress(pubkey))
+	s.NoError(err)
+	s.True(found)
+	s.Equal(newAddr, acc)
+
+	// an account without noding record is migrated with no fuss
+	s.NoError(s.k.MigrateAccount(s.ctx, s.user(3), s.user(15)))
+}
+
 func (s *BaseSuite) nextBlock(proposer crypto.PubKey, votes []abci.VoteInfo, byzantine []abci.Evidence) (abci.ResponseEndBlock, abci.ResponseBeginBlock) {
 	ebr := s.app.EndBlocker(s.ctx, abci.RequestEndBlock{Height: s.ctx.BlockHeight()})
 	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
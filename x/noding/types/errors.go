@@ -12,4 +12,5 @@ var (
 	ErrJailPeriodNotOver = sdkerrors.Register(ModuleName, 5, "jail period is not finished yet")
 	ErrBannedForLifetime = sdkerrors.Register(ModuleName, 6, "validator is banned for a lifetime")
 	ErrAlreadyOn         = sdkerrors.Register(ModuleName, 7, "noding is already on")
+	ErrNodingOn          = sdkerrors.Register(ModuleName, 8, "noding must be switched off (and the node must leave the validator set) first")
 )
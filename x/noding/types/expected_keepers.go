@@ -23,7 +23,7 @@ type ReferralKeeper interface {
 }
 
 type ScheduleKeeper interface {
-	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) error
+	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) (uint64, error)
 	GetParams(ctx sdk.Context) schedule.Params
 }
 
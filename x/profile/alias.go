@@ -14,6 +14,9 @@ const (
 	DefaultParamspace = types.DefaultParamspace
 	//QueryParams       = types.QueryParams
 	QuerierRoute = types.QuerierRoute
+
+	AccountMigratedCallback       = keeper.AccountMigratedCallback
+	PrepaidInviteRedeemedCallback = keeper.PrepaidInviteRedeemedCallback
 )
 
 var (
@@ -24,16 +27,40 @@ var (
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
+	MigrationSignBytes  = types.MigrationSignBytes
+	InviteHash          = types.InviteHash
+	InviteKey           = types.InviteKey
+	InviteSignBytes     = types.InviteSignBytes
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
 
 	ErrNicknamePrefix       = types.ErrNicknamePrefix
 	ErrNicknameAlreadyInUse = types.ErrNicknameAlreadyInUse
+	ErrNoGuardians          = types.ErrNoGuardians
+	ErrNotGuardian          = types.ErrNotGuardian
+	ErrRecoveryPending      = types.ErrRecoveryPending
+	ErrNoRecovery           = types.ErrNoRecovery
+	ErrAlreadyApproved      = types.ErrAlreadyApproved
+	ErrRecoveryNotReady     = types.ErrRecoveryNotReady
+	ErrAddressInUse         = types.ErrAddressInUse
+	ErrNicknameReserved     = types.ErrNicknameReserved
+	ErrNoNickname           = types.ErrNoNickname
+	ErrHasNickname          = types.ErrHasNickname
+	ErrNicknameOnSale       = types.ErrNicknameOnSale
+	ErrNoNicknameOffer      = types.ErrNoNicknameOffer
+	ErrInviteExists         = types.ErrInviteExists
+	ErrNoInvite             = types.ErrNoInvite
+	ErrInviteExpired        = types.ErrInviteExpired
 )
 
 type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
 	Params       = types.Params
+	Guardians    = types.Guardians
+	Recovery     = types.Recovery
+
+	NicknameOffer = types.NicknameOffer
+	Invite        = types.Invite
 )
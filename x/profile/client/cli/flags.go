@@ -0,0 +1,7 @@
+package cli
+
+const (
+	FlagExpiresAt = "expires-at"
+	FlagPrepaid   = "prepaid"
+	FlagCode      = "code"
+)
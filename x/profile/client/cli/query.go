@@ -35,6 +35,12 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetAccountByNicknameCmd(queryRoute, cdc),
 		GetAccountByCardNumberCmd(queryRoute, cdc),
 		GetCreatorsCmd(queryRoute, cdc),
+		GetGuardiansCmd(queryRoute, cdc),
+		GetRecoveryCmd(queryRoute, cdc),
+		GetForwardingCmd(queryRoute, cdc),
+		GetNicknameOfferCmd(queryRoute, cdc),
+		GetReservedNicknamesCmd(queryRoute, cdc),
+		GetInviteCmd(queryRoute, cdc),
 		util.LineBreak(),
 		getCmdParams(queryRoute, cdc),
 		//)...,
@@ -190,3 +196,175 @@ func getCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetGuardiansCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guardians <address>",
+		Short: "Query accounts allowed to recover the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryProfileParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGuardians), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResGuardians
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetRecoveryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery <address>",
+		Short: "Query a pending recovery of the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryProfileParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRecovery), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResRecovery
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetForwardingCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forwarding <address>",
+		Short: "Query the current address of an account (following migrations, if any)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryProfileParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryForwarding), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResForwarding
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetNicknameOfferCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nickname-offer <nickname>",
+		Short: "Query a sale offer of the nickname",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAccountByNicknameParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryNicknameOffer), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResNicknameOffer
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetReservedNicknamesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserved-nicknames",
+		Short: "Query nicknames reserved by voting",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryReservedNicknames), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResReservedNicknames
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetInviteCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invite <code>",
+		Short: "Query an invite by its code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryInviteParams(types.InviteHash(types.InviteKey(args[0]).PubKey())))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryInvite), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResInvite
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
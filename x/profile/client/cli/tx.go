@@ -6,13 +6,17 @@ import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"strconv"
 	"strings"
 
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 	"github.com/arterynetwork/artr/x/profile/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -33,6 +37,19 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetSetProfileCmd(cdc),
 		//GetCreateAccountCmd(cdc),
 		GetCreateAccountWithProfileCmd(cdc),
+		GetSetGuardiansCmd(cdc),
+		GetRequestRecoveryCmd(cdc),
+		GetApproveRecoveryCmd(cdc),
+		GetCancelRecoveryCmd(cdc),
+		GetCompleteRecoveryCmd(cdc),
+		GetMigrateAccountCmd(cdc),
+		GetTransferNicknameCmd(cdc),
+		GetOfferNicknameCmd(cdc),
+		GetCancelNicknameOfferCmd(cdc),
+		GetBuyNicknameCmd(cdc),
+		GetCreateInviteCmd(cdc),
+		GetCancelInviteCmd(cdc),
+		GetRedeemInviteCmd(cdc),
 		// GetCmd<Action>(cdc)
 		//)...
 	)
@@ -56,20 +73,19 @@ func GetSetProfileCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			// query current profile
-			params := types.NewQueryProfileParams(addr)
-			bz, err := cliCtx.Codec.MarshalJSON(params)
-
-			res, _, _ := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/profile", "profile"), bz)
-
-			var out types.QueryResProfile
-			cdc.MustUnmarshalJSON(res, &out)
-
-			profile := out.Profile
+			// read the current profile from the store, the profile query hides private fields
+			var profile types.Profile
+			bz, _, err := cliCtx.QueryStore(auth.AddressStoreKey(addr), types.StoreKey)
+			if err != nil {
+				return err
+			}
+			if bz != nil {
+				cdc.MustUnmarshalBinaryBare(bz, &profile)
+			}
 
 			if len(args) > 1 {
 				for _, val := range args[1:] {
-					com := strings.Split(strings.TrimSpace(val), ":")
+					com := strings.SplitN(strings.TrimSpace(val), ":", 2)
 
 					if len(com) != 2 {
 						return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid parameter string "+val)
@@ -84,10 +100,33 @@ func GetSetProfileCmd(cdc *codec.Codec) *cobra.Command {
 						profile.Noding = com[1] == "yes"
 					case "storage":
 						profile.Storage = com[1] == "yes"
+					case "storage_auto_buy":
+						profile.StorageAutoBuy = com[1] == "yes"
 					case "validator":
 						profile.Validator = com[1] == "yes"
 					case "vpn":
 						profile.VPN = com[1] == "yes"
+					case "avatar":
+						profile.AvatarHash = com[1]
+					case "bio":
+						profile.Bio = com[1]
+					case "contacts":
+						profile.Contacts = nil
+						for _, contact := range strings.Split(com[1], ",") {
+							if contact = strings.TrimSpace(contact); contact != "" {
+								profile.Contacts = append(profile.Contacts, contact)
+							}
+						}
+					case "hide_nickname":
+						profile.Visibility.HideNickname = com[1] == "yes"
+					case "hide_card_number":
+						profile.Visibility.HideCardNumber = com[1] == "yes"
+					case "hide_avatar":
+						profile.Visibility.HideAvatar = com[1] == "yes"
+					case "hide_bio":
+						profile.Visibility.HideBio = com[1] == "yes"
+					case "hide_contacts":
+						profile.Visibility.HideContacts = com[1] == "yes"
 					}
 
 				}
@@ -101,6 +140,9 @@ func GetSetProfileCmd(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgSetProfile(addr, profile)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -128,12 +170,12 @@ func GetCreateAccountCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			newAddr, err := sdk.AccAddressFromBech32(args[1])
+			newAddr, err := resolver.ResolveAddressCLI(cliCtx, args[1])
 			if err != nil {
 				return err
 			}
 
-			refAddr, err := sdk.AccAddressFromBech32(args[2])
+			refAddr, err := resolver.ResolveAddressCLI(cliCtx, args[2])
 			if err != nil {
 				return err
 			}
@@ -164,51 +206,439 @@ func GetCreateAccountWithProfileCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			newAddr, err := sdk.AccAddressFromBech32(args[1])
+			newAddr, err := resolver.ResolveAddressCLI(cliCtx, args[1])
 			if err != nil {
 				return err
 			}
 
-			refAddr, err := sdk.AccAddressFromBech32(args[2])
+			refAddr, err := resolver.ResolveAddressCLI(cliCtx, args[2])
 			if err != nil {
 				return err
 			}
 
-			profile := types.Profile{}
+			profile, err := parseProfileParams(args[3:])
+			if err != nil {
+				return err
+			}
 
-			if len(args) > 3 {
-				for _, val := range args[3:] {
-					com := strings.Split(strings.TrimSpace(val), ":")
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgCreateAccountWithProfile(addr, newAddr, refAddr, profile)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 
-					if len(com) != 2 {
-						return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid parameter string "+val)
-					}
+	cmd = flags.PostCommands(cmd)[0]
 
-					switch strings.ToLower(com[0]) {
-					case "nickname":
-						profile.Nickname = com[1]
-					case "autopay":
-						profile.AutoPay = com[1] == "yes"
-					case "noding":
-						profile.Noding = com[1] == "yes"
-					case "storage":
-						profile.Storage = com[1] == "yes"
-					case "validator":
-						profile.Validator = com[1] == "yes"
-					case "vpn":
-						profile.VPN = com[1] == "yes"
-					}
+	return cmd
+}
+
+func GetSetGuardiansCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-guardians <from_key_or_address> <threshold> [guardian_address ...]",
+		Short: "Set accounts allowed to recover yours, and how many of them should approve a recovery",
+		Long: "Set accounts allowed to recover yours (i.e. to move it to a new address, if you lose your key), " +
+			"and how many of them should approve a recovery. Run with zero threshold and no guardians to remove them.",
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
 
+			guardians := make([]sdk.AccAddress, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				guardian, err := resolver.ResolveAddressCLI(cliCtx, arg)
+				if err != nil {
+					return err
 				}
+				guardians = append(guardians, guardian)
 			}
 
-			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgCreateAccountWithProfile(addr, newAddr, refAddr, profile)
+			msg := types.NewMsgSetGuardians(cliCtx.GetFromAddress(), guardians, uint32(threshold))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
-	cmd = flags.PostCommands(cmd)[0]
+	return flags.PostCommands(cmd)[0]
+}
 
-	return cmd
+func GetRequestRecoveryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-recovery <guardian_key_or_address> <account_address> <new_address>",
+		Short: "Request (as a guardian) moving an account to a new address",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return postRecoveryMsg(cmd, cdc, args, func(guardian, acc, newAddr sdk.AccAddress) sdk.Msg {
+				return types.NewMsgRequestRecovery(guardian, acc, newAddr)
+			})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetApproveRecoveryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-recovery <guardian_key_or_address> <account_address> <new_address>",
+		Short: "Approve (as a guardian) a pending account recovery",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return postRecoveryMsg(cmd, cdc, args, func(guardian, acc, newAddr sdk.AccAddress) sdk.Msg {
+				return types.NewMsgApproveRecovery(guardian, acc, newAddr)
+			})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetCancelRecoveryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-recovery <from_key_or_address>",
+		Short: "Cancel a pending recovery of your account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			msg := types.NewMsgCancelRecovery(cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetCompleteRecoveryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complete-recovery <from_key_or_address> <account_address>",
+		Short: "Move an account to its new address, when the recovery is approved and its delay is over",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			acc, err := resolver.ResolveAddressCLI(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCompleteRecovery(cliCtx.GetFromAddress(), acc)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetMigrateAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate <from_key_or_address> <new_key_name>",
+		Short: "Move your account to a new address",
+		Long: "Move your account (balance, profile, referral, delegation, subscription, etc.) to the address of " +
+			"another key. Both keys must be in the local keybase, the new one signs its consent to take the account over.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			info, err := txBldr.Keybase().Get(args[1])
+			if err != nil {
+				return err
+			}
+			newPubKey := info.GetPubKey()
+			sig, _, err := txBldr.Keybase().Sign(
+				args[1],
+				keys.DefaultKeyPass,
+				types.MigrationSignBytes(txBldr.ChainID(), cliCtx.GetFromAddress(), sdk.AccAddress(newPubKey.Address())),
+			)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateAccount(cliCtx.GetFromAddress(), newPubKey, sig)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetTransferNicknameCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-nickname <from_key_or_address> <recipient_address>",
+		Short: "Give your nickname to another account (having no nickname)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			recipient, err := resolver.ResolveAddressCLI(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferNickname(cliCtx.GetFromAddress(), recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetOfferNicknameCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer-nickname <from_key_or_address> <price> [buyer_address]",
+		Short: "Put your nickname on sale",
+		Long: "Put your nickname on sale for the price (in uARTR). If the buyer is specified, nobody else can buy it. " +
+			"The nickname cannot be changed until it's sold or the offer is canceled.",
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			price, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			var buyer sdk.AccAddress
+			if len(args) > 2 {
+				if buyer, err = resolver.ResolveAddressCLI(cliCtx, args[2]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgOfferNickname(cliCtx.GetFromAddress(), price, buyer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetCancelNicknameOfferCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-nickname-offer <from_key_or_address>",
+		Short: "Withdraw your nickname from sale",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			msg := types.NewMsgCancelNicknameOffer(cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetBuyNicknameCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-nickname <from_key_or_address> <nickname> <max_price>",
+		Short: "Buy a nickname on sale (your current nickname, if any, is dropped)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			maxPrice, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyNickname(cliCtx.GetFromAddress(), args[1], maxPrice)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetCreateInviteCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-invite <from_key_or_address> <max_uses>",
+		Short: "Publish an invite code, so that newcomers could create accounts under yours",
+		Long: "Publish an invite code, so that newcomers could create accounts under yours. A random code is " +
+			"generated and printed (unless one is set with --code). An invite key is derived from the code, and only " +
+			"a hash of its public key is stored on-chain, so share the code itself privately. " +
+			"You pay an account creation fee for each newcomer (and their first month of subscription, if the invite " +
+			"is prepaid).",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			maxUses, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			code := viper.GetString(FlagCode)
+			if code == "" {
+				if code, err = types.NewInviteCode(); err != nil {
+					return err
+				}
+			} else if err := types.ValidateInviteCode(code); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateInvite(
+				cliCtx.GetFromAddress(),
+				types.InviteHash(types.InviteKey(code).PubKey()),
+				uint32(maxUses),
+				viper.GetInt64(FlagExpiresAt),
+				viper.GetBool(FlagPrepaid),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Invite code: %s\n", code)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Int64(FlagExpiresAt, 0, "Block height the invite expires at (never, if not set)")
+	cmd.Flags().Bool(FlagPrepaid, false, "Pay for the first month of newcomers' subscription")
+	cmd.Flags().String(FlagCode, "", fmt.Sprintf("Use this code instead of a random one (at least %d characters)", types.MinInviteCodeLength))
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetCancelInviteCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-invite <from_key_or_address> <code>",
+		Short: "Withdraw your invite, so that its code cannot be used anymore",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			msg := types.NewMsgCancelInvite(cliCtx.GetFromAddress(), types.InviteHash(types.InviteKey(args[1]).PubKey()))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func GetRedeemInviteCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-invite <from_key_or_address> <code> <new_account_address> [params]",
+		Short: "Create a new account under the referrer who published the invite code",
+		Long: "Create a new account under the referrer who published the invite code. A new account cannot sign " +
+			"transactions until it exists, so the transaction is signed by any existing one (it pays a transaction " +
+			"fee only). The code itself is not sent, the invite key derived from it signs the new address and " +
+			"profile instead. Params are the same as for create_account_with_profile.",
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+			newAddr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			profile, err := parseProfileParams(args[3:])
+			if err != nil {
+				return err
+			}
+
+			inviteKey := types.InviteKey(args[1])
+			sig, err := inviteKey.Sign(types.InviteSignBytes(txBldr.ChainID(), newAddr, profile))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemInvite(cliCtx.GetFromAddress(), inviteKey.PubKey(), sig, newAddr, profile)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// parseProfileParams parses "key:value" profile params of account creation commands
+func parseProfileParams(params []string) (types.Profile, error) {
+	profile := types.Profile{}
+
+	for _, val := range params {
+		com := strings.Split(strings.TrimSpace(val), ":")
+
+		if len(com) != 2 {
+			return profile, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid parameter string "+val)
+		}
+
+		switch strings.ToLower(com[0]) {
+		case "nickname":
+			profile.Nickname = com[1]
+		case "autopay":
+			profile.AutoPay = com[1] == "yes"
+		case "noding":
+			profile.Noding = com[1] == "yes"
+		case "storage":
+			profile.Storage = com[1] == "yes"
+		case "storage_auto_buy":
+			profile.StorageAutoBuy = com[1] == "yes"
+		case "validator":
+			profile.Validator = com[1] == "yes"
+		case "vpn":
+			profile.VPN = com[1] == "yes"
+		}
+	}
+
+	return profile, nil
+}
+
+func postRecoveryMsg(cmd *cobra.Command, cdc *codec.Codec, args []string, newMsg func(guardian, acc, newAddr sdk.AccAddress) sdk.Msg) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+
+	acc, err := resolver.ResolveAddressCLI(cliCtx, args[1])
+	if err != nil {
+		return err
+	}
+	newAddr, err := resolver.ResolveAddressCLI(cliCtx, args[2])
+	if err != nil {
+		return err
+	}
+
+	msg := newMsg(cliCtx.GetFromAddress(), acc, newAddr)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 }
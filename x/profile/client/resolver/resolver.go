@@ -0,0 +1,104 @@
+// Package resolver lets CLI and REST transaction builders accept human-readable account references.
+//
+// A reference is either a bech32 address, a profile nickname prefixed with "@" (e.g. "@alice"), or a profile card
+// number (decimal digits only).
+package resolver
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/arterynetwork/artr/x/profile/types"
+)
+
+const NicknamePrefix = "@"
+
+// ResolveAddress returns an account address the reference points to.
+func ResolveAddress(cliCtx context.CLIContext, ref string) (sdk.AccAddress, error) {
+	var (
+		query  string
+		params interface{}
+	)
+	switch {
+	case strings.HasPrefix(ref, NicknamePrefix):
+		nickname := strings.TrimPrefix(ref, NicknamePrefix)
+		if nickname == "" {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "nickname is missing")
+		}
+		query, params = types.QueryAccountAddressByNickname, types.NewQueryAccountByNicknameParams(nickname)
+	case isCardNumber(ref):
+		cardNumber, err := strconv.ParseUint(ref, 10, 64)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid card number %s: %s", ref, err.Error())
+		}
+		query, params = types.QueryAccountAddressByCardNumber, types.NewQueryAccountByCardNumberParams(cardNumber)
+	default:
+		addr, err := sdk.AccAddressFromBech32(ref)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		return addr, nil
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query), bz)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "cannot resolve %s", ref)
+	}
+
+	var out types.QueryResAccountBy
+	if err := types.ModuleCdc.UnmarshalJSON(res, &out); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if out.Address.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "no account found for %s", ref)
+	}
+	return out.Address, nil
+}
+
+// ResolveAddressCLI does the same as ResolveAddress, and, if the reference is not an address itself, prints the
+// resolved address to stderr, so a user can check it before confirming the transaction.
+func ResolveAddressCLI(cliCtx context.CLIContext, ref string) (sdk.AccAddress, error) {
+	addr, err := ResolveAddress(cliCtx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if addr.String() != ref {
+		_, _ = fmt.Fprintf(os.Stderr, "%s -> %s\n", ref, addr)
+	}
+	return addr, nil
+}
+
+// ResolveAddressREST does the same as ResolveAddress, but writes an error response if the reference cannot be
+// resolved. The resolved address is shown to a user as part of the generated transaction.
+func ResolveAddressREST(w http.ResponseWriter, cliCtx context.CLIContext, ref string) (sdk.AccAddress, bool) {
+	addr, err := ResolveAddress(cliCtx, ref)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return addr, true
+}
+
+func isCardNumber(ref string) bool {
+	if ref == "" {
+		return false
+	}
+	for _, r := range ref {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
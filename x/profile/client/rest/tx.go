@@ -1,46 +1,162 @@
 package rest
 
-// The packages below are commented out at first to prevent an error if this file isn't initially saved.
 import (
-	// "bytes"
-	// "net/http"
+	"net/http"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
-	// sdk "github.com/cosmos/cosmos-sdk/types"
-	// "github.com/cosmos/cosmos-sdk/types/rest"
-	// "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
-	// "github.com/arterynetwork/artr/x/profile/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
+	"github.com/arterynetwork/artr/x/profile/types"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	// r.HandleFunc(
-	// TODO: Define the Rest route ,
-	// Call the function which should be executed for this route),
-	// ).Methods("POST")
+	r.HandleFunc(
+		"/profile/nickname/transfer",
+		transferNicknameHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/profile/guardians",
+		setGuardiansHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/profile/recoveries/{account}",
+		recoveryHandlerFn(cliCtx, func(guardian, account, newAddress sdk.AccAddress) sdk.Msg {
+			return types.NewMsgRequestRecovery(guardian, account, newAddress)
+		}),
+	).Methods("POST")
+	r.HandleFunc(
+		"/profile/recoveries/{account}/approvals",
+		recoveryHandlerFn(cliCtx, func(guardian, account, newAddress sdk.AccAddress) sdk.Msg {
+			return types.NewMsgApproveRecovery(guardian, account, newAddress)
+		}),
+	).Methods("POST")
 }
 
-/*
-// Action TX body
-type <Action>Req struct {
-	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
-	// TODO: Define more types if needed
+// TransferNicknameReq defines the properties of a nickname transfer request's body. The recipient can be referred
+// to by an address, a @nickname or a card number.
+type TransferNicknameReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Recipient string       `json:"recipient" yaml:"recipient"`
 }
 
-func <Action>RequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+func transferNicknameHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req <Action>Req
-		vars := mux.Vars(r)
+		var req TransferNicknameReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
 
-		baseReq := req.BaseReq.Sanitize()
-		if !baseReq.ValidateBasic(w) {
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
 			return
 		}
 
-		// TODO: Define the module tx logic for this action
+		addr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		recipient, ok := resolver.ResolveAddressREST(w, cliCtx, req.Recipient)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgTransferNickname(addr, recipient)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// SetGuardiansReq defines the properties of a guardian list request's body. Guardians can be referred to by
+// addresses, @nicknames or card numbers.
+type SetGuardiansReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Guardians []string     `json:"guardians" yaml:"guardians"`
+	Threshold uint32       `json:"threshold" yaml:"threshold"`
+}
+
+func setGuardiansHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetGuardiansReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		guardians := make([]sdk.AccAddress, 0, len(req.Guardians))
+		for _, ref := range req.Guardians {
+			guardian, ok := resolver.ResolveAddressREST(w, cliCtx, ref)
+			if !ok {
+				return
+			}
+			guardians = append(guardians, guardian)
+		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, BaseReq, []sdk.Msg{msg})
+		msg := types.NewMsgSetGuardians(addr, guardians, req.Threshold)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// RecoveryReq defines the properties of a recovery request (or approval) body. The account is taken from the URL,
+// both it and the new address can be referred to by an address, a @nickname or a card number.
+type RecoveryReq struct {
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	NewAddress string       `json:"new_address" yaml:"new_address"`
+}
+
+func recoveryHandlerFn(cliCtx context.CLIContext, newMsg func(guardian, account, newAddress sdk.AccAddress) sdk.Msg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		account, ok := resolver.ResolveAddressREST(w, cliCtx, mux.Vars(r)["account"])
+		if !ok {
+			return
+		}
+
+		var req RecoveryReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		guardian, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		newAddress, ok := resolver.ResolveAddressREST(w, cliCtx, req.NewAddress)
+		if !ok {
+			return
+		}
+
+		msg := newMsg(guardian, account, newAddress)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
-*/
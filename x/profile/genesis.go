@@ -11,17 +11,35 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	k.Logger(ctx).Info("Starting from genesis...")
 	k.SetParams(ctx, data.Params)
 	for _, record := range data.ProfileRecords {
-		acc := k.AccountKeeper.GetAccount(ctx, record.Address)
-		record.Profile.CardNumber = k.CardNumberByAccountNumber(ctx, acc.GetAccountNumber())
+		// A migrated account keeps its card number, so it cannot be derived from the account number
+		if record.Profile.CardNumber == 0 {
+			acc := k.AccountKeeper.GetAccount(ctx, record.Address)
+			record.Profile.CardNumber = k.CardNumberByAccountNumber(ctx, acc.GetAccountNumber())
+		}
 		if err := k.SetProfile(ctx, record.Address, record.Profile); err != nil {
 			panic(errors.Wrapf(err, "invalid profile %s", record.Address))
 		}
 	}
+	k.InitGuardians(ctx, data.Guardians)
+	k.InitRecoveries(ctx, data.Recoveries)
+	k.InitForwardings(ctx, data.Forwardings)
+	k.InitReservedNicknames(ctx, data.Reserved)
+	k.InitNicknameOffers(ctx, data.Offers)
+	k.InitInvites(ctx, data.Invites)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
-	return NewGenesisState(k.GetParams(ctx), k.ExportProfileRecords(ctx))
+	return NewGenesisState(
+		k.GetParams(ctx),
+		k.ExportProfileRecords(ctx),
+		k.ExportGuardians(ctx),
+		k.ExportRecoveries(ctx),
+		k.ExportForwardings(ctx),
+		k.GetReservedNicknames(ctx),
+		k.ExportNicknameOffers(ctx),
+		k.ExportInvites(ctx),
+	)
 }
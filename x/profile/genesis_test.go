@@ -60,6 +60,59 @@ func (s Suite) TestFullData() {
 	s.checkExportImport()
 }
 
+func (s Suite) TestPublicFields() {
+	_, _, newAcc := authtypes.KeyTestPubAddr()
+	s.k.CreateAccountWithProfile(s.ctx, newAcc, app.DefaultGenesisUsers["user13"], types.Profile{
+		Nickname:   "FooBar",
+		AvatarHash: "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o",
+		Bio:        "Lorem ipsum",
+		Contacts:   []string{"https://example.com"},
+		Visibility: types.Visibility{HideNickname: true, HideContacts: true},
+	})
+	s.checkExportImport()
+}
+
+func (s Suite) TestRecovery() {
+	var (
+		user      = app.DefaultGenesisUsers["user4"]
+		guardian1 = app.DefaultGenesisUsers["user1"]
+		guardian2 = app.DefaultGenesisUsers["user2"]
+	)
+	_, _, newAddr := authtypes.KeyTestPubAddr()
+	s.NoError(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{guardian1, guardian2}, 2)))
+	s.NoError(s.k.SetGuardians(s.ctx, guardian1, types.NewGuardians([]sdk.AccAddress{guardian2}, 1)))
+	s.NoError(s.k.RequestRecovery(s.ctx, guardian2, user, newAddr))
+	s.checkExportImport()
+}
+
+func (s Suite) TestForwarding() {
+	_, _, newAddr := authtypes.KeyTestPubAddr()
+	s.NoError(s.k.MigrateAccount(s.ctx, app.DefaultGenesisUsers["user4"], newAddr))
+	s.checkExportImport()
+}
+
+func (s Suite) TestNicknames() {
+	s.NoError(s.k.OfferNickname(s.ctx, app.DefaultGenesisUsers["user2"], 5_000000, nil))
+	s.NoError(s.k.OfferNickname(s.ctx, app.DefaultGenesisUsers["user3"], 1_000000, app.DefaultGenesisUsers["user4"]))
+	s.k.ReserveNickname(s.ctx, "Artery")
+	s.checkExportImport()
+}
+
+func (s Suite) TestInvites() {
+	s.NoError(s.k.CreateInvite(s.ctx, profile.InviteHash(profile.InviteKey("welcome").PubKey()), profile.Invite{
+		Referrer: app.DefaultGenesisUsers["user2"],
+		MaxUses:  3,
+		Used:     1,
+	}))
+	s.NoError(s.k.CreateInvite(s.ctx, profile.InviteHash(profile.InviteKey("vip").PubKey()), profile.Invite{
+		Referrer:  app.DefaultGenesisUsers["user3"],
+		MaxUses:   1,
+		ExpiresAt: 1000,
+		Prepaid:   true,
+	}))
+	s.checkExportImport()
+}
+
 func (s *Suite) TestParams() {
 	s.Panics(func() {
 		s.k.SetParams(s.ctx, profile.Params{
@@ -24,6 +24,32 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgCreateAccount(ctx, k, msg)
 		case types.MsgCreateAccountWithProfile:
 			return handleMsgCreateAccountWithProfile(ctx, k, msg)
+		case types.MsgSetGuardians:
+			return handleMsgSetGuardians(ctx, k, msg)
+		case types.MsgRequestRecovery:
+			return handleMsgRequestRecovery(ctx, k, msg)
+		case types.MsgApproveRecovery:
+			return handleMsgApproveRecovery(ctx, k, msg)
+		case types.MsgCancelRecovery:
+			return handleMsgCancelRecovery(ctx, k, msg)
+		case types.MsgCompleteRecovery:
+			return handleMsgCompleteRecovery(ctx, k, msg)
+		case types.MsgMigrateAccount:
+			return handleMsgMigrateAccount(ctx, k, msg)
+		case types.MsgTransferNickname:
+			return handleMsgTransferNickname(ctx, k, msg)
+		case types.MsgOfferNickname:
+			return handleMsgOfferNickname(ctx, k, msg)
+		case types.MsgCancelNicknameOffer:
+			return handleMsgCancelNicknameOffer(ctx, k, msg)
+		case types.MsgBuyNickname:
+			return handleMsgBuyNickname(ctx, k, msg)
+		case types.MsgCreateInvite:
+			return handleMsgCreateInvite(ctx, k, msg)
+		case types.MsgCancelInvite:
+			return handleMsgCancelInvite(ctx, k, msg)
+		case types.MsgRedeemInvite:
+			return handleMsgRedeemInvite(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -159,3 +185,95 @@ func handleMsgCreateAccountWithProfile(ctx sdk.Context, keeper Keeper, msg types
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgSetGuardians(ctx sdk.Context, keeper Keeper, msg types.MsgSetGuardians) (*sdk.Result, error) {
+	if err := keeper.SetGuardians(ctx, msg.Address, types.NewGuardians(msg.Guardians, msg.Threshold)); err != nil {
+		return nil, errors.Wrap(err, "cannot set guardians")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRequestRecovery(ctx sdk.Context, keeper Keeper, msg types.MsgRequestRecovery) (*sdk.Result, error) {
+	if err := keeper.RequestRecovery(ctx, msg.Guardian, msg.Account, msg.NewAddress); err != nil {
+		return nil, errors.Wrap(err, "cannot request recovery")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgApproveRecovery(ctx sdk.Context, keeper Keeper, msg types.MsgApproveRecovery) (*sdk.Result, error) {
+	if err := keeper.ApproveRecovery(ctx, msg.Guardian, msg.Account, msg.NewAddress); err != nil {
+		return nil, errors.Wrap(err, "cannot approve recovery")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelRecovery(ctx sdk.Context, keeper Keeper, msg types.MsgCancelRecovery) (*sdk.Result, error) {
+	if err := keeper.CancelRecovery(ctx, msg.Address); err != nil {
+		return nil, errors.Wrap(err, "cannot cancel recovery")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCompleteRecovery(ctx sdk.Context, keeper Keeper, msg types.MsgCompleteRecovery) (*sdk.Result, error) {
+	if err := keeper.CompleteRecovery(ctx, msg.Account); err != nil {
+		return nil, errors.Wrap(err, "cannot complete recovery")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgMigrateAccount(ctx sdk.Context, keeper Keeper, msg types.MsgMigrateAccount) (*sdk.Result, error) {
+	if err := keeper.MigrateAccountToKey(ctx, msg.Address, msg.NewPubKey, msg.NewSignature); err != nil {
+		return nil, errors.Wrap(err, "cannot migrate account")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTransferNickname(ctx sdk.Context, keeper Keeper, msg types.MsgTransferNickname) (*sdk.Result, error) {
+	if err := keeper.TransferNickname(ctx, msg.Address, msg.Recipient); err != nil {
+		return nil, errors.Wrap(err, "cannot transfer nickname")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgOfferNickname(ctx sdk.Context, keeper Keeper, msg types.MsgOfferNickname) (*sdk.Result, error) {
+	if err := keeper.OfferNickname(ctx, msg.Address, msg.Price, msg.Buyer); err != nil {
+		return nil, errors.Wrap(err, "cannot offer nickname")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelNicknameOffer(ctx sdk.Context, keeper Keeper, msg types.MsgCancelNicknameOffer) (*sdk.Result, error) {
+	if err := keeper.CancelNicknameOffer(ctx, msg.Address); err != nil {
+		return nil, errors.Wrap(err, "cannot cancel nickname offer")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgBuyNickname(ctx sdk.Context, keeper Keeper, msg types.MsgBuyNickname) (*sdk.Result, error) {
+	if err := keeper.BuyNickname(ctx, msg.Buyer, msg.Nickname, msg.MaxPrice); err != nil {
+		return nil, errors.Wrap(err, "cannot buy nickname")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCreateInvite(ctx sdk.Context, keeper Keeper, msg types.MsgCreateInvite) (*sdk.Result, error) {
+	invite := types.NewInvite(msg.Referrer, msg.MaxUses, msg.ExpiresAt, msg.Prepaid)
+	if err := keeper.CreateInvite(ctx, msg.Hash, invite); err != nil {
+		return nil, errors.Wrap(err, "cannot create invite")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelInvite(ctx sdk.Context, keeper Keeper, msg types.MsgCancelInvite) (*sdk.Result, error) {
+	if err := keeper.CancelInvite(ctx, msg.Referrer, msg.Hash); err != nil {
+		return nil, errors.Wrap(err, "cannot cancel invite")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRedeemInvite(ctx sdk.Context, keeper Keeper, msg types.MsgRedeemInvite) (*sdk.Result, error) {
+	if err := keeper.RedeemInvite(ctx, msg.InvitePubKey, msg.Signature, msg.NewAccount, msg.Profile); err != nil {
+		return nil, errors.Wrap(err, "cannot redeem invite")
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 
 	"github.com/arterynetwork/artr/x/profile/types"
 )
@@ -9,13 +10,16 @@ import (
 func (k Keeper) ExportProfileRecords(ctx sdk.Context) []types.GenesisProfile {
 	var result []types.GenesisProfile
 	store := ctx.KVStore(k.storeKey)
-	it := store.Iterator(nil, nil)
+	it := sdk.KVStorePrefixIterator(store, auth.AddressStoreKeyPrefix)
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
 		acc := sdk.AccAddress(it.Key()[1:])
 		var value types.Profile
 		k.cdc.MustUnmarshalBinaryBare(it.Value(), &value)
-		value.CardNumber = 0
+		// Card number is derived from the account number, unless the account has been migrated
+		if value.CardNumber == k.CardNumberByAccountNumber(ctx, k.AccountKeeper.GetAccount(ctx, acc).GetAccountNumber()) {
+			value.CardNumber = 0
+		}
 		result = append(result, types.GenesisProfile{
 			Address: acc,
 			Profile: value,
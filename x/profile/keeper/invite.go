@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/crypto"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/arterynetwork/artr/x/profile/types"
+)
+
+const (
+	// PrepaidInviteRedeemedCallback is called with the referrer and a new account addresses, when a prepaid invite is
+	// redeemed, so that the subscription module could charge the referrer for the new account's first month.
+	PrepaidInviteRedeemedCallback = "prepaid-invite-redeemed"
+)
+
+// GetInvite returns an invite by its public key hash (if any)
+func (k Keeper) GetInvite(ctx sdk.Context, hash []byte) (types.Invite, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(inviteKey(hash))
+	if bz == nil {
+		return types.Invite{}, false
+	}
+	var invite types.Invite
+	k.cdc.MustUnmarshalBinaryBare(bz, &invite)
+	return invite, true
+}
+
+// CreateInvite publishes an invite. The invite key is kept by the referrer, only a hash of its public key is stored.
+func (k Keeper) CreateInvite(ctx sdk.Context, hash []byte, invite types.Invite) error {
+	if err := invite.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if invite.IsExpired(ctx.BlockHeight()) {
+		return types.ErrInviteExpired
+	}
+	if _, found := k.GetInvite(ctx, hash); found {
+		return types.ErrInviteExists
+	}
+	if k.GetProfile(ctx, invite.Referrer) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "no profile for %s", invite.Referrer)
+	}
+	k.setInvite(ctx, hash, invite)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInviteCreated,
+		sdk.NewAttribute(types.AttributeKeyInvite, tmbytes.HexBytes(hash).String()),
+		sdk.NewAttribute(types.AttributeKeyReferrer, invite.Referrer.String()),
+	))
+	return nil
+}
+
+// CancelInvite deletes an invite, so that its key cannot be used anymore
+func (k Keeper) CancelInvite(ctx sdk.Context, referrer sdk.AccAddress, hash []byte) error {
+	invite, found := k.GetInvite(ctx, hash)
+	if !found {
+		return types.ErrNoInvite
+	}
+	if !invite.Referrer.Equals(referrer) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the invite belongs to another account")
+	}
+	ctx.KVStore(k.storeKey).Delete(inviteKey(hash))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInviteCanceled,
+		sdk.NewAttribute(types.AttributeKeyInvite, tmbytes.HexBytes(hash).String()),
+		sdk.NewAttribute(types.AttributeKeyReferrer, referrer.String()),
+	))
+	return nil
+}
+
+// RedeemInvite creates a new account under the invite's referrer, who pays the account creation fee (unless they are
+// a free creator). The invite key must sign types.InviteSignBytes. An invite is deleted as soon as it's used up; an
+// expired one stays until the referrer cancels it.
+func (k Keeper) RedeemInvite(ctx sdk.Context, invitePubKey crypto.PubKey, signature []byte, addr sdk.AccAddress, profile types.Profile) error {
+	hash := types.InviteHash(invitePubKey)
+	invite, found := k.GetInvite(ctx, hash)
+	if !found {
+		return types.ErrNoInvite
+	}
+	if !invitePubKey.VerifyBytes(types.InviteSignBytes(ctx.ChainID(), addr, profile), signature) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invite key signature verification failed")
+	}
+	if invite.IsExpired(ctx.BlockHeight()) {
+		return types.ErrInviteExpired
+	}
+	if k.AccountKeeper.GetAccount(ctx, addr) != nil {
+		return sdkerrors.Wrapf(types.ErrAddressInUse, "%s already exists", addr)
+	}
+
+	if err := k.payCreationFee(ctx, invite.Referrer); err != nil {
+		return err
+	}
+	if err := k.CreateAccountWithProfile(ctx, addr, invite.Referrer, profile); err != nil {
+		return errors.Wrap(err, "cannot create account")
+	}
+
+	invite.Used++
+	if invite.Used < invite.MaxUses {
+		k.setInvite(ctx, hash, invite)
+	} else {
+		ctx.KVStore(k.storeKey).Delete(inviteKey(hash))
+	}
+
+	if invite.Prepaid {
+		for _, hook := range k.eventHooks[PrepaidInviteRedeemedCallback] {
+			if err := hook(ctx, invite.Referrer, addr); err != nil {
+				return errors.Wrap(err, "cannot pay for subscription")
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInviteRedeemed,
+		sdk.NewAttribute(types.AttributeKeyInvite, hash.String()),
+		sdk.NewAttribute(types.AttributeKeyReferrer, invite.Referrer.String()),
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+	))
+	return nil
+}
+
+func (k Keeper) ExportInvites(ctx sdk.Context) []types.GenesisInvite {
+	var result []types.GenesisInvite
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.InvitePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var value types.Invite
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &value)
+		result = append(result, types.GenesisInvite{
+			Hash:   append(tmbytes.HexBytes(nil), it.Key()[len(types.InvitePrefix):]...),
+			Invite: value,
+		})
+	}
+	return result
+}
+
+func (k Keeper) InitInvites(ctx sdk.Context, data []types.GenesisInvite) {
+	for _, record := range data {
+		k.setInvite(ctx, record.Hash, record.Invite)
+	}
+}
+
+// moveInvites makes a migrated account the referrer of its invites
+func (k Keeper) moveInvites(ctx sdk.Context, from, to sdk.AccAddress) {
+	for _, record := range k.ExportInvites(ctx) {
+		if record.Invite.Referrer.Equals(from) {
+			record.Invite.Referrer = to
+			k.setInvite(ctx, record.Hash, record.Invite)
+		}
+	}
+}
+
+func (k Keeper) payCreationFee(ctx sdk.Context, payer sdk.AccAddress) error {
+	p := k.GetParams(ctx)
+	for _, acc := range p.Creators {
+		if acc.Equals(payer) {
+			return nil
+		}
+	}
+
+	amt := sdk.NewCoins(sdk.NewCoin(types.MainDenom, sdk.NewInt(p.Fee)))
+	if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, payer, auth.FeeCollectorName, amt); err != nil {
+		return errors.Wrapf(err, "referrer %s cannot pay account creation fee", payer)
+	}
+	return nil
+}
+
+func (k Keeper) setInvite(ctx sdk.Context, hash []byte, invite types.Invite) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(inviteKey(hash), k.cdc.MustMarshalBinaryBare(invite))
+}
+
+func inviteKey(hash []byte) []byte {
+	return append(append([]byte(nil), types.InvitePrefix...), hash...)
+}
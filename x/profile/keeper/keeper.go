@@ -29,6 +29,7 @@ type Keeper struct {
 	BankKeeper      types.BankKeeper
 	ReferralsKeeper types.ReferralsKeeper
 	SupplyKeeper    types.SupplyKeeper
+	eventHooks      map[string][]func(ctx sdk.Context, from, to sdk.AccAddress) error
 }
 
 // NewKeeper creates a profile keeper
@@ -52,6 +53,7 @@ func NewKeeper(cdc *codec.Codec,
 		BankKeeper:      bankKeeper,
 		ReferralsKeeper: referralsKeeper,
 		SupplyKeeper:    supplyKeeper,
+		eventHooks:      make(map[string][]func(ctx sdk.Context, from, to sdk.AccAddress) error),
 	}
 	return keeper
 }
@@ -154,6 +156,9 @@ func (k Keeper) SetProfile(ctx sdk.Context, addr sdk.AccAddress, profile types.P
 		if oldProfile.Nickname != profile.Nickname {
 			// Profile.Nickname changed - we need to remove old nickname from store
 			if oldProfile.Nickname != "" {
+				if _, found := k.GetNicknameOffer(ctx, oldProfile.Nickname); found {
+					return types.ErrNicknameOnSale
+				}
 				k.removeProfileAccountByNickname(ctx, oldProfile.Nickname)
 			}
 
@@ -169,6 +174,18 @@ func (k Keeper) SetProfile(ctx sdk.Context, addr sdk.AccAddress, profile types.P
 			}
 		}
 
+		// Avatar, bio and contacts updates are charged (but privacy settings are not)
+		if !oldProfile.PublicInfoEquals(profile) {
+			if fee := k.GetParams(ctx).Fee; fee > 0 {
+				if err := k.SupplyKeeper.SendCoinsFromAccountToModule(
+					ctx, addr, auth.FeeCollectorName,
+					util.Uartrs(fee),
+				); err != nil {
+					return errors.Wrap(err, "cannot charge a profile update fee")
+				}
+			}
+		}
+
 		if profile.CardNumber != 0 {
 			if oldProfile.CardNumber != profile.CardNumber {
 				if oldProfile.CardNumber != 0 {
@@ -213,6 +230,10 @@ func (k Keeper) ValidateProfileNickname(ctx sdk.Context, addr sdk.AccAddress, ni
 		return types.ErrNicknamePrefix
 	}
 
+	if k.IsNicknameReserved(ctx, nickname) {
+		return types.ErrNicknameReserved
+	}
+
 	namesake := k.GetProfileAccountByNickname(ctx, nickname)
 	if namesake != nil && !namesake.Equals(addr) {
 		return types.ErrNicknameAlreadyInUse
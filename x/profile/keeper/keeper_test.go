@@ -3,12 +3,15 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -19,6 +22,7 @@ import (
 	"github.com/arterynetwork/artr/x/profile/types"
 	"github.com/arterynetwork/artr/x/referral"
 	"github.com/arterynetwork/artr/x/schedule"
+	"github.com/arterynetwork/artr/x/subscription"
 )
 
 func TestProfileKeeper(t *testing.T) {
@@ -75,7 +79,7 @@ func (s *Suite) TestCreateAccountWithProfile() {
 	}
 	{
 		tasks := s.scheduleKeeper.GetTasks(s.ctx, 1+2*util.BlocksOneMonth)
-		s.Equal(schedule.Schedule{schedule.Task{referral.CompressionHookName, addr}}, tasks)
+		s.Equal(schedule.Schedule{schedule.Task{HandlerName: referral.CompressionHookName, Data: addr, ID: 1}}, tasks)
 	}
 }
 
@@ -121,7 +125,7 @@ func (s *Suite) TestCreateAccount() {
 	}
 	{
 		tasks := s.scheduleKeeper.GetTasks(s.ctx, 1+2*util.BlocksOneMonth)
-		s.Equal(schedule.Schedule{schedule.Task{referral.CompressionHookName, addr}}, tasks)
+		s.Equal(schedule.Schedule{schedule.Task{HandlerName: referral.CompressionHookName, Data: addr, ID: 1}}, tasks)
 	}
 }
 
@@ -226,3 +230,553 @@ func (s *Suite) TestRename_ClearAndSet() {
 		s.authKeeper.GetAccount(s.ctx, user).GetCoins(),
 	)
 }
+
+func (s *Suite) TestPublicFields() {
+	user := app.DefaultGenesisUsers["user2"]
+	fee := s.k.GetParams(s.ctx).Fee
+	balance := s.authKeeper.GetAccount(s.ctx, user).GetCoins().AmountOf(util.ConfigMainDenom).Int64()
+
+	p := s.k.GetProfile(s.ctx, user)
+	p.AvatarHash = "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"
+	p.Bio = "VPN node operator"
+	p.Contacts = []string{"https://example.com", "@user2"}
+	s.NoError(s.k.SetProfile(s.ctx, user, *p))
+	s.Equal(balance-fee, s.authKeeper.GetAccount(s.ctx, user).GetCoins().AmountOf(util.ConfigMainDenom).Int64())
+
+	// Privacy settings are free
+	p.Visibility = types.Visibility{HideCardNumber: true, HideBio: true, HideContacts: true}
+	s.NoError(s.k.SetProfile(s.ctx, user, *p))
+	s.Equal(balance-fee, s.authKeeper.GetAccount(s.ctx, user).GetCoins().AmountOf(util.ConfigMainDenom).Int64())
+	s.Equal(*p, *s.k.GetProfile(s.ctx, user))
+
+	bz, err := profile.NewQuerier(s.k)(s.ctx, []string{types.QueryProfile}, abci.RequestQuery{
+		Data: s.cdc.MustMarshalJSON(types.NewQueryProfileParams(user)),
+	})
+	s.NoError(err)
+	var res types.QueryResProfile
+	s.cdc.MustUnmarshalJSON(bz, &res)
+	s.Equal("user2", res.Profile.Nickname)
+	s.Equal(p.AvatarHash, res.Profile.AvatarHash)
+	s.Zero(res.Profile.CardNumber)
+	s.Empty(res.Profile.Bio)
+	s.Empty(res.Profile.Contacts)
+
+	// A hidden card number cannot be resolved either
+	s.NotZero(p.CardNumber)
+	bz, err = profile.NewQuerier(s.k)(s.ctx, []string{types.QueryAccountAddressByCardNumber}, abci.RequestQuery{
+		Data: s.cdc.MustMarshalJSON(types.NewQueryAccountByCardNumberParams(p.CardNumber)),
+	})
+	s.NoError(err)
+	var resAddr types.QueryResAccountBy
+	s.cdc.MustUnmarshalJSON(bz, &resAddr)
+	s.Empty(resAddr.Address)
+
+	bz, err = profile.NewQuerier(s.k)(s.ctx, []string{types.QueryAccountAddressByNickname}, abci.RequestQuery{
+		Data: s.cdc.MustMarshalJSON(types.NewQueryAccountByNicknameParams("user2")),
+	})
+	s.NoError(err)
+	s.cdc.MustUnmarshalJSON(bz, &resAddr)
+	s.Equal(user, resAddr.Address)
+}
+
+func (s *Suite) TestPublicFields_Validation() {
+	user := app.DefaultGenesisUsers["user2"]
+	p := *s.k.GetProfile(s.ctx, user)
+
+	p.Bio = strings.Repeat("x", types.MaxBioLength+1)
+	s.Error(types.NewMsgSetProfile(user, p).ValidateBasic())
+	p.Bio = strings.Repeat("x", types.MaxBioLength)
+	s.NoError(types.NewMsgSetProfile(user, p).ValidateBasic())
+
+	p.Contacts = []string{"a", "b", "c", "d", "e", "f"}
+	s.Error(types.NewMsgSetProfile(user, p).ValidateBasic())
+	p.Contacts = []string{"a", " "}
+	s.Error(types.NewMsgSetProfile(user, p).ValidateBasic())
+	p.Contacts = []string{strings.Repeat("x", types.MaxContactLength+1)}
+	s.Error(types.NewMsgSetProfile(user, p).ValidateBasic())
+	p.Contacts = nil
+
+	p.AvatarHash = strings.Repeat("x", types.MaxAvatarHashLength+1)
+	s.Error(types.NewMsgSetProfile(user, p).ValidateBasic())
+}
+
+func (s *Suite) TestRecovery() {
+	var (
+		user      = app.DefaultGenesisUsers["user4"]
+		guardian1 = app.DefaultGenesisUsers["user1"]
+		guardian2 = app.DefaultGenesisUsers["user2"]
+		stranger  = app.DefaultGenesisUsers["user6"]
+	)
+	_, _, newAddr := authtypes.KeyTestPubAddr()
+	_, _, otherAddr := authtypes.KeyTestPubAddr()
+
+	params := s.k.GetParams(s.ctx)
+	params.RecoveryDelay = 10
+	s.k.SetParams(s.ctx, params)
+
+	s.NoError(s.app.GetDelegatingKeeper().Delegate(s.ctx, user, sdk.NewInt(100_000000)))
+	s.NoError(s.app.GetDelegatingKeeper().Revoke(s.ctx, user, sdk.NewInt(10_000000)))
+	s.NoError(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{guardian1, guardian2}, 2)))
+
+	var (
+		profileBefore = s.k.GetProfile(s.ctx, user)
+		coinsBefore   = s.authKeeper.GetAccount(s.ctx, user).GetCoins()
+		tasksBefore   = s.scheduleKeeper.GetTasksByAccount(s.ctx, user)
+	)
+	parent, err := s.refKeeper.GetParent(s.ctx, user)
+	s.NoError(err)
+	children, err := s.refKeeper.GetChildren(s.ctx, user)
+	s.NoError(err)
+	s.NotEmpty(children)
+	s.NotEmpty(tasksBefore)
+
+	s.True(types.ErrNotGuardian.Is(s.k.RequestRecovery(s.ctx, stranger, user, newAddr)))
+	s.True(types.ErrAddressInUse.Is(s.k.RequestRecovery(s.ctx, guardian1, user, stranger)))
+	s.NoError(s.k.RequestRecovery(s.ctx, guardian1, user, newAddr))
+
+	recovery, found := s.k.GetRecovery(s.ctx, user)
+	s.True(found)
+	s.Equal(types.Recovery{NewAddress: newAddr, Approvals: []sdk.AccAddress{guardian1}}, recovery)
+	s.True(types.ErrRecoveryNotReady.Is(s.k.CompleteRecovery(s.ctx, user)))
+
+	s.True(types.ErrAlreadyApproved.Is(s.k.ApproveRecovery(s.ctx, guardian1, user, newAddr)))
+	s.True(types.ErrRecoveryPending.Is(s.k.ApproveRecovery(s.ctx, guardian2, user, otherAddr)))
+	s.True(types.ErrRecoveryPending.Is(s.k.RequestRecovery(s.ctx, guardian2, user, otherAddr)))
+	s.NoError(s.k.ApproveRecovery(s.ctx, guardian2, user, newAddr))
+
+	recovery, _ = s.k.GetRecovery(s.ctx, user)
+	s.Equal(int64(11), recovery.CompleteAt)
+	s.True(types.ErrRecoveryNotReady.Is(s.k.CompleteRecovery(s.ctx.WithBlockHeight(10), user)))
+
+	ctx := s.ctx.WithBlockHeight(11)
+	s.NoError(s.k.CompleteRecovery(ctx, user))
+
+	_, found = s.k.GetRecovery(ctx, user)
+	s.False(found)
+
+	// profile, nickname, card number and guardians
+	s.Nil(s.k.GetProfile(ctx, user))
+	s.Equal(profileBefore, s.k.GetProfile(ctx, newAddr))
+	s.Equal(newAddr, s.k.GetProfileAccountByNickname(ctx, profileBefore.Nickname))
+	s.Equal(newAddr, s.k.GetProfileAccountByCardNumber(ctx, profileBefore.CardNumber))
+	_, found = s.k.GetGuardians(ctx, user)
+	s.False(found)
+	guardians, found := s.k.GetGuardians(ctx, newAddr)
+	s.True(found)
+	s.Equal(types.NewGuardians([]sdk.AccAddress{guardian1, guardian2}, 2), guardians)
+
+	// balance and delegation
+	s.True(s.authKeeper.GetAccount(ctx, user).GetCoins().IsZero())
+	s.Equal(coinsBefore, s.authKeeper.GetAccount(ctx, newAddr).GetCoins())
+	_, err = s.app.GetDelegatingKeeper().GetAccumulation(ctx, newAddr)
+	s.NoError(err)
+	revoking, err := s.app.GetDelegatingKeeper().GetRevoking(ctx, newAddr)
+	s.NoError(err)
+	s.Len(revoking, 1)
+
+	// referral position
+	_, err = s.refKeeper.GetParent(ctx, user)
+	s.Error(err)
+	newParent, err := s.refKeeper.GetParent(ctx, newAddr)
+	s.NoError(err)
+	s.Equal(parent, newParent)
+	siblings, err := s.refKeeper.GetChildren(ctx, parent)
+	s.NoError(err)
+	s.Contains(siblings, newAddr)
+	s.NotContains(siblings, user)
+	newChildren, err := s.refKeeper.GetChildren(ctx, newAddr)
+	s.NoError(err)
+	s.Equal(children, newChildren)
+	for _, child := range children {
+		p, err := s.refKeeper.GetParent(ctx, child)
+		s.NoError(err)
+		s.Equal(newAddr, p)
+	}
+
+	// scheduled tasks
+	s.Empty(s.scheduleKeeper.GetTasksByAccount(ctx, user))
+	s.Equal(len(tasksBefore), len(s.scheduleKeeper.GetTasksByAccount(ctx, newAddr)))
+
+	// the new account is fully functional
+	s.NoError(s.app.GetBankKeeper().SendCoins(ctx, newAddr, guardian1, util.Uartrs(1_000000)))
+}
+
+func (s *Suite) TestRecovery_Timeout() {
+	var (
+		user      = app.DefaultGenesisUsers["user4"]
+		guardian1 = app.DefaultGenesisUsers["user1"]
+		guardian2 = app.DefaultGenesisUsers["user2"]
+		guardian3 = app.DefaultGenesisUsers["user3"]
+	)
+	_, _, rogueAddr := authtypes.KeyTestPubAddr()
+	_, _, newAddr := authtypes.KeyTestPubAddr()
+
+	params := s.k.GetParams(s.ctx)
+	params.RecoveryTimeout = 10
+	s.k.SetParams(s.ctx, params)
+	s.NoError(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{guardian1, guardian2, guardian3}, 2)))
+
+	s.NoError(s.k.RequestRecovery(s.ctx, guardian1, user, rogueAddr))
+	recovery, _ := s.k.GetRecovery(s.ctx, user)
+	s.Equal(int64(11), recovery.ExpiresAt)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	s.True(types.ErrRecoveryPending.Is(s.k.RequestRecovery(ctx, guardian2, user, newAddr)))
+
+	ctx = s.ctx.WithBlockHeight(11)
+	s.True(types.ErrNoRecovery.Is(s.k.ApproveRecovery(ctx, guardian3, user, rogueAddr)))
+	s.NoError(s.k.RequestRecovery(ctx, guardian2, user, newAddr))
+	s.NoError(s.k.ApproveRecovery(ctx, guardian3, user, newAddr))
+
+	recovery, _ = s.k.GetRecovery(ctx, user)
+	s.Equal(newAddr, recovery.NewAddress)
+	s.Equal([]sdk.AccAddress{guardian2, guardian3}, recovery.Approvals)
+	s.NotZero(recovery.CompleteAt)
+	s.False(recovery.IsExpired(ctx.BlockHeight() + 100))
+}
+
+func (s *Suite) TestRecovery_GuardianMigrated() {
+	var (
+		user      = app.DefaultGenesisUsers["user4"]
+		guardian1 = app.DefaultGenesisUsers["user3"]
+		guardian2 = app.DefaultGenesisUsers["user2"]
+	)
+	_, _, newGuardian1 := authtypes.KeyTestPubAddr()
+	_, _, newAddr := authtypes.KeyTestPubAddr()
+
+	s.NoError(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{guardian1, guardian2}, 2)))
+	s.NoError(s.k.RequestRecovery(s.ctx, guardian1, user, newAddr))
+	s.NoError(s.k.MigrateAccount(s.ctx, guardian1, newGuardian1))
+
+	guardians, _ := s.k.GetGuardians(s.ctx, user)
+	s.Equal(types.NewGuardians([]sdk.AccAddress{newGuardian1, guardian2}, 2), guardians)
+	recovery, _ := s.k.GetRecovery(s.ctx, user)
+	s.Equal([]sdk.AccAddress{newGuardian1}, recovery.Approvals)
+
+	s.True(types.ErrNotGuardian.Is(s.k.ApproveRecovery(s.ctx, guardian1, user, newAddr)))
+	s.True(types.ErrAlreadyApproved.Is(s.k.ApproveRecovery(s.ctx, newGuardian1, user, newAddr)))
+	s.NoError(s.k.ApproveRecovery(s.ctx, guardian2, user, newAddr))
+	recovery, _ = s.k.GetRecovery(s.ctx, user)
+	s.NotZero(recovery.CompleteAt)
+
+	// a guardian moved to the address that already guards the account is merged with it
+	_, _, newGuardian2 := authtypes.KeyTestPubAddr()
+	s.NoError(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{newGuardian2, guardian2}, 2)))
+	s.NoError(s.k.MigrateAccount(s.ctx, guardian2, newGuardian2))
+	guardians, _ = s.k.GetGuardians(s.ctx, user)
+	s.Equal(types.NewGuardians([]sdk.AccAddress{newGuardian2}, 1), guardians)
+}
+
+func (s *Suite) TestRecovery_Cancel() {
+	var (
+		user     = app.DefaultGenesisUsers["user4"]
+		guardian = app.DefaultGenesisUsers["user1"]
+	)
+	_, _, newAddr := authtypes.KeyTestPubAddr()
+
+	s.True(types.ErrNoGuardians.Is(s.k.RequestRecovery(s.ctx, guardian, user, newAddr)))
+	s.NoError(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{guardian}, 1)))
+	s.NoError(s.k.RequestRecovery(s.ctx, guardian, user, newAddr))
+
+	s.NoError(s.k.CancelRecovery(s.ctx, user))
+	_, found := s.k.GetRecovery(s.ctx, user)
+	s.False(found)
+	s.True(types.ErrNoRecovery.Is(s.k.CompleteRecovery(s.ctx.WithBlockHeight(1+util.BlocksOneWeek), user)))
+	s.True(types.ErrNoRecovery.Is(s.k.CancelRecovery(s.ctx, user)))
+
+	// changing guardians cancels a pending recovery too
+	s.NoError(s.k.RequestRecovery(s.ctx, guardian, user, newAddr))
+	s.NoError(s.k.SetGuardians(s.ctx, user, types.Guardians{}))
+	_, found = s.k.GetRecovery(s.ctx, user)
+	s.False(found)
+	_, found = s.k.GetGuardians(s.ctx, user)
+	s.False(found)
+	s.NotNil(s.k.GetProfile(s.ctx, user))
+}
+
+func (s *Suite) TestRecovery_Validation() {
+	user := app.DefaultGenesisUsers["user4"]
+	g := app.DefaultGenesisUsers["user1"]
+
+	s.Error(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{g}, 2)))
+	s.Error(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{g}, 0)))
+	s.Error(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{g, g}, 1)))
+	s.Error(s.k.SetGuardians(s.ctx, user, types.NewGuardians([]sdk.AccAddress{user}, 1)))
+
+	_, _, noProfile := authtypes.KeyTestPubAddr()
+	s.Error(s.k.SetGuardians(s.ctx, noProfile, types.NewGuardians([]sdk.AccAddress{g}, 1)))
+}
+
+func (s *Suite) TestMigrateAccount() {
+	var (
+		user  = app.DefaultGenesisUsers["user4"]
+		other = app.DefaultGenesisUsers["user1"]
+	)
+	newPriv, newPub, newAddr := authtypes.KeyTestPubAddr()
+	ctx := s.ctx.WithChainID("test-chain")
+
+	var (
+		vpnKeeper          = s.app.GetVpnKeeper()
+		storageKeeper      = s.app.GetStorageKeeper()
+		subscriptionKeeper = s.app.GetSubscriptionKeeper()
+	)
+	vpnKeeper.SetLimit(ctx, user, 100)
+	storageKeeper.SetLimit(ctx, user, 200)
+	storageKeeper.SetData(ctx, user, []byte("manifest"))
+	subscriptionKeeper.SetActivityInfo(ctx, user, subscription.ActivityInfo{Active: true, ExpireAt: 1000})
+	s.NoError(s.k.SetGuardians(ctx, user, types.NewGuardians([]sdk.AccAddress{other}, 1)))
+	_, _, recoveryAddr := authtypes.KeyTestPubAddr()
+	s.NoError(s.k.RequestRecovery(ctx, other, user, recoveryAddr))
+	profileBefore := s.k.GetProfile(ctx, user)
+
+	// a signature made for another chain doesn't fit
+	sig, err := newPriv.Sign(types.MigrationSignBytes("other-chain", user, newAddr))
+	s.NoError(err)
+	s.True(sdkerrors.ErrUnauthorized.Is(s.k.MigrateAccountToKey(ctx, user, newPub, sig)))
+	// nor one made for another account
+	sig, err = newPriv.Sign(types.MigrationSignBytes("test-chain", other, newAddr))
+	s.NoError(err)
+	s.True(sdkerrors.ErrUnauthorized.Is(s.k.MigrateAccountToKey(ctx, user, newPub, sig)))
+	s.NotNil(s.k.GetProfile(ctx, user))
+
+	sig, err = newPriv.Sign(types.MigrationSignBytes("test-chain", user, newAddr))
+	s.NoError(err)
+	s.NoError(s.k.MigrateAccountToKey(ctx, user, newPub, sig))
+
+	s.Nil(s.k.GetProfile(ctx, user))
+	s.Equal(profileBefore, s.k.GetProfile(ctx, newAddr))
+	_, found := s.k.GetRecovery(ctx, user)
+	s.False(found)
+
+	// other modules' data
+	limit, err := vpnKeeper.GetLimit(ctx, newAddr)
+	s.NoError(err)
+	s.Equal(int64(100), limit)
+	limit, err = vpnKeeper.GetLimit(ctx, user)
+	s.NoError(err)
+	s.Zero(limit)
+	s.Equal(int64(200), storageKeeper.GetLimit(ctx, newAddr))
+	s.Equal(int64(0), storageKeeper.GetLimit(ctx, user))
+	s.Equal([]byte("manifest"), storageKeeper.GetData(ctx, newAddr))
+	s.Nil(storageKeeper.GetData(ctx, user))
+	s.Equal(subscription.ActivityInfo{Active: true, ExpireAt: 1000}, subscriptionKeeper.GetActivityInfo(ctx, newAddr))
+	s.False(subscriptionKeeper.IsActive(ctx, user))
+
+	// forwarding record
+	fwd, found := s.k.GetForwarding(ctx, user)
+	s.True(found)
+	s.Equal(newAddr, fwd)
+	s.Equal(newAddr, s.k.ResolveForwarding(ctx, user))
+	s.Equal(other, s.k.ResolveForwarding(ctx, other))
+
+	// the old address cannot be reused
+	_, _, thirdAddr := authtypes.KeyTestPubAddr()
+	s.NoError(s.k.MigrateAccount(ctx, newAddr, thirdAddr))
+	s.Equal(thirdAddr, s.k.ResolveForwarding(ctx, user))
+	s.True(types.ErrAddressInUse.Is(s.k.MigrateAccount(ctx, thirdAddr, user)))
+}
+
+func (s *Suite) TestMigrateAccount_PendingTransition() {
+	var (
+		subject = app.DefaultGenesisUsers["user4"]
+		dest    = app.DefaultGenesisUsers["user3"]
+	)
+	_, _, newAddr := authtypes.KeyTestPubAddr()
+	s.NoError(s.refKeeper.RequestTransition(s.ctx, subject, dest))
+
+	s.NoError(s.k.MigrateAccount(s.ctx, dest, newAddr))
+
+	transition, err := s.refKeeper.GetPendingTransition(s.ctx, subject)
+	s.NoError(err)
+	s.Equal(newAddr, transition)
+	s.NoError(s.refKeeper.AffirmTransition(s.ctx, subject))
+	referrer, err := s.refKeeper.GetParent(s.ctx, subject)
+	s.NoError(err)
+	s.Equal(newAddr, referrer)
+}
+
+func (s *Suite) TestTransferNickname() {
+	user := app.DefaultGenesisUsers["user2"]
+	_, _, recipient := authtypes.KeyTestPubAddr()
+	s.NoError(s.k.CreateAccountWithProfile(s.ctx, recipient, user, types.Profile{}))
+
+	s.True(types.ErrHasNickname.Is(s.k.TransferNickname(s.ctx, user, app.DefaultGenesisUsers["user3"])))
+	s.NoError(s.k.TransferNickname(s.ctx, user, recipient))
+
+	s.Equal("", s.k.GetProfile(s.ctx, user).Nickname)
+	s.Equal("user2", s.k.GetProfile(s.ctx, recipient).Nickname)
+	s.Equal(recipient, s.k.GetProfileAccountByNickname(s.ctx, "user2"))
+	s.True(types.ErrNoNickname.Is(s.k.TransferNickname(s.ctx, user, recipient)))
+}
+
+func (s *Suite) TestNicknameSale() {
+	var (
+		seller   = app.DefaultGenesisUsers["user2"]
+		buyer    = app.DefaultGenesisUsers["user3"]
+		stranger = app.DefaultGenesisUsers["user4"]
+		bk       = s.app.GetBankKeeper()
+	)
+	s.True(types.ErrNoNicknameOffer.Is(s.k.BuyNickname(s.ctx, buyer, "user2", 5_000000)))
+	s.NoError(s.k.OfferNickname(s.ctx, seller, 5_000000, buyer))
+
+	// the nickname is in escrow
+	p := s.k.GetProfile(s.ctx, seller)
+	p.Nickname = "user2a"
+	s.True(types.ErrNicknameOnSale.Is(errors.Cause(s.k.SetProfile(s.ctx, seller, *p))))
+	s.True(types.ErrNicknameOnSale.Is(s.k.TransferNickname(s.ctx, seller, stranger)))
+	// a buyer cannot sell its nickname and buy another one at the same time
+	s.NoError(s.k.OfferNickname(s.ctx, buyer, 1_000000, nil))
+	s.True(types.ErrNicknameOnSale.Is(errors.Cause(s.k.BuyNickname(s.ctx, buyer, "user2", 5_000000))))
+	s.NoError(s.k.CancelNicknameOffer(s.ctx, buyer))
+	s.True(types.ErrNoNicknameOffer.Is(s.k.CancelNicknameOffer(s.ctx, buyer)))
+
+	s.True(sdkerrors.ErrUnauthorized.Is(s.k.BuyNickname(s.ctx, stranger, "user2", 5_000000)))
+	s.True(sdkerrors.ErrInvalidRequest.Is(s.k.BuyNickname(s.ctx, buyer, "user2", 4_999999)))
+
+	var (
+		sellerCoins = bk.GetCoins(s.ctx, seller)
+		buyerCoins  = bk.GetCoins(s.ctx, buyer)
+	)
+	s.NoError(s.k.BuyNickname(s.ctx, buyer, "USER2", 5_000000))
+
+	s.Equal("", s.k.GetProfile(s.ctx, seller).Nickname)
+	s.Equal("user2", s.k.GetProfile(s.ctx, buyer).Nickname)
+	s.Equal(buyer, s.k.GetProfileAccountByNickname(s.ctx, "user2"))
+	s.Nil(s.k.GetProfileAccountByNickname(s.ctx, "user3"))
+	_, found := s.k.GetNicknameOffer(s.ctx, "user2")
+	s.False(found)
+	s.Equal(sellerCoins.Add(util.Uartrs(5_000000)...), bk.GetCoins(s.ctx, seller))
+	s.Equal(buyerCoins.Sub(util.Uartrs(5_000000)), bk.GetCoins(s.ctx, buyer))
+}
+
+func (s *Suite) TestReservedNickname() {
+	var (
+		holder = app.DefaultGenesisUsers["user2"]
+		user   = app.DefaultGenesisUsers["user3"]
+	)
+	s.NoError(s.k.OfferNickname(s.ctx, holder, 5_000000, nil))
+	s.k.ReserveNickname(s.ctx, "USER2")
+
+	s.True(s.k.IsNicknameReserved(s.ctx, "user2"))
+	s.Equal([]string{"user2"}, s.k.GetReservedNicknames(s.ctx))
+	s.Equal("", s.k.GetProfile(s.ctx, holder).Nickname)
+	s.Nil(s.k.GetProfileAccountByNickname(s.ctx, "user2"))
+	_, found := s.k.GetNicknameOffer(s.ctx, "user2")
+	s.False(found)
+
+	p := s.k.GetProfile(s.ctx, user)
+	p.Nickname = "User2"
+	s.True(types.ErrNicknameReserved.Is(errors.Cause(s.k.SetProfile(s.ctx, user, *p))))
+
+	s.k.ReleaseNickname(s.ctx, "user2")
+	s.False(s.k.IsNicknameReserved(s.ctx, "user2"))
+	s.NoError(s.k.SetProfile(s.ctx, user, *p))
+	s.Equal(user, s.k.GetProfileAccountByNickname(s.ctx, "user2"))
+}
+
+func (s *Suite) TestNicknameReclaimedOnCompression() {
+	user := app.DefaultGenesisUsers["user4"]
+	nickname := s.k.GetProfile(s.ctx, user).Nickname
+	s.NotEmpty(nickname)
+	s.NoError(s.k.OfferNickname(s.ctx, user, 5_000000, nil))
+
+	s.NoError(s.refKeeper.Compress(s.ctx, user))
+
+	s.Equal("", s.k.GetProfile(s.ctx, user).Nickname)
+	s.Nil(s.k.GetProfileAccountByNickname(s.ctx, nickname))
+	_, found := s.k.GetNicknameOffer(s.ctx, nickname)
+	s.False(found)
+}
+
+func (s *Suite) TestInvite() {
+	var (
+		referrer = app.DefaultGenesisUsers["user2"]
+		stranger = app.DefaultGenesisUsers["user3"]
+		hash     = types.InviteHash(types.InviteKey("welcome").PubKey())
+	)
+	s.NoError(s.k.CreateInvite(s.ctx, hash, types.NewInvite(referrer, 2, 10, false)))
+	s.True(types.ErrInviteExists.Is(s.k.CreateInvite(s.ctx, hash, types.NewInvite(stranger, 1, 0, false))))
+	s.True(sdkerrors.ErrUnauthorized.Is(s.k.CancelInvite(s.ctx, stranger, hash)))
+
+	_, _, addr1 := authtypes.KeyTestPubAddr()
+	_, _, addr2 := authtypes.KeyTestPubAddr()
+	s.True(types.ErrNoInvite.Is(s.redeemInvite("wrong", addr1, types.Profile{})))
+	s.NoError(s.redeemInvite("welcome", addr1, types.Profile{Nickname: "newbie"}))
+	s.True(types.ErrAddressInUse.Is(s.redeemInvite("welcome", addr1, types.Profile{})))
+
+	parent, err := s.refKeeper.GetParent(s.ctx, addr1)
+	s.NoError(err)
+	s.Equal(referrer, parent)
+	s.Equal(addr1, s.k.GetProfileAccountByNickname(s.ctx, "newbie"))
+	invite, found := s.k.GetInvite(s.ctx, hash)
+	s.True(found)
+	s.Equal(uint32(1), invite.Used)
+
+	s.NoError(s.redeemInvite("welcome", addr2, types.Profile{}))
+	_, found = s.k.GetInvite(s.ctx, hash)
+	s.False(found, "used up")
+}
+
+func (s *Suite) TestInvite_Signature() {
+	var (
+		referrer  = app.DefaultGenesisUsers["user2"]
+		inviteKey = types.InviteKey("welcome")
+	)
+	s.NoError(s.k.CreateInvite(s.ctx, types.InviteHash(inviteKey.PubKey()), types.NewInvite(referrer, 1, 0, false)))
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, thief := authtypes.KeyTestPubAddr()
+	sig, err := inviteKey.Sign(types.InviteSignBytes(s.ctx.ChainID(), addr, types.Profile{Nickname: "newbie"}))
+	s.NoError(err)
+
+	s.True(sdkerrors.ErrUnauthorized.Is(s.k.RedeemInvite(s.ctx, inviteKey.PubKey(), sig, thief, types.Profile{Nickname: "newbie"})))
+	s.True(sdkerrors.ErrUnauthorized.Is(s.k.RedeemInvite(s.ctx, inviteKey.PubKey(), sig, addr, types.Profile{Nickname: "thief"})))
+	s.Nil(s.authKeeper.GetAccount(s.ctx, thief))
+
+	s.NoError(s.k.RedeemInvite(s.ctx, inviteKey.PubKey(), sig, addr, types.Profile{Nickname: "newbie"}))
+	s.Equal(addr, s.k.GetProfileAccountByNickname(s.ctx, "newbie"))
+}
+
+func (s *Suite) TestInvite_Expired() {
+	var (
+		referrer = app.DefaultGenesisUsers["user2"]
+		hash     = types.InviteHash(types.InviteKey("welcome").PubKey())
+	)
+	s.NoError(s.k.CreateInvite(s.ctx, hash, types.NewInvite(referrer, 1, 10, false)))
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	s.True(types.ErrInviteExpired.Is(s.redeemInviteAt(s.ctx.WithBlockHeight(10), "welcome", addr, types.Profile{})))
+	s.Nil(s.authKeeper.GetAccount(s.ctx, addr))
+
+	_, found := s.k.GetInvite(s.ctx, hash)
+	s.True(found, "kept until canceled")
+	s.NoError(s.k.CancelInvite(s.ctx, referrer, hash))
+}
+
+func (s *Suite) TestInvite_Prepaid() {
+	var (
+		referrer = app.DefaultGenesisUsers["user2"]
+		hash     = types.InviteHash(types.InviteKey("welcome").PubKey())
+		bk       = s.app.GetBankKeeper()
+		sk       = s.app.GetSubscriptionKeeper()
+	)
+	s.NoError(s.k.CreateInvite(s.ctx, hash, types.NewInvite(referrer, 1, 0, true)))
+	coins := bk.GetCoins(s.ctx, referrer)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	s.NoError(s.redeemInvite("welcome", addr, types.Profile{}))
+
+	s.True(sk.IsActive(s.ctx, addr))
+	s.True(bk.GetCoins(s.ctx, referrer).AmountOf(util.ConfigMainDenom).LT(coins.AmountOf(util.ConfigMainDenom)))
+}
+
+func (s *Suite) redeemInvite(code string, addr sdk.AccAddress, profile types.Profile) error {
+	return s.redeemInviteAt(s.ctx, code, addr, profile)
+}
+
+func (s *Suite) redeemInviteAt(ctx sdk.Context, code string, addr sdk.AccAddress, profile types.Profile) error {
+	inviteKey := types.InviteKey(code)
+	sig, err := inviteKey.Sign(types.InviteSignBytes(ctx.ChainID(), addr, profile))
+	s.NoError(err)
+	return s.k.RedeemInvite(ctx, inviteKey.PubKey(), sig, addr, profile)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/x/profile/types"
+)
+
+// MigrateAccountToKey moves the account to the address of the new key. The new key owner must sign
+// types.MigrationSignBytes to agree to take the account over.
+func (k Keeper) MigrateAccountToKey(ctx sdk.Context, acc sdk.AccAddress, newPubKey crypto.PubKey, newSignature []byte) error {
+	newAddr := sdk.AccAddress(newPubKey.Address())
+	if !newPubKey.VerifyBytes(types.MigrationSignBytes(ctx.ChainID(), acc, newAddr), newSignature) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "new key signature verification failed")
+	}
+	if err := k.MigrateAccount(ctx, acc, newAddr); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAccountMigrated,
+		sdk.NewAttribute(types.AttributeKeyAddress, acc.String()),
+		sdk.NewAttribute(types.AttributeKeyNewAddress, newAddr.String()),
+	))
+	return nil
+}
+
+// GetForwarding returns the address the account has been moved to (if it has)
+func (k Keeper) GetForwarding(ctx sdk.Context, acc sdk.AccAddress) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(forwardingKey(acc))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// ResolveForwarding follows forwarding records starting from the address, and returns the account's current address
+// (which is the address itself if it hasn't been moved)
+func (k Keeper) ResolveForwarding(ctx sdk.Context, acc sdk.AccAddress) sdk.AccAddress {
+	for {
+		next, found := k.GetForwarding(ctx, acc)
+		if !found {
+			return acc
+		}
+		acc = next
+	}
+}
+
+func (k Keeper) ExportForwardings(ctx sdk.Context) []types.GenesisForwarding {
+	var result []types.GenesisForwarding
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ForwardingPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		result = append(result, types.GenesisForwarding{
+			Address:    sdk.AccAddress(it.Key()[len(types.ForwardingPrefix):]),
+			NewAddress: sdk.AccAddress(it.Value()),
+		})
+	}
+	return result
+}
+
+func (k Keeper) InitForwardings(ctx sdk.Context, data []types.GenesisForwarding) {
+	for _, record := range data {
+		k.setForwarding(ctx, record.Address, record.NewAddress)
+	}
+}
+
+func (k Keeper) setForwarding(ctx sdk.Context, from, to sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(forwardingKey(from), to)
+}
+
+func forwardingKey(acc sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.ForwardingPrefix...), acc...)
+}
@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/profile/types"
+)
+
+// IsNicknameReserved checks if nobody can take the nickname, because it's reserved by voting
+func (k Keeper) IsNicknameReserved(ctx sdk.Context, nickname string) bool {
+	return ctx.KVStore(k.storeKey).Has(reservedKey(nickname))
+}
+
+// ReserveNickname makes the nickname unavailable. If somebody has it, it's reclaimed.
+func (k Keeper) ReserveNickname(ctx sdk.Context, nickname string) {
+	if holder := k.GetProfileAccountByNickname(ctx, nickname); holder != nil {
+		k.reclaimNickname(ctx, holder)
+	}
+	ctx.KVStore(k.storeKey).Set(reservedKey(nickname), []byte{})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNicknameReserved,
+		sdk.NewAttribute(types.AttributeKeyNickname, nickname),
+	))
+}
+
+// ReleaseNickname makes a reserved nickname available again
+func (k Keeper) ReleaseNickname(ctx sdk.Context, nickname string) {
+	ctx.KVStore(k.storeKey).Delete(reservedKey(nickname))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNicknameReleased,
+		sdk.NewAttribute(types.AttributeKeyNickname, nickname),
+	))
+}
+
+// GetReservedNicknames returns all the reserved nicknames (in lower case), sorted
+func (k Keeper) GetReservedNicknames(ctx sdk.Context) []string {
+	var result []string
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ReservedPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		result = append(result, string(it.Key()[len(types.ReservedPrefix):]))
+	}
+	return result
+}
+
+// GetNicknameOffer returns a sale offer of the nickname (if any)
+func (k Keeper) GetNicknameOffer(ctx sdk.Context, nickname string) (types.NicknameOffer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(offerKey(nickname))
+	if bz == nil {
+		return types.NicknameOffer{}, false
+	}
+	var offer types.NicknameOffer
+	k.cdc.MustUnmarshalBinaryBare(bz, &offer)
+	return offer, true
+}
+
+// TransferNickname gives the account's nickname to another account, which must have a profile, but no nickname
+func (k Keeper) TransferNickname(ctx sdk.Context, from, to sdk.AccAddress) error {
+	fromProfile := k.GetProfile(ctx, from)
+	if fromProfile == nil || fromProfile.Nickname == "" {
+		return types.ErrNoNickname
+	}
+	if _, found := k.GetNicknameOffer(ctx, fromProfile.Nickname); found {
+		return types.ErrNicknameOnSale
+	}
+	toProfile := k.GetProfile(ctx, to)
+	if toProfile == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "no profile for %s", to)
+	}
+	if toProfile.Nickname != "" {
+		return sdkerrors.Wrapf(types.ErrHasNickname, "%s is already known as %s", to, toProfile.Nickname)
+	}
+
+	nickname := fromProfile.Nickname
+	k.moveNickname(ctx, from, *fromProfile, to, *toProfile)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNicknameTransferred,
+		sdk.NewAttribute(types.AttributeKeyNickname, nickname),
+		sdk.NewAttribute(types.AttributeKeyAddress, from.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, to.String()),
+	))
+	return nil
+}
+
+// OfferNickname puts the account's nickname on sale. A previous offer (if any) is replaced.
+func (k Keeper) OfferNickname(ctx sdk.Context, seller sdk.AccAddress, price uint64, buyer sdk.AccAddress) error {
+	offer := types.NewNicknameOffer(seller, price, buyer)
+	if err := offer.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	profile := k.GetProfile(ctx, seller)
+	if profile == nil || profile.Nickname == "" {
+		return types.ErrNoNickname
+	}
+	k.setNicknameOffer(ctx, profile.Nickname, offer)
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyNickname, profile.Nickname),
+		sdk.NewAttribute(types.AttributeKeySeller, seller.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", price)),
+	}
+	if !buyer.Empty() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyBuyer, buyer.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeNicknameOffered, attrs...))
+	return nil
+}
+
+// CancelNicknameOffer withdraws the account's nickname from sale
+func (k Keeper) CancelNicknameOffer(ctx sdk.Context, seller sdk.AccAddress) error {
+	profile := k.GetProfile(ctx, seller)
+	if profile == nil || profile.Nickname == "" {
+		return types.ErrNoNickname
+	}
+	if _, found := k.GetNicknameOffer(ctx, profile.Nickname); !found {
+		return types.ErrNoNicknameOffer
+	}
+	k.cancelNicknameOffer(ctx, profile.Nickname)
+	return nil
+}
+
+// BuyNickname pays the seller and moves the nickname to the buyer. The buyer's current nickname (if any) is dropped.
+func (k Keeper) BuyNickname(ctx sdk.Context, buyer sdk.AccAddress, nickname string, maxPrice uint64) error {
+	offer, found := k.GetNicknameOffer(ctx, nickname)
+	if !found {
+		return types.ErrNoNicknameOffer
+	}
+	if !offer.IsFor(buyer) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the offer is for another buyer")
+	}
+	if offer.Price > maxPrice {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price %d is above the limit %d", offer.Price, maxPrice)
+	}
+	buyerProfile := k.GetProfile(ctx, buyer)
+	if buyerProfile == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "no profile for %s", buyer)
+	}
+	if buyerProfile.Nickname != "" {
+		if _, found := k.GetNicknameOffer(ctx, buyerProfile.Nickname); found {
+			return sdkerrors.Wrapf(types.ErrNicknameOnSale, "buyer's nickname %s", buyerProfile.Nickname)
+		}
+		k.removeProfileAccountByNickname(ctx, buyerProfile.Nickname)
+		buyerProfile.Nickname = ""
+	}
+	sellerProfile := k.GetProfile(ctx, offer.Seller)
+	if sellerProfile == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "no profile for %s", offer.Seller)
+	}
+
+	if err := k.BankKeeper.SendCoins(ctx, buyer, offer.Seller, util.UartrsUint64(offer.Price)); err != nil {
+		return errors.Wrap(err, "cannot pay for nickname")
+	}
+	ctx.KVStore(k.storeKey).Delete(offerKey(nickname))
+	k.moveNickname(ctx, offer.Seller, *sellerProfile, buyer, *buyerProfile)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNicknameSold,
+		sdk.NewAttribute(types.AttributeKeyNickname, sellerProfile.Nickname),
+		sdk.NewAttribute(types.AttributeKeySeller, offer.Seller.String()),
+		sdk.NewAttribute(types.AttributeKeyBuyer, buyer.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", offer.Price)),
+	))
+	return nil
+}
+
+// OnAccountCompressed reclaims a nickname of an account compressed due to inactivity, so that somebody else could
+// take it.
+func (k Keeper) OnAccountCompressed(ctx sdk.Context, acc sdk.AccAddress) error {
+	k.reclaimNickname(ctx, acc)
+	return nil
+}
+
+func (k Keeper) ExportNicknameOffers(ctx sdk.Context) []types.GenesisNicknameOffer {
+	var result []types.GenesisNicknameOffer
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.OfferPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var value types.NicknameOffer
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &value)
+		result = append(result, types.GenesisNicknameOffer{
+			Nickname: string(it.Key()[len(types.OfferPrefix):]),
+			Offer:    value,
+		})
+	}
+	return result
+}
+
+func (k Keeper) InitReservedNicknames(ctx sdk.Context, data []string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, nickname := range data {
+		store.Set(reservedKey(nickname), []byte{})
+	}
+}
+
+func (k Keeper) InitNicknameOffers(ctx sdk.Context, data []types.GenesisNicknameOffer) {
+	for _, record := range data {
+		k.setNicknameOffer(ctx, record.Nickname, record.Offer)
+	}
+}
+
+// reclaimNickname takes the nickname (if any) away from the account
+func (k Keeper) reclaimNickname(ctx sdk.Context, acc sdk.AccAddress) {
+	profile := k.GetProfile(ctx, acc)
+	if profile == nil || profile.Nickname == "" {
+		return
+	}
+	nickname := profile.Nickname
+	if _, found := k.GetNicknameOffer(ctx, nickname); found {
+		k.cancelNicknameOffer(ctx, nickname)
+	}
+	k.removeProfileAccountByNickname(ctx, nickname)
+	profile.Nickname = ""
+	k.saveProfile(ctx, acc, *profile)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNicknameReclaimed,
+		sdk.NewAttribute(types.AttributeKeyNickname, nickname),
+		sdk.NewAttribute(types.AttributeKeyAddress, acc.String()),
+	))
+}
+
+func (k Keeper) moveNickname(ctx sdk.Context, from sdk.AccAddress, fromProfile types.Profile, to sdk.AccAddress, toProfile types.Profile) {
+	toProfile.Nickname = fromProfile.Nickname
+	fromProfile.Nickname = ""
+	k.saveProfile(ctx, from, fromProfile)
+	k.saveProfile(ctx, to, toProfile)
+	k.setProfileAccountByNickname(ctx, toProfile.Nickname, to)
+}
+
+func (k Keeper) cancelNicknameOffer(ctx sdk.Context, nickname string) {
+	ctx.KVStore(k.storeKey).Delete(offerKey(nickname))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNicknameOfferCanceled,
+		sdk.NewAttribute(types.AttributeKeyNickname, nickname),
+	))
+}
+
+func (k Keeper) setNicknameOffer(ctx sdk.Context, nickname string, offer types.NicknameOffer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(offerKey(nickname), k.cdc.MustMarshalBinaryBare(offer))
+}
+
+func (k Keeper) saveProfile(ctx sdk.Context, addr sdk.AccAddress, profile types.Profile) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(auth.AddressStoreKey(addr), k.cdc.MustMarshalBinaryBare(profile))
+}
+
+func reservedKey(nickname string) []byte {
+	return append(append([]byte(nil), types.ReservedPrefix...), strings.ToLower(nickname)...)
+}
+
+func offerKey(nickname string) []byte {
+	return append(append([]byte(nil), types.OfferPrefix...), strings.ToLower(nickname)...)
+}
@@ -22,6 +22,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryAccountByCardNumber(ctx, req, k)
 		case types.QueryParams:
 			return queryParams(ctx, k)
+		case types.QueryGuardians:
+			return queryGuardians(ctx, req, k)
+		case types.QueryRecovery:
+			return queryRecovery(ctx, req, k)
+		case types.QueryForwarding:
+			return queryForwarding(ctx, req, k)
+		case types.QueryNicknameOffer:
+			return queryNicknameOffer(ctx, req, k)
+		case types.QueryReservedNicknames:
+			return queryReservedNicknames(ctx, k)
+		case types.QueryInvite:
+			return queryInvite(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown profile query endpoint")
 		}
@@ -51,7 +63,7 @@ func queryProfile(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, err
 		profile = &types.Profile{}
 	}
 
-	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResProfile{Profile: *profile})
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResProfile{Profile: profile.Public()})
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -67,6 +79,11 @@ func queryAccountByNickname(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([
 	}
 
 	addr := k.GetProfileAccountByNickname(ctx, params.Nickname)
+	if addr != nil {
+		if p := k.GetProfile(ctx, addr); p != nil && p.Visibility.HideNickname {
+			addr = nil
+		}
+	}
 	if addr == nil {
 		addr = sdk.AccAddress{}
 	}
@@ -87,6 +104,11 @@ func queryAccountByCardNumber(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 	}
 
 	addr := k.GetProfileAccountByCardNumber(ctx, params.CardNumber)
+	if addr != nil {
+		if p := k.GetProfile(ctx, addr); p != nil && p.Visibility.HideCardNumber {
+			addr = nil
+		}
+	}
 	if addr == nil {
 		addr = sdk.AccAddress{}
 	}
@@ -109,3 +131,106 @@ func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+func queryGuardians(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProfileParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	guardians, _ := k.GetGuardians(ctx, params.Address)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResGuardians{Guardians: guardians})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryRecovery(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProfileParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var res types.QueryResRecovery
+	if recovery, found := k.GetRecovery(ctx, params.Address); found {
+		res.Recovery = &recovery
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryForwarding(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProfileParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryResForwarding{Address: k.ResolveForwarding(ctx, params.Address)}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryNicknameOffer(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAccountByNicknameParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var res types.QueryResNicknameOffer
+	if offer, found := k.GetNicknameOffer(ctx, params.Nickname); found {
+		res.Offer = &offer
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryReservedNicknames(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res := types.QueryResReservedNicknames{Nicknames: k.GetReservedNicknames(ctx)}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryInvite(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryInviteParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var res types.QueryResInvite
+	if invite, found := k.GetInvite(ctx, params.Hash); found {
+		res.Invite = &invite
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
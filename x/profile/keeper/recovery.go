@@ -0,0 +1,364 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/arterynetwork/artr/x/profile/types"
+)
+
+const (
+	// AccountMigratedCallback is called when an account is moved to a new address (i.e. recovered), so that other
+	// modules could move their data too.
+	AccountMigratedCallback = "account-migrated"
+)
+
+func (k Keeper) AddHook(eventName string, callback func(ctx sdk.Context, from, to sdk.AccAddress) error) {
+	k.eventHooks[eventName] = append(k.eventHooks[eventName], callback)
+}
+
+// GetGuardians returns the account's guardians (if any)
+func (k Keeper) GetGuardians(ctx sdk.Context, acc sdk.AccAddress) (types.Guardians, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(guardiansKey(acc))
+	if bz == nil {
+		return types.Guardians{}, false
+	}
+	var guardians types.Guardians
+	k.cdc.MustUnmarshalBinaryBare(bz, &guardians)
+	return guardians, true
+}
+
+// SetGuardians replaces the account's guardian list (an empty list removes guardians). A pending recovery, if any,
+// is canceled, because the account owner is obviously able to sign.
+func (k Keeper) SetGuardians(ctx sdk.Context, acc sdk.AccAddress, guardians types.Guardians) error {
+	if err := guardians.Validate(acc); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if k.GetProfile(ctx, acc) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "no profile for %s", acc)
+	}
+
+	if _, found := k.GetRecovery(ctx, acc); found {
+		if err := k.CancelRecovery(ctx, acc); err != nil {
+			return err
+		}
+	}
+	k.setGuardians(ctx, acc, guardians)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGuardiansSet,
+		sdk.NewAttribute(types.AttributeKeyAddress, acc.String()),
+	))
+	return nil
+}
+
+// GetRecovery returns a pending recovery of the account (if any)
+func (k Keeper) GetRecovery(ctx sdk.Context, acc sdk.AccAddress) (types.Recovery, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(recoveryKey(acc))
+	if bz == nil {
+		return types.Recovery{}, false
+	}
+	var recovery types.Recovery
+	k.cdc.MustUnmarshalBinaryBare(bz, &recovery)
+	return recovery, true
+}
+
+// RequestRecovery starts moving the account to a new address. The request is approved by the guardian sending it.
+// A pending request that hasn't got enough approvals within the RecoveryTimeout is replaced.
+func (k Keeper) RequestRecovery(ctx sdk.Context, guardian, acc, newAddr sdk.AccAddress) error {
+	guardians, found := k.GetGuardians(ctx, acc)
+	if !found {
+		return types.ErrNoGuardians
+	}
+	if !guardians.Has(guardian) {
+		return types.ErrNotGuardian
+	}
+	if recovery, found := k.GetRecovery(ctx, acc); found && !recovery.IsExpired(ctx.BlockHeight()) {
+		if !recovery.NewAddress.Equals(newAddr) {
+			return sdkerrors.Wrapf(types.ErrRecoveryPending, "to %s", recovery.NewAddress)
+		}
+		return k.ApproveRecovery(ctx, guardian, acc, newAddr)
+	}
+	if err := k.validateNewAddress(ctx, newAddr); err != nil {
+		return err
+	}
+
+	var expiresAt int64
+	if timeout := k.GetParams(ctx).RecoveryTimeout; timeout != 0 {
+		expiresAt = ctx.BlockHeight() + timeout
+	}
+	recovery := types.NewRecovery(newAddr, expiresAt)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRecoveryRequest,
+		sdk.NewAttribute(types.AttributeKeyAddress, acc.String()),
+		sdk.NewAttribute(types.AttributeKeyNewAddress, newAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyGuardian, guardian.String()),
+	))
+	k.approve(ctx, guardians, guardian, acc, recovery)
+	return nil
+}
+
+// ApproveRecovery adds a guardian's approval to the pending recovery. As soon as there are enough approvals,
+// the recovery delay starts.
+func (k Keeper) ApproveRecovery(ctx sdk.Context, guardian, acc, newAddr sdk.AccAddress) error {
+	guardians, found := k.GetGuardians(ctx, acc)
+	if !found {
+		return types.ErrNoGuardians
+	}
+	if !guardians.Has(guardian) {
+		return types.ErrNotGuardian
+	}
+	recovery, found := k.GetRecovery(ctx, acc)
+	if !found {
+		return types.ErrNoRecovery
+	}
+	if recovery.IsExpired(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrNoRecovery, "the request expired at block %d", recovery.ExpiresAt)
+	}
+	if !recovery.NewAddress.Equals(newAddr) {
+		return sdkerrors.Wrapf(types.ErrRecoveryPending, "to %s", recovery.NewAddress)
+	}
+	if recovery.IsApprovedBy(guardian) {
+		return types.ErrAlreadyApproved
+	}
+
+	k.approve(ctx, guardians, guardian, acc, recovery)
+	return nil
+}
+
+// CancelRecovery drops the account's pending recovery. It's supposed to be called by the account owner.
+func (k Keeper) CancelRecovery(ctx sdk.Context, acc sdk.AccAddress) error {
+	recovery, found := k.GetRecovery(ctx, acc)
+	if !found {
+		return types.ErrNoRecovery
+	}
+	ctx.KVStore(k.storeKey).Delete(recoveryKey(acc))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRecoveryCanceled,
+		sdk.NewAttribute(types.AttributeKeyAddress, acc.String()),
+		sdk.NewAttribute(types.AttributeKeyNewAddress, recovery.NewAddress.String()),
+	))
+	return nil
+}
+
+// CompleteRecovery moves the account to the new address, if the recovery is approved and its delay is over.
+func (k Keeper) CompleteRecovery(ctx sdk.Context, acc sdk.AccAddress) error {
+	recovery, found := k.GetRecovery(ctx, acc)
+	if !found {
+		return types.ErrNoRecovery
+	}
+	if recovery.CompleteAt == 0 {
+		return sdkerrors.Wrap(types.ErrRecoveryNotReady, "not enough approvals")
+	}
+	if ctx.BlockHeight() < recovery.CompleteAt {
+		return sdkerrors.Wrapf(types.ErrRecoveryNotReady, "wait until block %d", recovery.CompleteAt)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(recoveryKey(acc))
+	if err := k.MigrateAccount(ctx, acc, recovery.NewAddress); err != nil {
+		return errors.Wrap(err, "cannot migrate account")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAccountRecovered,
+		sdk.NewAttribute(types.AttributeKeyAddress, acc.String()),
+		sdk.NewAttribute(types.AttributeKeyNewAddress, recovery.NewAddress.String()),
+	))
+	return nil
+}
+
+// MigrateAccount moves an account to a new address: balance (delegated coins inclusive), profile, nickname, card
+// number, guardians and its place in other accounts' guardian lists. Other modules move their data in AccountMigratedCallback hooks. A forwarding record is left
+// at the old address, and a pending recovery of the account (if any) is dropped.
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	profile := k.GetProfile(ctx, from)
+	if profile == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "no profile for %s", from)
+	}
+	if err := k.validateNewAddress(ctx, to); err != nil {
+		return err
+	}
+
+	// Coins are moved directly (bypassing the bank keeper hooks), because the referral record, where they're
+	// accounted, is moved as a whole.
+	fromAcc := k.AccountKeeper.GetAccount(ctx, from)
+	if fromAcc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", from)
+	}
+	toAcc := k.AccountKeeper.GetAccount(ctx, to)
+	if toAcc == nil {
+		toAcc = k.AccountKeeper.NewAccountWithAddress(ctx, to)
+	}
+	if err := toAcc.SetCoins(fromAcc.GetCoins()); err != nil {
+		return errors.Wrap(err, "cannot set new account balance")
+	}
+	if err := fromAcc.SetCoins(sdk.NewCoins()); err != nil {
+		return errors.Wrap(err, "cannot reset old account balance")
+	}
+	k.AccountKeeper.SetAccount(ctx, toAcc)
+	k.AccountKeeper.SetAccount(ctx, fromAcc)
+
+	for _, hook := range k.eventHooks[AccountMigratedCallback] {
+		if err := hook(ctx, from, to); err != nil {
+			return err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(auth.AddressStoreKey(from))
+	store.Set(auth.AddressStoreKey(to), k.cdc.MustMarshalBinaryBare(*profile))
+	if profile.Nickname != "" {
+		k.setProfileAccountByNickname(ctx, profile.Nickname, to)
+		if offer, found := k.GetNicknameOffer(ctx, profile.Nickname); found {
+			offer.Seller = to
+			k.setNicknameOffer(ctx, profile.Nickname, offer)
+		}
+	}
+	if profile.CardNumber != 0 {
+		k.setProfileAccountByCardNumber(ctx, profile.CardNumber, to)
+	}
+	if guardians, found := k.GetGuardians(ctx, from); found {
+		k.setGuardians(ctx, from, types.Guardians{})
+		k.setGuardians(ctx, to, guardians)
+	}
+	k.moveGuardian(ctx, from, to)
+	store.Delete(recoveryKey(from))
+	k.moveInvites(ctx, from, to)
+	k.setForwarding(ctx, from, to)
+	return nil
+}
+
+func (k Keeper) ExportGuardians(ctx sdk.Context) []types.GenesisGuardians {
+	var result []types.GenesisGuardians
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.GuardiansPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var value types.Guardians
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &value)
+		result = append(result, types.GenesisGuardians{
+			Address:   sdk.AccAddress(it.Key()[len(types.GuardiansPrefix):]),
+			Guardians: value,
+		})
+	}
+	return result
+}
+
+func (k Keeper) ExportRecoveries(ctx sdk.Context) []types.GenesisRecovery {
+	var result []types.GenesisRecovery
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.RecoveryPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var value types.Recovery
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &value)
+		result = append(result, types.GenesisRecovery{
+			Address:  sdk.AccAddress(it.Key()[len(types.RecoveryPrefix):]),
+			Recovery: value,
+		})
+	}
+	return result
+}
+
+func (k Keeper) InitGuardians(ctx sdk.Context, data []types.GenesisGuardians) {
+	for _, record := range data {
+		k.setGuardians(ctx, record.Address, record.Guardians)
+	}
+}
+
+func (k Keeper) InitRecoveries(ctx sdk.Context, data []types.GenesisRecovery) {
+	for _, record := range data {
+		k.setRecovery(ctx, record.Address, record.Recovery)
+	}
+}
+
+func (k Keeper) approve(ctx sdk.Context, guardians types.Guardians, guardian, acc sdk.AccAddress, recovery types.Recovery) {
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if recovery.CompleteAt == 0 && len(recovery.Approvals) >= int(guardians.Threshold) {
+		recovery.CompleteAt = ctx.BlockHeight() + k.GetParams(ctx).RecoveryDelay
+	}
+	k.setRecovery(ctx, acc, recovery)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRecoveryApproved,
+		sdk.NewAttribute(types.AttributeKeyAddress, acc.String()),
+		sdk.NewAttribute(types.AttributeKeyNewAddress, recovery.NewAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyGuardian, guardian.String()),
+		sdk.NewAttribute(types.AttributeKeyCompleteAt, fmt.Sprintf("%d", recovery.CompleteAt)),
+	))
+}
+
+// moveGuardian replaces the guardian's address in guardian lists (and approvals of pending recoveries) of the
+// accounts it guards
+func (k Keeper) moveGuardian(ctx sdk.Context, from, to sdk.AccAddress) {
+	var owners []sdk.AccAddress
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), guardedKey(from, nil))
+	for ; it.Valid(); it.Next() {
+		owners = append(owners, sdk.AccAddress(it.Key()[len(types.GuardedPrefix)+len(from):]))
+	}
+	it.Close()
+
+	for _, owner := range owners {
+		guardians, _ := k.GetGuardians(ctx, owner)
+		k.setGuardians(ctx, owner, guardians.Replace(from, to))
+		if recovery, found := k.GetRecovery(ctx, owner); found {
+			k.setRecovery(ctx, owner, recovery.ReplaceApproval(from, to))
+		}
+	}
+}
+
+// validateNewAddress checks that nobody uses the address an account is going to be moved to
+func (k Keeper) validateNewAddress(ctx sdk.Context, addr sdk.AccAddress) error {
+	if k.GetProfile(ctx, addr) != nil {
+		return sdkerrors.Wrapf(types.ErrAddressInUse, "%s has a profile", addr)
+	}
+	if acc := k.AccountKeeper.GetAccount(ctx, addr); acc != nil && !acc.GetCoins().IsZero() {
+		return sdkerrors.Wrapf(types.ErrAddressInUse, "%s has coins", addr)
+	}
+	if _, found := k.GetForwarding(ctx, addr); found {
+		return sdkerrors.Wrapf(types.ErrAddressInUse, "%s has been migrated", addr)
+	}
+	return nil
+}
+
+func (k Keeper) setGuardians(ctx sdk.Context, acc sdk.AccAddress, guardians types.Guardians) {
+	store := ctx.KVStore(k.storeKey)
+	if old, found := k.GetGuardians(ctx, acc); found {
+		for _, guardian := range old.Accounts {
+			store.Delete(guardedKey(guardian, acc))
+		}
+	}
+	if len(guardians.Accounts) == 0 {
+		store.Delete(guardiansKey(acc))
+	} else {
+		store.Set(guardiansKey(acc), k.cdc.MustMarshalBinaryBare(guardians))
+		for _, guardian := range guardians.Accounts {
+			store.Set(guardedKey(guardian, acc), []byte{0x01})
+		}
+	}
+}
+
+func (k Keeper) setRecovery(ctx sdk.Context, acc sdk.AccAddress, recovery types.Recovery) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(recoveryKey(acc), k.cdc.MustMarshalBinaryBare(recovery))
+}
+
+func guardiansKey(acc sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.GuardiansPrefix...), acc...)
+}
+
+func guardedKey(guardian, owner sdk.AccAddress) []byte {
+	return append(append(append([]byte(nil), types.GuardedPrefix...), guardian...), owner...)
+}
+
+func recoveryKey(acc sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.RecoveryPrefix...), acc...)
+}
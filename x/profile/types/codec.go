@@ -11,6 +11,19 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetCardNumber{}, "profile/SetCardNumber", nil)
 	cdc.RegisterConcrete(MsgCreateAccount{}, "profile/CreateAccount", nil)
 	cdc.RegisterConcrete(MsgCreateAccountWithProfile{}, "profile/CreateAccountWithProfile", nil)
+	cdc.RegisterConcrete(MsgSetGuardians{}, "profile/SetGuardians", nil)
+	cdc.RegisterConcrete(MsgRequestRecovery{}, "profile/RequestRecovery", nil)
+	cdc.RegisterConcrete(MsgApproveRecovery{}, "profile/ApproveRecovery", nil)
+	cdc.RegisterConcrete(MsgCancelRecovery{}, "profile/CancelRecovery", nil)
+	cdc.RegisterConcrete(MsgCompleteRecovery{}, "profile/CompleteRecovery", nil)
+	cdc.RegisterConcrete(MsgMigrateAccount{}, "profile/MigrateAccount", nil)
+	cdc.RegisterConcrete(MsgTransferNickname{}, "profile/TransferNickname", nil)
+	cdc.RegisterConcrete(MsgOfferNickname{}, "profile/OfferNickname", nil)
+	cdc.RegisterConcrete(MsgCancelNicknameOffer{}, "profile/CancelNicknameOffer", nil)
+	cdc.RegisterConcrete(MsgBuyNickname{}, "profile/BuyNickname", nil)
+	cdc.RegisterConcrete(MsgCreateInvite{}, "profile/CreateInvite", nil)
+	cdc.RegisterConcrete(MsgCancelInvite{}, "profile/CancelInvite", nil)
+	cdc.RegisterConcrete(MsgRedeemInvite{}, "profile/RedeemInvite", nil)
 }
 
 // ModuleCdc defines the module codec
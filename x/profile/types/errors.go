@@ -5,4 +5,19 @@ import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 var (
 	ErrNicknamePrefix       = sdkerrors.Register(ModuleName, 1, "nickname cannot start with 'ARTR-' prefix")
 	ErrNicknameAlreadyInUse = sdkerrors.Register(ModuleName, 2, "nickname is already in use")
+	ErrNoGuardians          = sdkerrors.Register(ModuleName, 3, "account has no guardians")
+	ErrNotGuardian          = sdkerrors.Register(ModuleName, 4, "not a guardian of the account")
+	ErrRecoveryPending      = sdkerrors.Register(ModuleName, 5, "another recovery is pending")
+	ErrNoRecovery           = sdkerrors.Register(ModuleName, 6, "no recovery is pending")
+	ErrAlreadyApproved      = sdkerrors.Register(ModuleName, 7, "recovery is already approved by the guardian")
+	ErrRecoveryNotReady     = sdkerrors.Register(ModuleName, 8, "recovery cannot be completed yet")
+	ErrAddressInUse         = sdkerrors.Register(ModuleName, 9, "new address is already in use")
+	ErrNicknameReserved     = sdkerrors.Register(ModuleName, 10, "nickname is reserved")
+	ErrNoNickname           = sdkerrors.Register(ModuleName, 11, "account has no nickname")
+	ErrHasNickname          = sdkerrors.Register(ModuleName, 12, "account already has a nickname")
+	ErrNicknameOnSale       = sdkerrors.Register(ModuleName, 13, "nickname is on sale")
+	ErrNoNicknameOffer      = sdkerrors.Register(ModuleName, 14, "nickname is not on sale")
+	ErrInviteExists         = sdkerrors.Register(ModuleName, 15, "invite with the same key already exists")
+	ErrNoInvite             = sdkerrors.Register(ModuleName, 16, "invite not found")
+	ErrInviteExpired        = sdkerrors.Register(ModuleName, 17, "invite is expired")
 )
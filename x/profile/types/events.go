@@ -2,5 +2,36 @@ package types
 
 // profile module event types
 const (
+	EventTypeGuardiansSet     = "guardians_set"
+	EventTypeRecoveryRequest  = "recovery_requested"
+	EventTypeRecoveryApproved = "recovery_approved"
+	EventTypeRecoveryCanceled = "recovery_canceled"
+	EventTypeAccountRecovered = "account_recovered"
+	EventTypeAccountMigrated  = "account_migrated"
+
+	EventTypeNicknameTransferred   = "nickname_transferred"
+	EventTypeNicknameOffered       = "nickname_offered"
+	EventTypeNicknameOfferCanceled = "nickname_offer_canceled"
+	EventTypeNicknameSold          = "nickname_sold"
+	EventTypeNicknameReserved      = "nickname_reserved"
+	EventTypeNicknameReleased      = "nickname_released"
+	EventTypeNicknameReclaimed     = "nickname_reclaimed"
+
+	EventTypeInviteCreated  = "invite_created"
+	EventTypeInviteCanceled = "invite_canceled"
+	EventTypeInviteRedeemed = "invite_redeemed"
+
+	AttributeKeyAddress    = "address"
+	AttributeKeyNewAddress = "new_address"
+	AttributeKeyGuardian   = "guardian"
+	AttributeKeyCompleteAt = "complete_at"
+	AttributeKeyNickname   = "nickname"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeySeller     = "seller"
+	AttributeKeyBuyer      = "buyer"
+	AttributeKeyPrice      = "price"
+	AttributeKeyInvite     = "invite"
+	AttributeKeyReferrer   = "referrer"
+
 	AttributeValueCategory = ModuleName
 )
@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type GenesisProfile struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
@@ -9,15 +14,28 @@ type GenesisProfile struct {
 
 // GenesisState - all profile state that must be provided at genesis
 type GenesisState struct {
-	ProfileRecords []GenesisProfile `json:"profiles" yaml:"profiles"`
-	Params         Params           `json:"params" yaml:"params"`
+	ProfileRecords []GenesisProfile    `json:"profiles" yaml:"profiles"`
+	Params         Params              `json:"params" yaml:"params"`
+	Guardians      []GenesisGuardians  `json:"guardians,omitempty" yaml:"guardians,omitempty"`
+	Recoveries     []GenesisRecovery   `json:"recoveries,omitempty" yaml:"recoveries,omitempty"`
+	Forwardings    []GenesisForwarding `json:"forwardings,omitempty" yaml:"forwardings,omitempty"`
+	// Reserved nicknames (controlled by voting)
+	Reserved []string               `json:"reserved,omitempty" yaml:"reserved,omitempty"`
+	Offers   []GenesisNicknameOffer `json:"offers,omitempty" yaml:"offers,omitempty"`
+	Invites  []GenesisInvite        `json:"invites,omitempty" yaml:"invites,omitempty"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, profiles []GenesisProfile) GenesisState {
+func NewGenesisState(params Params, profiles []GenesisProfile, guardians []GenesisGuardians, recoveries []GenesisRecovery, forwardings []GenesisForwarding, reserved []string, offers []GenesisNicknameOffer, invites []GenesisInvite) GenesisState {
 	return GenesisState{
 		ProfileRecords: profiles,
 		Params:         params,
+		Guardians:      guardians,
+		Recoveries:     recoveries,
+		Forwardings:    forwardings,
+		Reserved:       reserved,
+		Offers:         offers,
+		Invites:        invites,
 	}
 }
 
@@ -32,5 +50,43 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	for _, record := range data.ProfileRecords {
+		if err := record.Profile.Validate(); err != nil {
+			return fmt.Errorf("invalid profile of %s: %w", record.Address, err)
+		}
+	}
+	for _, record := range data.Guardians {
+		if err := record.Guardians.Validate(record.Address); err != nil {
+			return fmt.Errorf("invalid guardians of %s: %w", record.Address, err)
+		}
+	}
+	for _, record := range data.Recoveries {
+		if record.Recovery.NewAddress.Empty() {
+			return fmt.Errorf("recovery of %s has no new address", record.Address)
+		}
+	}
+	for _, record := range data.Forwardings {
+		if record.NewAddress.Empty() || record.Address.Equals(record.NewAddress) {
+			return fmt.Errorf("invalid forwarding of %s: %s", record.Address, record.NewAddress)
+		}
+	}
+	for _, nickname := range data.Reserved {
+		if nickname == "" {
+			return fmt.Errorf("empty reserved nickname")
+		}
+	}
+	for _, record := range data.Offers {
+		if err := record.Offer.Validate(); err != nil {
+			return fmt.Errorf("invalid offer of %s: %w", record.Nickname, err)
+		}
+	}
+	for _, record := range data.Invites {
+		if len(record.Hash) != sha256.Size {
+			return fmt.Errorf("invalid invite hash %s", record.Hash)
+		}
+		if err := record.Invite.Validate(); err != nil {
+			return fmt.Errorf("invalid invite %s: %w", record.Hash, err)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Invite lets anybody who holds its private key create an account under the referrer. Only a hash of the invite public
+// key is stored.
+type Invite struct {
+	Referrer sdk.AccAddress `json:"referrer" yaml:"referrer"`
+	// MaxUses is how many accounts can be created with the invite
+	MaxUses uint32 `json:"max_uses" yaml:"max_uses"`
+	// Used is how many accounts have been created with the invite so far
+	Used uint32 `json:"used,omitempty" yaml:"used,omitempty"`
+	// ExpiresAt is a block height the invite cannot be used since (0 means never)
+	ExpiresAt int64 `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+	// Prepaid means the referrer pays the first month of a new account subscription
+	Prepaid bool `json:"prepaid,omitempty" yaml:"prepaid,omitempty"`
+}
+
+func NewInvite(referrer sdk.AccAddress, maxUses uint32, expiresAt int64, prepaid bool) Invite {
+	return Invite{
+		Referrer:  referrer,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+		Prepaid:   prepaid,
+	}
+}
+
+// IsExpired checks if the invite cannot be used at the height anymore
+func (i Invite) IsExpired(height int64) bool {
+	return i.ExpiresAt != 0 && height >= i.ExpiresAt
+}
+
+func (i Invite) Validate() error {
+	if i.Referrer.Empty() {
+		return fmt.Errorf("referrer is missing")
+	}
+	if i.MaxUses == 0 {
+		return fmt.Errorf("max uses must be positive")
+	}
+	if i.Used >= i.MaxUses {
+		return fmt.Errorf("invite is used up (%d/%d)", i.Used, i.MaxUses)
+	}
+	if i.ExpiresAt < 0 {
+		return fmt.Errorf("expiration height must be non-negative")
+	}
+	return nil
+}
+
+func (i Invite) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("Referrer: %s\nUsed: %d/%d", i.Referrer, i.Used, i.MaxUses))
+	if i.ExpiresAt != 0 {
+		sb.WriteString(fmt.Sprintf("\nExpires at: %d", i.ExpiresAt))
+	}
+	if i.Prepaid {
+		sb.WriteString("\nPrepaid: true")
+	}
+	return sb.String()
+}
+
+// MinInviteCodeLength is the least length of an invite code, it's the length of a code made by NewInviteCode
+const MinInviteCodeLength = 22
+
+// NewInviteCode generates a random invite code carrying 128 bits of entropy
+func NewInviteCode() (string, error) {
+	bz := make([]byte, 16)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bz), nil
+}
+
+// ValidateInviteCode checks that the code is long enough to withstand brute force
+func ValidateInviteCode(code string) error {
+	if len(code) < MinInviteCodeLength {
+		return fmt.Errorf("invite code must be at least %d characters long", MinInviteCodeLength)
+	}
+	return nil
+}
+
+// InviteKey derives an invite private key from a code the referrer shares with newcomers. The code itself never
+// gets on-chain.
+//
+// The derivation is a single hash, and the invite hash is public, so anybody can try codes offline at a very high
+// rate: a short or guessable code will be found and redeemed by a stranger. Use codes made by NewInviteCode (or at
+// least as strong, see ValidateInviteCode).
+func InviteKey(code string) crypto.PrivKey {
+	return secp256k1.GenPrivKeySecp256k1([]byte(code))
+}
+
+// InviteHash returns a hash of the invite public key, which is stored on-chain instead of the key itself
+func InviteHash(pubKey crypto.PubKey) tmbytes.HexBytes {
+	hash := sha256.Sum256(pubKey.Bytes())
+	return hash[:]
+}
+
+// InviteStatement is what the invite key signs to let a new account be created with the invite. It binds the invite
+// to the new account and its profile, so that a redeem transaction cannot be front-run with other ones.
+type InviteStatement struct {
+	ChainID    string         `json:"chain_id" yaml:"chain_id"`
+	NewAccount sdk.AccAddress `json:"new_account" yaml:"new_account"`
+	Profile    Profile        `json:"profile" yaml:"profile"`
+}
+
+// InviteSignBytes returns bytes to be signed by the invite key to redeem the invite
+func InviteSignBytes(chainID string, newAccount sdk.AccAddress, profile Profile) []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(InviteStatement{
+		ChainID:    chainID,
+		NewAccount: newAccount,
+		Profile:    profile,
+	}))
+}
+
+type GenesisInvite struct {
+	Hash   tmbytes.HexBytes `json:"hash" yaml:"hash"`
+	Invite Invite           `json:"invite" yaml:"invite"`
+}
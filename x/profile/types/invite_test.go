@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInviteCode(t *testing.T) {
+	code1, err := NewInviteCode()
+	require.NoError(t, err)
+	code2, err := NewInviteCode()
+	require.NoError(t, err)
+
+	require.Len(t, code1, MinInviteCodeLength)
+	require.NotEqual(t, code1, code2)
+	require.NoError(t, ValidateInviteCode(code1))
+}
+
+func TestValidateInviteCode(t *testing.T) {
+	require.Error(t, ValidateInviteCode(""))
+	require.Error(t, ValidateInviteCode("welcome"))
+	require.NoError(t, ValidateInviteCode("correct-horse-battery-staple"))
+}
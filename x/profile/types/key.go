@@ -21,3 +21,15 @@ const (
 
 	MainDenom = "uartr"
 )
+
+// Profile store key prefixes (profiles themselves are stored under auth.AddressStoreKey)
+var (
+	GuardiansPrefix  = []byte{0x02}
+	RecoveryPrefix   = []byte{0x03}
+	ForwardingPrefix = []byte{0x04}
+	ReservedPrefix   = []byte{0x05}
+	OfferPrefix      = []byte{0x06}
+	InvitePrefix     = []byte{0x07}
+	// GuardedPrefix indexes guardian lists by guardian (the key is the prefix, guardian address and owner address)
+	GuardedPrefix = []byte{0x08}
+)
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrationStatement is what the new key signs to prove its owner agrees to take the account over
+type MigrationStatement struct {
+	ChainID    string         `json:"chain_id" yaml:"chain_id"`
+	Address    sdk.AccAddress `json:"address" yaml:"address"`
+	NewAddress sdk.AccAddress `json:"new_address" yaml:"new_address"`
+}
+
+// MigrationSignBytes returns bytes to be signed by the new key of an account being migrated
+func MigrationSignBytes(chainID string, addr, newAddr sdk.AccAddress) []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(MigrationStatement{
+		ChainID:    chainID,
+		Address:    addr,
+		NewAddress: newAddr,
+	}))
+}
+
+// GenesisForwarding is a record left by an account moved to a new address
+type GenesisForwarding struct {
+	Address    sdk.AccAddress `json:"address" yaml:"address"`
+	NewAddress sdk.AccAddress `json:"new_address" yaml:"new_address"`
+}
@@ -1,6 +1,11 @@
 package types
 
 import (
+	"crypto/sha256"
+
+	"github.com/tendermint/tendermint/crypto"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -31,6 +36,10 @@ func (msg MsgSetProfile) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Address.String())
 	}
 
+	if err := msg.Profile.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -152,6 +161,10 @@ func (msg MsgCreateAccountWithProfile) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.NewAccount.String())
 	}
 
+	if err := msg.Profile.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -207,3 +220,600 @@ func (msg MsgCreateAccount) GetSignBytes() []byte {
 func (msg MsgCreateAccount) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Address}
 }
+
+type MsgSetGuardians struct {
+	Address   sdk.AccAddress   `json:"address" yaml:"address"`
+	Guardians []sdk.AccAddress `json:"guardians" yaml:"guardians"`
+	Threshold uint32           `json:"threshold" yaml:"threshold"`
+}
+
+func NewMsgSetGuardians(addr sdk.AccAddress, guardians []sdk.AccAddress, threshold uint32) MsgSetGuardians {
+	return MsgSetGuardians{
+		Address:   addr,
+		Guardians: guardians,
+		Threshold: threshold,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetGuardians) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetGuardians) Type() string { return "set_guardians" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetGuardians) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Address.String())
+	}
+
+	if err := NewGuardians(msg.Guardians, msg.Threshold).Validate(msg.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetGuardians) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetGuardians) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// Start an account recovery (a guardian's approval inclusive)
+type MsgRequestRecovery struct {
+	Guardian   sdk.AccAddress `json:"guardian" yaml:"guardian"`
+	Account    sdk.AccAddress `json:"account" yaml:"account"`
+	NewAddress sdk.AccAddress `json:"new_address" yaml:"new_address"`
+}
+
+func NewMsgRequestRecovery(guardian sdk.AccAddress, account sdk.AccAddress, newAddress sdk.AccAddress) MsgRequestRecovery {
+	return MsgRequestRecovery{
+		Guardian:   guardian,
+		Account:    account,
+		NewAddress: newAddress,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRequestRecovery) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRequestRecovery) Type() string { return "request_recovery" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRequestRecovery) ValidateBasic() error {
+	return validateRecoveryMsg(msg.Guardian, msg.Account, msg.NewAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRequestRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRequestRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Guardian}
+}
+
+type MsgApproveRecovery struct {
+	Guardian   sdk.AccAddress `json:"guardian" yaml:"guardian"`
+	Account    sdk.AccAddress `json:"account" yaml:"account"`
+	NewAddress sdk.AccAddress `json:"new_address" yaml:"new_address"`
+}
+
+func NewMsgApproveRecovery(guardian sdk.AccAddress, account sdk.AccAddress, newAddress sdk.AccAddress) MsgApproveRecovery {
+	return MsgApproveRecovery{
+		Guardian:   guardian,
+		Account:    account,
+		NewAddress: newAddress,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgApproveRecovery) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgApproveRecovery) Type() string { return "approve_recovery" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgApproveRecovery) ValidateBasic() error {
+	return validateRecoveryMsg(msg.Guardian, msg.Account, msg.NewAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgApproveRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgApproveRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Guardian}
+}
+
+// Cancel a pending recovery (by the account owner)
+type MsgCancelRecovery struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewMsgCancelRecovery(addr sdk.AccAddress) MsgCancelRecovery {
+	return MsgCancelRecovery{Address: addr}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelRecovery) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelRecovery) Type() string { return "cancel_recovery" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelRecovery) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Address.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// Complete an approved recovery after the delay. Anyone can send it, because the new address has no funds to pay
+// the fee (and usually doesn't exist on chain yet).
+type MsgCompleteRecovery struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Account sdk.AccAddress `json:"account" yaml:"account"`
+}
+
+func NewMsgCompleteRecovery(sender sdk.AccAddress, account sdk.AccAddress) MsgCompleteRecovery {
+	return MsgCompleteRecovery{
+		Sender:  sender,
+		Account: account,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCompleteRecovery) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCompleteRecovery) Type() string { return "complete_recovery" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCompleteRecovery) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+
+	if msg.Account.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Account.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCompleteRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCompleteRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// Move an account to a new address. The account owner signs the transaction, and the new key owner signs
+// the MigrationSignBytes statement (the new key cannot sign the transaction itself, because the new account
+// doesn't exist on chain yet).
+type MsgMigrateAccount struct {
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+	NewPubKey    crypto.PubKey  `json:"new_pub_key" yaml:"new_pub_key"`
+	NewSignature []byte         `json:"new_signature" yaml:"new_signature"`
+}
+
+func NewMsgMigrateAccount(addr sdk.AccAddress, newPubKey crypto.PubKey, newSignature []byte) MsgMigrateAccount {
+	return MsgMigrateAccount{
+		Address:      addr,
+		NewPubKey:    newPubKey,
+		NewSignature: newSignature,
+	}
+}
+
+// NewAddress returns the address the account is moved to
+func (msg MsgMigrateAccount) NewAddress() sdk.AccAddress {
+	return sdk.AccAddress(msg.NewPubKey.Address())
+}
+
+// Route should return the name of the module
+func (msg MsgMigrateAccount) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMigrateAccount) Type() string { return "migrate_account" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMigrateAccount) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Address.String())
+	}
+
+	if msg.NewPubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "new public key is missing")
+	}
+
+	if len(msg.NewSignature) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "new key signature is missing")
+	}
+
+	if msg.Address.Equals(msg.NewAddress()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new address must differ from the account one")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMigrateAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMigrateAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// Give your nickname to another account (having no nickname)
+type MsgTransferNickname struct {
+	Address   sdk.AccAddress `json:"address" yaml:"address"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+func NewMsgTransferNickname(addr sdk.AccAddress, recipient sdk.AccAddress) MsgTransferNickname {
+	return MsgTransferNickname{
+		Address:   addr,
+		Recipient: recipient,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferNickname) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferNickname) Type() string { return "transfer_nickname" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferNickname) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Address.String())
+	}
+
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+
+	if msg.Address.Equals(msg.Recipient) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "recipient must differ from the sender")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTransferNickname) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransferNickname) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// Put your nickname on sale (replacing the previous offer, if any)
+type MsgOfferNickname struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	// Price (in uARTR)
+	Price uint64 `json:"price" yaml:"price"`
+	// Buyer is the only account allowed to buy the nickname (optional)
+	Buyer sdk.AccAddress `json:"buyer,omitempty" yaml:"buyer,omitempty"`
+}
+
+func NewMsgOfferNickname(addr sdk.AccAddress, price uint64, buyer sdk.AccAddress) MsgOfferNickname {
+	return MsgOfferNickname{
+		Address: addr,
+		Price:   price,
+		Buyer:   buyer,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgOfferNickname) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgOfferNickname) Type() string { return "offer_nickname" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgOfferNickname) ValidateBasic() error {
+	if err := NewNicknameOffer(msg.Address, msg.Price, msg.Buyer).Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgOfferNickname) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgOfferNickname) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// Withdraw your nickname from sale
+type MsgCancelNicknameOffer struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewMsgCancelNicknameOffer(addr sdk.AccAddress) MsgCancelNicknameOffer {
+	return MsgCancelNicknameOffer{Address: addr}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelNicknameOffer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelNicknameOffer) Type() string { return "cancel_nickname_offer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelNicknameOffer) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Address.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelNicknameOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelNicknameOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// Buy a nickname on sale. The buyer's current nickname (if any) is dropped.
+type MsgBuyNickname struct {
+	Buyer    sdk.AccAddress `json:"buyer" yaml:"buyer"`
+	Nickname string         `json:"nickname" yaml:"nickname"`
+	// MaxPrice protects the buyer from the seller raising the price meanwhile
+	MaxPrice uint64 `json:"max_price" yaml:"max_price"`
+}
+
+func NewMsgBuyNickname(buyer sdk.AccAddress, nickname string, maxPrice uint64) MsgBuyNickname {
+	return MsgBuyNickname{
+		Buyer:    buyer,
+		Nickname: nickname,
+		MaxPrice: maxPrice,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgBuyNickname) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBuyNickname) Type() string { return "buy_nickname" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBuyNickname) ValidateBasic() error {
+	if msg.Buyer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Buyer.String())
+	}
+
+	if msg.Nickname == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nickname is missing")
+	}
+
+	if msg.MaxPrice == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max price must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBuyNickname) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBuyNickname) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Buyer}
+}
+
+// Publish an invite code, so that newcomers could create accounts under you
+type MsgCreateInvite struct {
+	Referrer sdk.AccAddress `json:"referrer" yaml:"referrer"`
+	// Hash is a SHA-256 hash of the invite public key (see InviteHash)
+	Hash    tmbytes.HexBytes `json:"hash" yaml:"hash"`
+	MaxUses uint32           `json:"max_uses" yaml:"max_uses"`
+	// ExpiresAt is a block height the invite cannot be used since (optional)
+	ExpiresAt int64 `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+	// Prepaid means the referrer pays the first month of a new account subscription
+	Prepaid bool `json:"prepaid,omitempty" yaml:"prepaid,omitempty"`
+}
+
+func NewMsgCreateInvite(referrer sdk.AccAddress, hash tmbytes.HexBytes, maxUses uint32, expiresAt int64, prepaid bool) MsgCreateInvite {
+	return MsgCreateInvite{
+		Referrer:  referrer,
+		Hash:      hash,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+		Prepaid:   prepaid,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateInvite) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateInvite) Type() string { return "create_invite" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateInvite) ValidateBasic() error {
+	if len(msg.Hash) != sha256.Size {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid hash length %d", len(msg.Hash))
+	}
+
+	if err := NewInvite(msg.Referrer, msg.MaxUses, msg.ExpiresAt, msg.Prepaid).Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateInvite) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateInvite) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Referrer}
+}
+
+// Withdraw your invite, so that its code cannot be used anymore
+type MsgCancelInvite struct {
+	Referrer sdk.AccAddress   `json:"referrer" yaml:"referrer"`
+	Hash     tmbytes.HexBytes `json:"hash" yaml:"hash"`
+}
+
+func NewMsgCancelInvite(referrer sdk.AccAddress, hash tmbytes.HexBytes) MsgCancelInvite {
+	return MsgCancelInvite{
+		Referrer: referrer,
+		Hash:     hash,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelInvite) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelInvite) Type() string { return "cancel_invite" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelInvite) ValidateBasic() error {
+	if msg.Referrer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Referrer.String())
+	}
+
+	if len(msg.Hash) != sha256.Size {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid hash length %d", len(msg.Hash))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelInvite) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelInvite) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Referrer}
+}
+
+// Create a new account under the invite referrer. A new account cannot sign a transaction until it exists, so the
+// message is signed (and its fee is paid) by a sender, who can be anybody. The invite key signs the InviteSignBytes
+// statement, so that the invite private key itself is never disclosed. An account creation fee is paid by the
+// referrer.
+type MsgRedeemInvite struct {
+	Sender       sdk.AccAddress `json:"sender" yaml:"sender"`
+	InvitePubKey crypto.PubKey  `json:"invite_pub_key" yaml:"invite_pub_key"`
+	Signature    []byte         `json:"signature" yaml:"signature"`
+	NewAccount   sdk.AccAddress `json:"new_account" yaml:"new_account"`
+	Profile      Profile        `json:"profile" yaml:"profile"`
+}
+
+func NewMsgRedeemInvite(sender sdk.AccAddress, invitePubKey crypto.PubKey, signature []byte, newAccount sdk.AccAddress, profile Profile) MsgRedeemInvite {
+	return MsgRedeemInvite{
+		Sender:       sender,
+		InvitePubKey: invitePubKey,
+		Signature:    signature,
+		NewAccount:   newAccount,
+		Profile:      profile,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRedeemInvite) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRedeemInvite) Type() string { return "redeem_invite" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRedeemInvite) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+
+	if msg.InvitePubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "invite public key is missing")
+	}
+
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invite key signature is missing")
+	}
+
+	if msg.NewAccount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.NewAccount.String())
+	}
+
+	if err := msg.Profile.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRedeemInvite) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRedeemInvite) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func validateRecoveryMsg(guardian, account, newAddress sdk.AccAddress) error {
+	if guardian.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, guardian.String())
+	}
+
+	if account.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, account.String())
+	}
+
+	if newAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, newAddress.String())
+	}
+
+	if account.Equals(newAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new address must differ from the account one")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NicknameOffer is a nickname sale offer. While the offer is active, the nickname is held in escrow, i.e.
+// the seller cannot drop, change or transfer it.
+type NicknameOffer struct {
+	Seller sdk.AccAddress `json:"seller" yaml:"seller"`
+	// Price (in uARTR)
+	Price uint64 `json:"price" yaml:"price"`
+	// Buyer is the only account allowed to buy the nickname (if set)
+	Buyer sdk.AccAddress `json:"buyer,omitempty" yaml:"buyer,omitempty"`
+}
+
+func NewNicknameOffer(seller sdk.AccAddress, price uint64, buyer sdk.AccAddress) NicknameOffer {
+	return NicknameOffer{
+		Seller: seller,
+		Price:  price,
+		Buyer:  buyer,
+	}
+}
+
+// IsFor checks if the account is allowed to accept the offer
+func (o NicknameOffer) IsFor(acc sdk.AccAddress) bool {
+	return o.Buyer.Empty() || o.Buyer.Equals(acc)
+}
+
+func (o NicknameOffer) Validate() error {
+	if o.Seller.Empty() {
+		return fmt.Errorf("seller is missing")
+	}
+	if o.Price == 0 {
+		return fmt.Errorf("price must be positive")
+	}
+	if o.Seller.Equals(o.Buyer) {
+		return fmt.Errorf("seller cannot buy its own nickname")
+	}
+	return nil
+}
+
+func (o NicknameOffer) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("Seller: %s\nPrice: %d", o.Seller, o.Price))
+	if !o.Buyer.Empty() {
+		sb.WriteString(fmt.Sprintf("\nBuyer: %s", o.Buyer))
+	}
+	return sb.String()
+}
+
+type GenesisNicknameOffer struct {
+	Nickname string        `json:"nickname" yaml:"nickname"`
+	Offer    NicknameOffer `json:"offer" yaml:"offer"`
+}
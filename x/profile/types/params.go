@@ -4,6 +4,8 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/arterynetwork/artr/util"
 )
 
 // Default parameter namespace
@@ -12,15 +14,20 @@ const (
 
 	DefaultFee       int64  = 1000000
 	DefaultCardMagic uint64 = 0x1A21A4B61B
+
+	DefaultRecoveryDelay   int64 = util.BlocksOneWeek
+	DefaultRecoveryTimeout int64 = util.BlocksOneWeek
 )
 
 // Parameter store keys
 var (
 	DefaultCreators []sdk.AccAddress = nil
 
-	KeyCreators  = []byte("Creators")
-	KeyFee       = []byte("Fee")
-	KeyCardMagic = []byte("CardMagic")
+	KeyCreators        = []byte("Creators")
+	KeyFee             = []byte("Fee")
+	KeyCardMagic       = []byte("CardMagic")
+	KeyRecoveryDelay   = []byte("RecoveryDelay")
+	KeyRecoveryTimeout = []byte("RecoveryTimeout")
 )
 
 // ParamKeyTable for profile module
@@ -33,14 +40,22 @@ type Params struct {
 	Creators  []sdk.AccAddress `json:"creators" yaml:"creators"`
 	Fee       int64            `json:"fee" yaml:"fee"`
 	CardMagic uint64           `json:"card_magic" yaml:"card_magic"`
+	// RecoveryDelay is how many blocks should pass after guardians approve an account recovery before it can be
+	// completed. The account owner can cancel the recovery during this time.
+	RecoveryDelay int64 `json:"recovery_delay,omitempty" yaml:"recovery_delay"`
+	// RecoveryTimeout is how many blocks guardians have to approve an account recovery. After that the request can be
+	// replaced with another one, so that a single guardian couldn't block the recovery. Zero means no timeout.
+	RecoveryTimeout int64 `json:"recovery_timeout,omitempty" yaml:"recovery_timeout"`
 }
 
 // NewParams creates a new Params object
-func NewParams(creators []sdk.AccAddress, fee int64, cardMagic uint64) Params {
+func NewParams(creators []sdk.AccAddress, fee int64, cardMagic uint64, recoveryDelay, recoveryTimeout int64) Params {
 	return Params{
-		Creators:  creators,
-		Fee:       fee,
-		CardMagic: cardMagic,
+		Creators:        creators,
+		Fee:             fee,
+		CardMagic:       cardMagic,
+		RecoveryDelay:   recoveryDelay,
+		RecoveryTimeout: recoveryTimeout,
 	}
 }
 
@@ -50,7 +65,9 @@ func (p Params) String() string {
 Creators: %s
 Fee: %d
 CardMagic: %d
-`, p.Creators, p.Fee, p.CardMagic)
+RecoveryDelay: %d
+RecoveryTimeout: %d
+`, p.Creators, p.Fee, p.CardMagic, p.RecoveryDelay, p.RecoveryTimeout)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -59,6 +76,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyCreators, &p.Creators, validateCreators),
 		params.NewParamSetPair(KeyFee, &p.Fee, validateFee),
 		params.NewParamSetPair(KeyCardMagic, &p.CardMagic, validateCardMagic),
+		params.NewParamSetPair(KeyRecoveryDelay, &p.RecoveryDelay, validateRecoveryDelay),
+		params.NewParamSetPair(KeyRecoveryTimeout, &p.RecoveryTimeout, validateRecoveryTimeout),
 	}
 }
 
@@ -68,6 +87,8 @@ func DefaultParams() Params {
 		DefaultCreators,
 		DefaultFee,
 		DefaultCardMagic,
+		DefaultRecoveryDelay,
+		DefaultRecoveryTimeout,
 	)
 }
 
@@ -81,6 +102,12 @@ func (p Params) Validate() error {
 	if err := validateCardMagic(p.CardMagic); err != nil {
 		return err
 	}
+	if err := validateRecoveryDelay(p.RecoveryDelay); err != nil {
+		return err
+	}
+	if err := validateRecoveryTimeout(p.RecoveryTimeout); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -115,3 +142,29 @@ func validateCardMagic(i interface{}) error {
 
 	return nil
 }
+
+func validateRecoveryDelay(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid RecoveryDelay parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("RecoveryDelay must be non-negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateRecoveryTimeout(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid RecoveryTimeout parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("RecoveryTimeout must be non-negative: %d", v)
+	}
+
+	return nil
+}
@@ -2,6 +2,10 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,6 +16,12 @@ const (
 	QueryAccountAddressByCardNumber = "query_account_address_by_card_number"
 	QueryCreators                   = "query_creators"
 	QueryParams                     = "params"
+	QueryGuardians                  = "guardians"
+	QueryRecovery                   = "recovery"
+	QueryForwarding                 = "forwarding"
+	QueryNicknameOffer              = "nickname_offer"
+	QueryReservedNicknames          = "reserved_nicknames"
+	QueryInvite                     = "invite"
 )
 
 type QueryResProfile struct {
@@ -77,3 +87,72 @@ func (q QueryCreatorsRes) String() string {
 func NewQueryCreatorsRes(creators []sdk.AccAddress) QueryCreatorsRes {
 	return QueryCreatorsRes{Creators: creators}
 }
+
+type QueryResGuardians struct {
+	Guardians Guardians `json:"guardians" yaml:"guardians"`
+}
+
+func (q QueryResGuardians) String() string {
+	return q.Guardians.String()
+}
+
+type QueryResRecovery struct {
+	// Recovery is nil if there is no recovery pending
+	Recovery *Recovery `json:"recovery" yaml:"recovery"`
+}
+
+func (q QueryResRecovery) String() string {
+	if q.Recovery == nil {
+		return "no recovery pending"
+	}
+	return q.Recovery.String()
+}
+
+type QueryResForwarding struct {
+	// Address is the account's current address (the queried one, if it hasn't been moved)
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func (q QueryResForwarding) String() string {
+	return q.Address.String()
+}
+
+type QueryResNicknameOffer struct {
+	// Offer is nil if the nickname is not on sale
+	Offer *NicknameOffer `json:"offer" yaml:"offer"`
+}
+
+func (q QueryResNicknameOffer) String() string {
+	if q.Offer == nil {
+		return "not on sale"
+	}
+	return q.Offer.String()
+}
+
+type QueryResReservedNicknames struct {
+	Nicknames []string `json:"nicknames" yaml:"nicknames"`
+}
+
+func (q QueryResReservedNicknames) String() string {
+	return strings.Join(q.Nicknames, "\n")
+}
+
+type QueryInviteParams struct {
+	Hash tmbytes.HexBytes `json:"hash" yaml:"hash"`
+}
+
+func NewQueryInviteParams(hash tmbytes.HexBytes) QueryInviteParams {
+	return QueryInviteParams{Hash: hash}
+}
+
+type QueryResInvite struct {
+	// Invite is nil if there is no such invite (or it's used up)
+	Invite *Invite `json:"invite" yaml:"invite"`
+}
+
+func (q QueryResInvite) String() string {
+	if q.Invite == nil {
+		return "no such invite"
+	}
+	return q.Invite.String()
+}
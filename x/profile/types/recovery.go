@@ -0,0 +1,157 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxGuardians is how many guardians an account can have
+const MaxGuardians = 10
+
+// Guardians are accounts allowed to recover an account (i.e. to move it to a new address) if its key is lost.
+// Threshold of them must approve a recovery.
+type Guardians struct {
+	Accounts  []sdk.AccAddress `json:"accounts" yaml:"accounts"`
+	Threshold uint32           `json:"threshold" yaml:"threshold"`
+}
+
+func NewGuardians(accounts []sdk.AccAddress, threshold uint32) Guardians {
+	return Guardians{
+		Accounts:  accounts,
+		Threshold: threshold,
+	}
+}
+
+// Validate checks the guardian list of the owner account. An empty list with a zero threshold is valid
+// (it means "no guardians").
+func (g Guardians) Validate(owner sdk.AccAddress) error {
+	if len(g.Accounts) == 0 {
+		if g.Threshold != 0 {
+			return fmt.Errorf("threshold must be zero if there are no guardians")
+		}
+		return nil
+	}
+	if len(g.Accounts) > MaxGuardians {
+		return fmt.Errorf("too many guardians (%d > %d)", len(g.Accounts), MaxGuardians)
+	}
+	if g.Threshold == 0 || int(g.Threshold) > len(g.Accounts) {
+		return fmt.Errorf("threshold must be from 1 to %d, got %d", len(g.Accounts), g.Threshold)
+	}
+	seen := make(map[string]bool, len(g.Accounts))
+	for i, acc := range g.Accounts {
+		if acc.Empty() {
+			return fmt.Errorf("guardian #%d is empty", i)
+		}
+		if acc.Equals(owner) {
+			return fmt.Errorf("account cannot be its own guardian")
+		}
+		if seen[acc.String()] {
+			return fmt.Errorf("duplicate guardian %s", acc)
+		}
+		seen[acc.String()] = true
+	}
+	return nil
+}
+
+// Has tells if the account is one of the guardians
+func (g Guardians) Has(acc sdk.AccAddress) bool {
+	for _, guardian := range g.Accounts {
+		if guardian.Equals(acc) {
+			return true
+		}
+	}
+	return false
+}
+
+// Replace substitutes a guardian's new address for the old one. If the new address is already a guardian,
+// the old one is just dropped (and the threshold is lowered if needed).
+func (g Guardians) Replace(from, to sdk.AccAddress) Guardians {
+	accounts := make([]sdk.AccAddress, 0, len(g.Accounts))
+	for _, acc := range g.Accounts {
+		if acc.Equals(from) {
+			if g.Has(to) {
+				continue
+			}
+			acc = to
+		}
+		accounts = append(accounts, acc)
+	}
+	threshold := g.Threshold
+	if int(threshold) > len(accounts) {
+		threshold = uint32(len(accounts))
+	}
+	return NewGuardians(accounts, threshold)
+}
+
+func (g Guardians) String() string {
+	return fmt.Sprintf(`Accounts: %s
+Threshold: %d`, g.Accounts, g.Threshold)
+}
+
+// Recovery is a pending request to move an account to a new address
+type Recovery struct {
+	NewAddress sdk.AccAddress   `json:"new_address" yaml:"new_address"`
+	Approvals  []sdk.AccAddress `json:"approvals" yaml:"approvals"`
+	// CompleteAt is a block height the recovery can be completed at. It's zero until enough guardians approve it.
+	CompleteAt int64 `json:"complete_at,omitempty" yaml:"complete_at,omitempty"`
+	// ExpiresAt is a block height the recovery lapses at unless enough guardians approve it (zero means never).
+	ExpiresAt int64 `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+}
+
+func NewRecovery(newAddress sdk.AccAddress, expiresAt int64) Recovery {
+	return Recovery{NewAddress: newAddress, ExpiresAt: expiresAt}
+}
+
+// IsExpired tells if the recovery hasn't got enough approvals in time
+func (r Recovery) IsExpired(height int64) bool {
+	return r.CompleteAt == 0 && r.ExpiresAt != 0 && height >= r.ExpiresAt
+}
+
+// IsApprovedBy tells if the guardian has already approved the recovery
+func (r Recovery) IsApprovedBy(guardian sdk.AccAddress) bool {
+	for _, acc := range r.Approvals {
+		if acc.Equals(guardian) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceApproval substitutes a guardian's new address for the old one in the approval list
+func (r Recovery) ReplaceApproval(from, to sdk.AccAddress) Recovery {
+	approvals := make([]sdk.AccAddress, 0, len(r.Approvals))
+	for _, acc := range r.Approvals {
+		if acc.Equals(from) {
+			if r.IsApprovedBy(to) {
+				continue
+			}
+			acc = to
+		}
+		approvals = append(approvals, acc)
+	}
+	r.Approvals = approvals
+	return r
+}
+
+func (r Recovery) String() string {
+	approvals := make([]string, len(r.Approvals))
+	for i, acc := range r.Approvals {
+		approvals[i] = acc.String()
+	}
+	return fmt.Sprintf(`NewAddress: %s
+Approvals: [%s]
+CompleteAt: %d
+ExpiresAt: %d`, r.NewAddress, strings.Join(approvals, ", "), r.CompleteAt, r.ExpiresAt)
+}
+
+type GenesisGuardians struct {
+	Address   sdk.AccAddress `json:"address" yaml:"address"`
+	Guardians Guardians      `json:"guardians" yaml:"guardians"`
+}
+
+type GenesisRecovery struct {
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Recovery Recovery       `json:"recovery" yaml:"recovery"`
+}
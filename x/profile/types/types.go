@@ -5,6 +5,14 @@ import (
 	"strings"
 )
 
+// Public profile field size limits
+const (
+	MaxAvatarHashLength = 128
+	MaxBioLength        = 512
+	MaxContacts         = 5
+	MaxContactLength    = 256
+)
+
 type Profile struct {
 	AutoPay     bool   `json:"autopay" yaml:"autopay"`
 	ActiveUntil uint64 `json:"active_until" yaml:"active_until"`
@@ -14,6 +22,81 @@ type Profile struct {
 	VPN         bool   `json:"VPN" yaml:"VPN"`
 	Nickname    string `json:"nickname" yaml:"nickname"`
 	CardNumber  uint64 `json:"card_number,omitempty" yaml:"card_number"`
+	// StorageAutoBuy allows buying extra storage space automatically when the limit is exceeded
+	StorageAutoBuy bool `json:"storage_auto_buy,omitempty" yaml:"storage_auto_buy"`
+
+	// AvatarHash is a content hash (or CID) of an avatar image stored elsewhere
+	AvatarHash string `json:"avatar_hash,omitempty" yaml:"avatar_hash,omitempty"`
+	Bio        string `json:"bio,omitempty" yaml:"bio,omitempty"`
+	// Contacts are links (URLs, messenger handles etc.) the account owner wants to share
+	Contacts []string `json:"contacts,omitempty" yaml:"contacts,omitempty"`
+	// Visibility tells which fields are hidden from profile queries
+	Visibility Visibility `json:"visibility,omitempty" yaml:"visibility,omitempty"`
+}
+
+// Visibility is per-field profile privacy settings. All fields are visible by default. A hidden nickname or card
+// number cannot be resolved to the account address by queries either.
+type Visibility struct {
+	HideNickname   bool `json:"hide_nickname,omitempty" yaml:"hide_nickname,omitempty"`
+	HideCardNumber bool `json:"hide_card_number,omitempty" yaml:"hide_card_number,omitempty"`
+	HideAvatar     bool `json:"hide_avatar,omitempty" yaml:"hide_avatar,omitempty"`
+	HideBio        bool `json:"hide_bio,omitempty" yaml:"hide_bio,omitempty"`
+	HideContacts   bool `json:"hide_contacts,omitempty" yaml:"hide_contacts,omitempty"`
+}
+
+// Validate checks public field sizes
+func (p Profile) Validate() error {
+	if len(p.AvatarHash) > MaxAvatarHashLength {
+		return fmt.Errorf("avatar hash is too long (%d > %d)", len(p.AvatarHash), MaxAvatarHashLength)
+	}
+	if len(p.Bio) > MaxBioLength {
+		return fmt.Errorf("bio is too long (%d > %d)", len(p.Bio), MaxBioLength)
+	}
+	if len(p.Contacts) > MaxContacts {
+		return fmt.Errorf("too many contacts (%d > %d)", len(p.Contacts), MaxContacts)
+	}
+	for i, contact := range p.Contacts {
+		if len(strings.TrimSpace(contact)) == 0 {
+			return fmt.Errorf("contact #%d is empty", i)
+		}
+		if len(contact) > MaxContactLength {
+			return fmt.Errorf("contact #%d is too long (%d > %d)", i, len(contact), MaxContactLength)
+		}
+	}
+	return nil
+}
+
+// Public returns the profile with hidden fields cleared
+func (p Profile) Public() Profile {
+	if p.Visibility.HideNickname {
+		p.Nickname = ""
+	}
+	if p.Visibility.HideCardNumber {
+		p.CardNumber = 0
+	}
+	if p.Visibility.HideAvatar {
+		p.AvatarHash = ""
+	}
+	if p.Visibility.HideBio {
+		p.Bio = ""
+	}
+	if p.Visibility.HideContacts {
+		p.Contacts = nil
+	}
+	return p
+}
+
+// PublicInfoEquals checks if avatar, bio and contacts are the same in both profiles
+func (p Profile) PublicInfoEquals(other Profile) bool {
+	if p.AvatarHash != other.AvatarHash || p.Bio != other.Bio || len(p.Contacts) != len(other.Contacts) {
+		return false
+	}
+	for i := range p.Contacts {
+		if p.Contacts[i] != other.Contacts[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func (p Profile) String() string {
@@ -25,7 +108,12 @@ func (p Profile) String() string {
 			"VPN: %t\n"+
 			"Validator: %t\n"+
 			"Nilname: %s\n"+
-			"CardNumber: %012d",
+			"CardNumber: %012d\n"+
+			"StorageAutoBuy: %t\n"+
+			"AvatarHash: %s\n"+
+			"Bio: %s\n"+
+			"Contacts: %s\n"+
+			"Visibility: %+v",
 		p.AutoPay,
 		p.ActiveUntil,
 		p.Noding,
@@ -33,5 +121,10 @@ func (p Profile) String() string {
 		p.VPN,
 		p.Validator,
 		p.Nickname,
-		p.CardNumber))
+		p.CardNumber,
+		p.StorageAutoBuy,
+		p.AvatarHash,
+		p.Bio,
+		strings.Join(p.Contacts, ", "),
+		p.Visibility))
 }
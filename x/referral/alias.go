@@ -26,8 +26,9 @@ const (
 	CompressionPeriod    = keeper.CompressionPeriod
 	StatusDowngradeAfter = keeper.StatusDowngradeAfter
 
-	StatusUpdatedCallback = keeper.StatusUpdatedCallback
-	StakeChangedCallback  = keeper.StakeChangedCallback
+	StatusUpdatedCallback     = keeper.StatusUpdatedCallback
+	StakeChangedCallback      = keeper.StakeChangedCallback
+	AccountCompressedCallback = keeper.AccountCompressedCallback
 
 	StatusDowngradeHookName   = keeper.StatusDowngradeHookName
 	CompressionHookName       = keeper.CompressionHookName
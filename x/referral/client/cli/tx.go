@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 	"github.com/arterynetwork/artr/x/referral/types"
 )
 
@@ -50,9 +51,9 @@ func getCmdRequestTransition(cdc *codec.Codec) *cobra.Command {
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
 			subjAddr := cliCtx.GetFromAddress()
-			destAddr, err := sdk.AccAddressFromBech32(args[0])
+			destAddr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
-				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+				return err
 			}
 
 			msg := types.NewMsgRequestTransition(subjAddr, destAddr)
@@ -78,9 +79,9 @@ func getCmdResolveTransition(cdc *codec.Codec) *cobra.Command {
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
 			senderAddr := cliCtx.GetFromAddress()
-			subjAddr, err := sdk.AccAddressFromBech32(args[0])
+			subjAddr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
-				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+				return err
 			}
 
 			approved := true
@@ -1,46 +1,103 @@
 package rest
 
-// The packages below are commented out at first to prevent an error if this file isn't initially saved.
 import (
-	// "bytes"
-	// "net/http"
+	"net/http"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
-	// sdk "github.com/cosmos/cosmos-sdk/types"
-	// "github.com/cosmos/cosmos-sdk/types/rest"
-	// "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
-	// "github.com/arterynetwork/artr/x/referral/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
+	"github.com/arterynetwork/artr/x/referral/types"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	// r.HandleFunc(
-	// TODO: Define the Rest route ,
-	// Call the function which should be executed for this route),
-	// ).Methods("POST")
+	r.HandleFunc(
+		"/referral/transitions",
+		requestTransitionHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/referral/transitions/{subject}",
+		resolveTransitionHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
-/*
-// Action TX body
-type <Action>Req struct {
-	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
-	// TODO: Define more types if needed
+// RequestTransitionReq defines the properties of a transition request's body. The destination (a new referrer) can
+// be referred to by an address, a @nickname or a card number.
+type RequestTransitionReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Destination string       `json:"destination" yaml:"destination"`
 }
 
-func <Action>RequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+func requestTransitionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req <Action>Req
-		vars := mux.Vars(r)
+		var req RequestTransitionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
 
-		baseReq := req.BaseReq.Sanitize()
-		if !baseReq.ValidateBasic(w) {
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
 			return
 		}
 
-		// TODO: Define the module tx logic for this action
+		subject, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		destination, ok := resolver.ResolveAddressREST(w, cliCtx, req.Destination)
+		if !ok {
+			return
+		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, BaseReq, []sdk.Msg{msg})
+		msg := types.NewMsgRequestTransition(subject, destination)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// ResolveTransitionReq defines the properties of a transition resolution request's body. The subject is taken from
+// the URL and can be an address, a @nickname or a card number.
+type ResolveTransitionReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Approved bool         `json:"approved" yaml:"approved"`
+}
+
+func resolveTransitionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		subject, ok := resolver.ResolveAddressREST(w, cliCtx, mux.Vars(r)["subject"])
+		if !ok {
+			return
+		}
+
+		var req ResolveTransitionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgResolveTransition(sender, subject, req.Approved)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
-*/
@@ -8,6 +8,8 @@ import (
 const (
 	StatusUpdatedCallback = "status-updated"
 	StakeChangedCallback  = "stake-changed"
+	// AccountCompressedCallback is called when an inactive account is compressed (i.e. looses its network)
+	AccountCompressedCallback = "account-compressed"
 
 	StatusDowngradeHookName   = "referral/downgrade"
 	CompressionHookName       = "referral/compression"
@@ -311,6 +311,7 @@ func (k Keeper) Compress(ctx sdk.Context, acc sdk.AccAddress) error {
 		bu.addCallback(StakeChangedCallback, acc)
 		k.setStatus(ctx, value, types.Lucky, acc)
 		bu.addCallback(StatusUpdatedCallback, acc)
+		bu.addCallback(AccountCompressedCallback, acc)
 	})
 	if err != nil {
 		return err
@@ -651,7 +652,7 @@ func (k Keeper) RequestTransition(ctx sdk.Context, subject, newParent sdk.AccAdd
 	}
 
 	var data []byte = subject
-	err = k.scheduleKeeper.ScheduleTask(ctx, uint64(ctx.BlockHeight()+util.BlocksOneDay), TransitionTimeoutHookName, &data)
+	_, err = k.scheduleKeeper.ScheduleTask(ctx, uint64(ctx.BlockHeight()+util.BlocksOneDay), TransitionTimeoutHookName, &data)
 	if err != nil {
 		panic(errors.Wrap(err, "cannot schedule transition timeout"))
 	}
@@ -800,6 +801,75 @@ func (k Keeper) AffirmTransition(ctx sdk.Context, subject sdk.AccAddress) error
 	return nil
 }
 
+// MigrateAccount moves an account's place in the referral structure (status, network, referrer and referrals) to
+// a new address. The new address must not be in the structure yet. Coins are supposed to be moved by the caller
+// *without* triggering OnBalanceChanged, since they are already accounted in the record being moved. Pending
+// transitions of other accounts to this one are redirected to the new address.
+// Scheduled tasks (downgrade, compression, transition timeout) are *NOT* affected.
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	if k.exists(ctx, to) {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("account %s already exists", to.String()),
+		)
+	}
+	r, err := k.get(ctx, from)
+	if err != nil {
+		return errors.Wrap(err, "subject account data missing")
+	}
+
+	ctx.KVStore(k.storeKey).Delete([]byte(from))
+	if err = k.set(ctx, to, r); err != nil {
+		return err
+	}
+	if r.Status >= minIndexedStatus {
+		store := ctx.KVStore(k.indexStoreKey)
+		store.Delete(append([]byte{uint8(r.Status)}, from...))
+		store.Set(append([]byte{uint8(r.Status)}, to...), []byte{0x01})
+	}
+
+	if r.Referrer != nil {
+		if err = k.update(ctx, r.Referrer, func(value types.R) types.R {
+			for i, child := range value.Referrals {
+				if child.Equals(from) {
+					value.Referrals[i] = to
+					break
+				}
+			}
+			return value
+		}); err != nil {
+			return errors.Wrap(err, "cannot update referrer data")
+		}
+	}
+	for _, child := range r.Referrals {
+		if err = k.update(ctx, child, func(value types.R) types.R {
+			value.Referrer = to
+			return value
+		}); err != nil {
+			return errors.Wrapf(err, "cannot update referral %s data", child)
+		}
+	}
+	// Every pending transition has a timeout task, so those pointing to the account can be found by them
+	for _, task := range k.scheduleKeeper.GetTasksByHandler(ctx, TransitionTimeoutHookName) {
+		subject := sdk.AccAddress(task.Data)
+		value, err := k.get(ctx, subject)
+		if err != nil || !value.Transition.Equals(from) {
+			continue
+		}
+		value.Transition = to
+		if err = k.set(ctx, subject, value); err != nil {
+			return errors.Wrapf(err, "cannot update %s transition", subject)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAccountMigrated,
+		sdk.NewAttribute(types.AttributeKeyAddress, from.String()),
+		sdk.NewAttribute(types.AttributeKeyNewAddress, to.String()),
+	))
+	return nil
+}
+
 // GetPendingTransition returns a new referral that the specified account is requested to be moved under. It returns
 // (nil, nil) if the account is OK, but a transition is not requested.
 func (k Keeper) GetPendingTransition(ctx sdk.Context, acc sdk.AccAddress) (sdk.AccAddress, error) {
@@ -953,10 +1023,8 @@ func setOrUpdate(m map[string]bank.Output, key sdk.AccAddress, amt int64) {
 func (k Keeper) ScheduleCompression(ctx sdk.Context, acc sdk.AccAddress, compressionAt int64) error {
 	data := acc.Bytes()
 
-	return sdkerrors.Wrap(
-		k.scheduleKeeper.ScheduleTask(ctx, uint64(compressionAt), CompressionHookName, &data),
-		"cannot schedule compression",
-	)
+	_, err := k.scheduleKeeper.ScheduleTask(ctx, uint64(compressionAt), CompressionHookName, &data)
+	return sdkerrors.Wrap(err, "cannot schedule compression")
 }
 
 // ValidateTransition checks if an account transition valid. This methods fails if subject's R.Transition is not nil.
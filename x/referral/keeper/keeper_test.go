@@ -21,6 +21,7 @@ import (
 	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/referral"
 	"github.com/arterynetwork/artr/x/referral/types"
+	subscription "github.com/arterynetwork/artr/x/subscription/types"
 )
 
 func TestReferralKeeper(t *testing.T) {
@@ -1211,7 +1212,7 @@ func (s *StatusBonusSuite) TestStatusBonus() {
 	s.NoError(err)
 	course, price, _, _, _, _ := s.app.GetSubscriptionKeeper().GetPrices(s.ctx)
 	payment := int64(course * price)
-	total := util.Percent(5).MulInt64(payment - util.CalculateFee(sdk.NewInt(payment)).Int64()).Int64()
+	total := util.Percent(5).MulInt64(payment - util.CalculateFee(s.app.GetBankKeeper().GetFeeSchedule(s.ctx), subscription.PaySubscriptionConst, sdk.NewInt(payment)).Int64()).Int64()
 
 	s.Equal(total,
 		s.app.GetBankKeeper().GetCoins(s.ctx, s.k.GetParams(s.ctx).CompanyAccounts.StatusBonuses).AmountOf(util.ConfigMainDenom).Int64(),
@@ -10,6 +10,7 @@ const (
 	EventTypeTransitionRequested     = "transition_requested"
 	EventTypeTransitionDeclined      = "transition_declined"
 	EventTypeTransitionPerformed     = "transition_performed"
+	EventTypeAccountMigrated         = "account_migrated"
 
 	AttributeKeyAddress        = "address"
 	AttributeKeyBlockHeight    = "block_height"
@@ -21,6 +22,7 @@ const (
 	AttributeKeyReferrerBefore = "referrer_before"
 	AttributeKeyReferrerAfter  = "referrer_after"
 	AttributeKeyReason         = "reason"
+	AttributeKeyNewAddress     = "new_address"
 
 	AttributeValueCategory = ModuleName
 	AttributeValueTimeout  = "timeout"
@@ -22,7 +22,8 @@ type AccountKeeper interface {
 }
 
 type ScheduleKeeper interface {
-	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) error
+	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) (uint64, error)
+	GetTasksByHandler(ctx sdk.Context, handler string) []schedule.ScheduledTask
 	GetParams(cts sdk.Context) schedule.Params
 }
 
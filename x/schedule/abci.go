@@ -8,7 +8,7 @@ import (
 // BeginBlocker check for infraction evidence or downtime of validators
 // on every begin block
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
-	k.PerfomSchedule(ctx, uint64(ctx.BlockHeight()))
+	k.PerformBlock(ctx)
 }
 
 // EndBlocker called every block, process inflation, update validator set.
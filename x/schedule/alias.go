@@ -18,18 +18,32 @@ var (
 	// functions aliases
 	NewKeeper           = keeper.NewKeeper
 	NewQuerier          = keeper.NewQuerier
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
 	RegisterCodec       = types.RegisterCodec
 	NewGenesisState     = types.NewGenesisState
+	NewRecurringTask    = types.NewRecurringTask
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
 	// variable aliases
-	ModuleCdc = types.ModuleCdc
+	ModuleCdc        = types.ModuleCdc
+	ErrTaskNotFound  = types.ErrTaskNotFound
+	ErrInvalidHeight = types.ErrInvalidHeight
+	ErrInvalidTime   = types.ErrInvalidTime
+
+	ErrRecurringTaskNotFound = types.ErrRecurringTaskNotFound
+	ErrInvalidRecurrence     = types.ErrInvalidRecurrence
 )
 
 type (
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
-	Params       = types.Params
-	Task         = types.Task
-	Schedule     = types.Schedule
+	Keeper          = keeper.Keeper
+	GenesisState    = types.GenesisState
+	GenesisSchedule = types.GenesisSchedule
+	GenesisTimeTask = types.GenesisTimeTask
+	Params          = types.Params
+	Task            = types.Task
+	Schedule        = types.Schedule
+	FailedTask      = types.FailedTask
+	ScheduledTask   = types.ScheduledTask
+	RecurringTask   = types.RecurringTask
 )
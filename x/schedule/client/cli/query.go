@@ -2,14 +2,17 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/arterynetwork/artr/x/schedule/types"
 )
@@ -27,7 +30,13 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	referralQueryCmd.AddCommand(
 		flags.GetCommands(
+			getCmdFailed(queryRoute, cdc),
 			getCmdParams(queryRoute, cdc),
+			getCmdTask(queryRoute, cdc),
+			getCmdTasksByHandler(queryRoute, cdc),
+			getCmdTasksByAccount(queryRoute, cdc),
+			getCmdRecurring(queryRoute, cdc),
+			getCmdUpcoming(queryRoute, cdc),
 		)...,
 	)
 
@@ -61,3 +70,160 @@ func getCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdFailed(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed",
+		Short: "Get tasks failed all their attempts",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz := cdc.MustMarshalJSON(types.NewQueryFailedParams(
+				int32(viper.GetInt(FlagLimit)),
+				int32(viper.GetInt(FlagPage)),
+			))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFailed), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryFailedRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of records per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
+
+func getCmdTask(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "task [id]",
+		Short: "Get a scheduled task by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryTaskParams(id))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTask), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.ScheduledTask
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdTasksByHandler(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tasks-by-handler [handler]",
+		Short: "Get all pending tasks with the handler name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz := cdc.MustMarshalJSON(types.NewQueryTasksByHandlerParams(args[0]))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTasksByHandler), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryScheduledTasksRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdTasksByAccount(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tasks-by-account [address]",
+		Short: "Get all pending tasks concerning the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryTasksByAccountParams(addr))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTasksByAccount), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryScheduledTasksRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdRecurring(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "recurring",
+		Short: "Get all recurring task definitions",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRecurring), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryRecurringRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func getCmdUpcoming(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming [id]",
+		Short: "Get block heights of upcoming executions of a recurring task",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryUpcomingParams(id, int32(viper.GetInt(FlagLimit))))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryUpcoming), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryUpcomingRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Number of executions returned")
+
+	return cmd
+}
@@ -9,12 +9,15 @@ import (
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	k.Logger(ctx).Info("Starting from genesis...")
 	k.SetParams(ctx, data.Params)
-	k.InitSchedule(ctx, data.Tasks)
+	k.InitTimeTasks(ctx, data.TimeTasks)
+	k.InitRecurringTasks(ctx, data.Recurring)
+	k.InitSchedule(ctx, data.Tasks, data.NextTaskID)
+	k.InitFailedTasks(ctx, data.Failed)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
-	return NewGenesisState(k.GetParams(ctx), k.ExportSchedule(ctx))
+	return NewGenesisState(k.GetParams(ctx), k.ExportSchedule(ctx), k.ExportFailedTasks(ctx), k.GetNextTaskID(ctx), k.ExportTimeTasks(ctx), k.GetRecurringTasks(ctx))
 }
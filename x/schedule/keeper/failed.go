@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/x/schedule/types"
+)
+
+// SetFailedTask puts a task to the dead-letter store. The index distinguishes tasks failed at the same height.
+func (k Keeper) SetFailedTask(ctx sdk.Context, index uint32, task types.FailedTask) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(failedTaskKey(task.Height, index), k.cdc.MustMarshalBinaryLengthPrefixed(task))
+}
+
+// GetFailedTasks returns tasks from the dead-letter store, the most recent first
+func (k Keeper) GetFailedTasks(ctx sdk.Context, limit int32, page int32) []types.FailedTask {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.DeadLetterPrefix)
+	defer iterator.Close()
+
+	records := make([]types.FailedTask, 0)
+	start := limit * (page - 1)
+	end := limit * page
+
+	for current := int32(0); iterator.Valid() && (current < end); iterator.Next() {
+		if current < start {
+			current++
+			continue
+		}
+		current++
+		var record types.FailedTask
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func (k Keeper) ExportFailedTasks(ctx sdk.Context) []types.FailedTask {
+	var result []types.FailedTask
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.DeadLetterPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var task types.FailedTask
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &task)
+		result = append(result, task)
+	}
+	return result
+}
+
+func (k Keeper) InitFailedTasks(ctx sdk.Context, tasks []types.FailedTask) {
+	var (
+		height uint64
+		index  uint32
+	)
+	for i, task := range tasks {
+		if i == 0 || task.Height != height {
+			height = task.Height
+			index = 0
+		}
+		k.SetFailedTask(ctx, index, task)
+		index++
+	}
+}
+
+func (k Keeper) nextFailedIndex(ctx sdk.Context, height uint64) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	prefix := failedTaskKey(height, 0)[:len(types.DeadLetterPrefix)+8]
+	it := sdk.KVStoreReversePrefixIterator(store, prefix)
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	return binary.BigEndian.Uint32(it.Key()[len(prefix):]) + 1
+}
+
+func failedTaskKey(height uint64, index uint32) []byte {
+	key := make([]byte, len(types.DeadLetterPrefix)+12)
+	copy(key, types.DeadLetterPrefix)
+	binary.BigEndian.PutUint64(key[len(types.DeadLetterPrefix):], height)
+	binary.BigEndian.PutUint32(key[len(types.DeadLetterPrefix)+8:], index)
+	return key
+}
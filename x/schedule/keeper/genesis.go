@@ -8,20 +8,31 @@ import (
 	"github.com/arterynetwork/artr/x/schedule/types"
 )
 
-func (k Keeper) InitSchedule(ctx sdk.Context, schedule []types.GenesisSchedule) {
+func (k Keeper) InitSchedule(ctx sdk.Context, schedule []types.GenesisSchedule, nextID uint64) {
 	store := ctx.KVStore(k.storeKey)
 	key := make([]byte, 8)
 	for _, sch := range schedule {
 		binary.BigEndian.PutUint64(key, sch.Height)
 		value := k.cdc.MustMarshalBinaryBare(sch.Schedule)
 		store.Set(key, value)
+
+		for _, task := range sch.Schedule {
+			if task.ID >= nextID {
+				nextID = task.ID + 1
+			}
+		}
+	}
+	if current := k.GetNextTaskID(ctx); current > nextID {
+		nextID = current
 	}
+	k.SetNextTaskID(ctx, nextID)
+	k.ReindexTasks(ctx)
 }
 
 func (k Keeper) ExportSchedule(ctx sdk.Context) []types.GenesisSchedule {
 	var result []types.GenesisSchedule
 	store := ctx.KVStore(k.storeKey)
-	it := store.Iterator(nil, nil)
+	it := sdk.KVStorePrefixIterator(store, types.TaskPrefix)
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
 		block := binary.BigEndian.Uint64(it.Key())
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/x/schedule/types"
+)
+
+// GetTasksByHandler returns all the pending tasks with the handler name, ordered by height
+func (k Keeper) GetTasksByHandler(ctx sdk.Context, handler string) []types.ScheduledTask {
+	return k.getIndexedTasks(ctx, handlerIndexKey(handler))
+}
+
+// GetTasksByAccount returns all the pending tasks having the account address as payload, ordered by height
+func (k Keeper) GetTasksByAccount(ctx sdk.Context, acc sdk.AccAddress) []types.ScheduledTask {
+	return k.getIndexedTasks(ctx, accountIndexKey(acc))
+}
+
+// ReassignTasks makes all the pending tasks and recurring task definitions having the `from` address as payload to
+// have the `to` address instead. Task IDs and timing are kept. It's used when an account is migrated to a new address.
+func (k Keeper) ReassignTasks(ctx sdk.Context, from, to sdk.AccAddress) {
+	for _, rt := range k.GetRecurringTasks(ctx) {
+		if bytes.Equal(rt.Data, from) {
+			rt.Data = append([]byte(nil), to...)
+			k.setRecurringTask(ctx, rt)
+		}
+	}
+	for _, task := range k.GetTasksByAccount(ctx, from) {
+		k.unschedule(ctx, task)
+		task.Data = append([]byte(nil), to...)
+		if task.Time != nil {
+			k.addTimeTask(ctx, *task.Time, task.Task)
+		} else {
+			k.addTask(ctx, task.Height, task.Task)
+		}
+	}
+}
+
+func (k Keeper) getIndexedTasks(ctx sdk.Context, prefix []byte) []types.ScheduledTask {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	result := make([]types.ScheduledTask, 0)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+		if task, found := k.GetTask(ctx, id); found {
+			result = append(result, task)
+		}
+	}
+	return result
+}
+
+// ReindexTasks assigns IDs to tasks scheduled before IDs were introduced and builds indexes for them
+func (k Keeper) ReindexTasks(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var blocks []uint64
+	it := sdk.KVStorePrefixIterator(store, types.TaskPrefix)
+	for ; it.Valid(); it.Next() {
+		blocks = append(blocks, binary.BigEndian.Uint64(it.Key()))
+	}
+	it.Close()
+
+	for _, block := range blocks {
+		items := k.GetTasks(ctx, block)
+		changed := false
+		for i := range items {
+			if items[i].ID == 0 {
+				items[i].ID = k.nextTaskID(ctx)
+				changed = true
+			}
+			k.setIndex(ctx, block, items[i])
+		}
+		if changed {
+			k.setTasks(ctx, block, items)
+		}
+	}
+}
+
+func (k Keeper) GetNextTaskID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextTaskIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextTaskID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.NextTaskIDKey, bz)
+}
+
+func (k Keeper) nextTaskID(ctx sdk.Context) uint64 {
+	id := k.GetNextTaskID(ctx)
+	k.SetNextTaskID(ctx, id+1)
+	return id
+}
+
+func (k Keeper) getTaskHeight(ctx sdk.Context, id uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(taskIDKey(id))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+func (k Keeper) setIndex(ctx sdk.Context, block uint64, task types.Task) {
+	if task.ID == 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, block)
+	store.Set(taskIDKey(task.ID), bz)
+
+	k.setIndexEntries(ctx, block, task)
+}
+
+func (k Keeper) deleteIndex(ctx sdk.Context, block uint64, task types.Task) {
+	if task.ID == 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(taskIDKey(task.ID))
+	k.deleteIndexEntries(ctx, block, task)
+}
+
+func (k Keeper) setTimeIndex(ctx sdk.Context, t time.Time, task types.Task) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(t.UnixNano()))
+	store.Set(timeTaskIDKey(task.ID), bz)
+
+	k.setIndexEntries(ctx, timePosition(t), task)
+}
+
+func (k Keeper) deleteTimeIndex(ctx sdk.Context, t time.Time, task types.Task) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(timeTaskIDKey(task.ID))
+	k.deleteIndexEntries(ctx, timePosition(t), task)
+}
+
+// setIndexEntries puts the task to the handler and account indexes. Position is used to order the tasks, it's
+// a block height for height-keyed tasks (see timePosition for time-keyed ones).
+func (k Keeper) setIndexEntries(ctx sdk.Context, position uint64, task types.Task) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(indexEntryKey(handlerIndexKey(task.HandlerName), position, task.ID), []byte{})
+	if len(task.Data) == sdk.AddrLen {
+		store.Set(indexEntryKey(accountIndexKey(task.Data), position, task.ID), []byte{})
+	}
+}
+
+func (k Keeper) deleteIndexEntries(ctx sdk.Context, position uint64, task types.Task) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(indexEntryKey(handlerIndexKey(task.HandlerName), position, task.ID))
+	if len(task.Data) == sdk.AddrLen {
+		store.Delete(indexEntryKey(accountIndexKey(task.Data), position, task.ID))
+	}
+}
+
+// timePosition is an index position of a time-keyed task. Its highest bit is set, so that time-keyed tasks go
+// after height-keyed ones.
+func timePosition(t time.Time) uint64 {
+	return 1<<63 | uint64(t.UnixNano())
+}
+
+func taskIDKey(id uint64) []byte {
+	key := make([]byte, len(types.TaskIDPrefix)+8)
+	copy(key, types.TaskIDPrefix)
+	binary.BigEndian.PutUint64(key[len(types.TaskIDPrefix):], id)
+	return key
+}
+
+func timeTaskIDKey(id uint64) []byte {
+	key := make([]byte, len(types.TimeTaskIDPrefix)+8)
+	copy(key, types.TimeTaskIDPrefix)
+	binary.BigEndian.PutUint64(key[len(types.TimeTaskIDPrefix):], id)
+	return key
+}
+
+// handlerIndexKey is a prefix for the handler's tasks. Handler name is terminated with a zero byte, so that
+// one name being a prefix of another doesn't mix them up.
+func handlerIndexKey(handler string) []byte {
+	key := append(append([]byte(nil), types.HandlerIndexPrefix...), handler...)
+	return append(key, 0x00)
+}
+
+func accountIndexKey(acc []byte) []byte {
+	return append(append([]byte(nil), types.AccountIndexPrefix...), acc...)
+}
+
+func indexEntryKey(prefix []byte, block uint64, id uint64) []byte {
+	key := make([]byte, len(prefix)+16)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], block)
+	binary.BigEndian.PutUint64(key[len(prefix)+8:], id)
+	return key
+}
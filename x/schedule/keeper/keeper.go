@@ -11,6 +11,7 @@ import (
 	"github.com/arterynetwork/artr/x/schedule/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Keeper of the schedule store
@@ -65,8 +66,11 @@ func (k Keeper) GetTasks(ctx sdk.Context, block uint64) types.Schedule {
 	return items
 }
 
-// Schedule an event on block block height
-func (k Keeper) ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) error {
+// Schedule an event on block block height. Returns a unique ID of the task scheduled. The height must be in the future.
+func (k Keeper) ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) (uint64, error) {
+	if block <= uint64(ctx.BlockHeight()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidHeight, "%d <= %d", block, ctx.BlockHeight())
+	}
 	store := ctx.KVStore(k.storeKey)
 
 	blockBuf := make([]byte, 8)
@@ -82,21 +86,104 @@ func (k Keeper) ScheduleTask(ctx sdk.Context, block uint64, event string, data *
 		err := k.cdc.UnmarshalBinaryBare(bz, &items)
 
 		if err != nil {
-			return err
+			return 0, err
 		}
 	}
 
-	items = append(items, types.Task{
+	task := types.Task{
+		ID:          k.nextTaskID(ctx),
 		HandlerName: event,
 		Data:        *data,
-	})
+	}
+	items = append(items, task)
 
 	bz = k.cdc.MustMarshalBinaryBare(items)
 	store.Set(blockBuf, bz)
+	k.setIndex(ctx, block, task)
+
+	return task.ID, nil
+}
+
+func (k Keeper) addTask(ctx sdk.Context, block uint64, task types.Task) {
+	items := k.GetTasks(ctx, block)
+	items = append(items, task)
+	k.setTasks(ctx, block, items)
+	k.setIndex(ctx, block, task)
+}
+
+func (k Keeper) setTasks(ctx sdk.Context, block uint64, items types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	blockBuf := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockBuf, block)
+
+	if len(items) == 0 {
+		store.Delete(blockBuf)
+	} else {
+		store.Set(blockBuf, k.cdc.MustMarshalBinaryBare(items))
+	}
+}
 
+// GetTask returns a task by its ID along with a block height (or a block time) it's scheduled on
+func (k Keeper) GetTask(ctx sdk.Context, id uint64) (task types.ScheduledTask, found bool) {
+	block, found := k.getTaskHeight(ctx, id)
+	if !found {
+		return k.getTimeTask(ctx, id)
+	}
+	for _, t := range k.GetTasks(ctx, block) {
+		if t.ID == id {
+			return types.ScheduledTask{Task: t, Height: block}, true
+		}
+	}
+	return types.ScheduledTask{}, false
+}
+
+// CancelTask removes a task from the schedule
+func (k Keeper) CancelTask(ctx sdk.Context, id uint64) error {
+	task, found := k.GetTask(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTaskNotFound, "id: %d", id)
+	}
+	k.unschedule(ctx, task)
 	return nil
 }
 
+// RescheduleTask moves a task (either height- or time-keyed one) to another block
+func (k Keeper) RescheduleTask(ctx sdk.Context, id uint64, block uint64) error {
+	if block <= uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrInvalidHeight, "%d <= %d", block, ctx.BlockHeight())
+	}
+	task, found := k.GetTask(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTaskNotFound, "id: %d", id)
+	}
+	if task.Time == nil && task.Height == block {
+		return nil
+	}
+	k.unschedule(ctx, task)
+	k.addTask(ctx, block, task.Task)
+	return nil
+}
+
+func (k Keeper) unschedule(ctx sdk.Context, task types.ScheduledTask) {
+	if task.Time != nil {
+		k.removeTimeTask(ctx, *task.Time, task.Task)
+	} else {
+		k.removeTask(ctx, task.Height, task.ID)
+		k.deleteIndex(ctx, task.Height, task.Task)
+	}
+}
+
+func (k Keeper) removeTask(ctx sdk.Context, block uint64, id uint64) {
+	items := k.GetTasks(ctx, block)
+	for i, t := range items {
+		if t.ID == id {
+			items = append(items[:i], items[i+1:]...)
+			break
+		}
+	}
+	k.setTasks(ctx, block, items)
+}
+
 func (k Keeper) filterTasks(vs types.Schedule, excludeEvent string) types.Schedule {
 	vsf := make(types.Schedule, 0)
 	for _, v := range vs {
@@ -108,78 +195,205 @@ func (k Keeper) filterTasks(vs types.Schedule, excludeEvent string) types.Schedu
 }
 
 func (k Keeper) DeleteAllTasksOnBlock(ctx sdk.Context, block uint64, event string) {
-	store := ctx.KVStore(k.storeKey)
-
-	blockBuf := make([]byte, 8)
-	binary.BigEndian.PutUint64(blockBuf, block)
-
-	bz := store.Get(blockBuf)
-
-	if bz == nil {
+	items := k.GetTasks(ctx, block)
+	if len(items) == 0 {
 		return
 	}
 
-	var items types.Schedule
-
-	err := k.cdc.UnmarshalBinaryBare(bz, &items)
-
-	if err != nil {
-		return
+	for _, t := range items {
+		if t.HandlerName == event {
+			k.deleteIndex(ctx, block, t)
+		}
 	}
+	k.setTasks(ctx, block, k.filterTasks(items, event))
+}
 
-	items = k.filterTasks(items, event)
-
-	bz = k.cdc.MustMarshalBinaryBare(items)
+// PerformBlock performs all the tasks due at the current block: height-keyed ones first, then time-keyed ones.
+// No more than MaxTasksPerBlock tasks are performed, the rest is left for the next blocks (in the same order).
+func (k Keeper) PerformBlock(ctx sdk.Context) {
+	block := uint64(ctx.BlockHeight())
+	limit := k.taskLimit(ctx)
 
-	if len(bz) == 0 {
-		store.Delete(blockBuf)
-	} else {
-		store.Set(blockBuf, bz)
+	performed, carried := k.performHeightTasks(ctx, block, limit)
+	if limit >= 0 {
+		limit -= performed
+	}
+	_, pending := k.performTimeTasks(ctx, limit)
+
+	if carried != 0 || pending {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBacklog,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", block)),
+			sdk.NewAttribute(types.AttributeKeyCarried, fmt.Sprintf("%d", carried)),
+			sdk.NewAttribute(types.AttributeKeyPending, fmt.Sprintf("%t", pending)),
+		))
+		k.Logger(ctx).Info("task budget exceeded", "height", block, "carried", carried, "pending", pending)
 	}
 }
 
-// Perfoms a sheduled tasks for block height. Tasks removed from store after completion
+// Perfoms a sheduled tasks for block height. Tasks removed from store after completion.
+// Each task is run in its own cached context, which is written only if the task succeeds. A failed task is
+// retried on the next block, or moved to the dead-letter store after MaxRetries attempts.
+// Tasks exceeding MaxTasksPerBlock are carried over to the head of the next block's queue.
 func (k Keeper) PerfomSchedule(ctx sdk.Context, block uint64) {
-	// We can ignore InitialHeight here, because all performed tasks are removed from KVStore
+	k.performHeightTasks(ctx, block, k.taskLimit(ctx))
+}
 
-	store := ctx.KVStore(k.storeKey)
+// performHeightTasks performs up to limit (negative means no limit) tasks scheduled on the block. Returns how
+// many tasks are performed and how many are carried over to the next block. Tasks added to the block by the hooks
+// being performed are performed (or carried over) as well.
+func (k Keeper) performHeightTasks(ctx sdk.Context, block uint64, limit int) (performed int, carried int) {
+	// We can ignore InitialHeight here, because all performed tasks are removed from KVStore
+	var maxRetries uint32
+	k.paramspace.Get(ctx, types.KeyMaxRetries, &maxRetries)
+
+	done := make(map[uint64]bool)
+	for items := k.GetTasks(ctx, block); len(items) != 0; items = k.pendingTasks(ctx, block, done) {
+		for i, task := range items {
+			if limit >= 0 && performed >= limit {
+				rest := items[i:]
+				for _, t := range rest {
+					done[t.ID] = true
+				}
+				carried = k.carryOver(ctx, block, append(rest, k.pendingTasks(ctx, block, done)...))
+				k.clearTasks(ctx, block)
+				return performed, carried
+			}
+			done[task.ID] = true
+			if task.ID != 0 {
+				if height, found := k.getTaskHeight(ctx, task.ID); !found || height != block {
+					// cancelled or rescheduled by one of the previous tasks
+					continue
+				}
+			}
+			k.deleteIndex(ctx, block, task)
+			if task.RecurringID != 0 && task.Attempts == 0 {
+				k.scheduleNextOccurrence(ctx, task, block)
+			}
+
+			k.performTask(ctx, block, task, maxRetries)
+			performed++
+		}
+	}
+	k.clearTasks(ctx, block)
+	return performed, 0
+}
 
-	blockBuf := make([]byte, 8)
-	binary.BigEndian.PutUint64(blockBuf, block)
+// clearTasks removes the block's queue. Index entries of tasks left there (e.g. ones re-added by a hook after they
+// are performed) are removed as well.
+func (k Keeper) clearTasks(ctx sdk.Context, block uint64) {
+	for _, task := range k.GetTasks(ctx, block) {
+		if height, found := k.getTaskHeight(ctx, task.ID); found && height == block {
+			k.deleteIndex(ctx, block, task)
+		} else {
+			k.deleteIndexEntries(ctx, block, task)
+		}
+	}
+	k.setTasks(ctx, block, nil)
+}
 
-	bz := store.Get(blockBuf)
+// pendingTasks returns tasks scheduled on the block which are not performed yet. Tasks without an ID are never
+// added by hooks, so they are only met in the initial block's queue.
+func (k Keeper) pendingTasks(ctx sdk.Context, block uint64, done map[uint64]bool) types.Schedule {
+	var pending types.Schedule
+	for _, task := range k.GetTasks(ctx, block) {
+		if task.ID != 0 && !done[task.ID] {
+			pending = append(pending, task)
+		}
+	}
+	return pending
+}
 
-	if bz == nil {
-		return
+// carryOver moves tasks, which don't fit into the block's budget, to the head of the next block's queue, so
+// that they are performed before the tasks scheduled on the next block initially. Returns how many tasks
+// are moved.
+func (k Keeper) carryOver(ctx sdk.Context, block uint64, items types.Schedule) int {
+	carried := make(types.Schedule, 0, len(items))
+	for _, task := range items {
+		if task.ID != 0 {
+			if height, found := k.getTaskHeight(ctx, task.ID); !found || height != block {
+				continue
+			}
+		}
+		k.deleteIndex(ctx, block, task)
+		carried = append(carried, task)
 	}
 
-	var items types.Schedule
+	k.setTasks(ctx, block+1, append(carried, k.GetTasks(ctx, block+1)...))
+	for _, task := range carried {
+		k.setIndex(ctx, block+1, task)
+	}
+	return len(carried)
+}
 
-	err := k.cdc.UnmarshalBinaryBare(bz, &items)
+// taskLimit returns how many tasks can be performed in a block, -1 means no limit
+func (k Keeper) taskLimit(ctx sdk.Context) int {
+	var maxTasks uint32
+	k.paramspace.Get(ctx, types.KeyMaxTasksPerBlock, &maxTasks)
+	if maxTasks == 0 {
+		return -1
+	}
+	return int(maxTasks)
+}
 
-	if err != nil {
+// performTask runs a task which is already removed from the schedule. If it fails, the task is rescheduled
+// on the next block or moved to the dead-letter store.
+func (k Keeper) performTask(ctx sdk.Context, block uint64, task types.Task, maxRetries uint32) {
+	hook := k.eventHooks[task.HandlerName]
+	if hook == nil {
 		return
 	}
 
-	for _, task := range items {
-		hook := k.eventHooks[task.HandlerName]
-		if hook != nil {
-			performSchedule(ctx, task, hook, k.Logger(ctx))
+	if err := performSchedule(ctx, task, hook, k.Logger(ctx)); err != nil {
+		task.Attempts++
+		retry := task.Attempts <= maxRetries
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTaskFailed,
+			sdk.NewAttribute(types.AttributeKeyHandler, task.HandlerName),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", block)),
+			sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", task.Attempts)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			sdk.NewAttribute(types.AttributeKeyRetry, fmt.Sprintf("%t", retry)),
+		))
+		if retry {
+			k.addTask(ctx, block+1, task)
+		} else {
+			k.SetFailedTask(ctx, k.nextFailedIndex(ctx, block), types.FailedTask{
+				Task:   task,
+				Height: block,
+				Error:  err.Error(),
+			})
 		}
+		return
 	}
 
-	store.Delete(blockBuf)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTaskExecuted,
+		sdk.NewAttribute(types.AttributeKeyHandler, task.HandlerName),
+		sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", block)),
+	))
 }
 
-func performSchedule(ctx sdk.Context, task types.Task, hook func(ctx sdk.Context, data []byte), logger log.Logger) {
+// performSchedule runs the task's hook in a cached context. Store changes and events are committed only if
+// the hook doesn't panic.
+func performSchedule(ctx sdk.Context, task types.Task, hook func(ctx sdk.Context, data []byte), logger log.Logger) (err error) {
 	logger.Debug("perform schedule", "task", task.HandlerName)
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
 	defer func(task string) {
-		if err := recover(); err != nil {
+		if r := recover(); r != nil {
 			logger.Error("recovered from panic",
 				"task", task,
-				"error", err,
+				"error", r,
 			)
+			err = fmt.Errorf("%v", r)
 		}
 	}(task.HandlerName)
-	hook(ctx, task.Data)
+
+	hook(cacheCtx, task.Data)
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
 }
@@ -0,0 +1,677 @@
+// +build testing
+
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/arterynetwork/artr/app"
+	"github.com/arterynetwork/artr/x/schedule"
+	"github.com/arterynetwork/artr/x/schedule/types"
+)
+
+func TestScheduleKeeper(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+type Suite struct {
+	suite.Suite
+
+	app     *app.ArteryApp
+	cleanup func()
+	ctx     sdk.Context
+	k       schedule.Keeper
+}
+
+func (s *Suite) SetupTest() {
+	s.app, s.cleanup = app.NewAppFromGenesis(nil)
+	s.ctx = s.app.NewContext(true, abci.Header{Height: 1})
+	s.k = s.app.GetScheduleKeeper()
+}
+
+func (s *Suite) TearDownTest() {
+	s.cleanup()
+}
+
+func (s Suite) TestTaskExecuted() {
+	user := app.DefaultGenesisUsers["user1"]
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) {
+		s.app.GetStorageKeeper().SetLimit(ctx, sdk.AccAddress(data), 42)
+	})
+
+	data := []byte(user)
+	_, err := s.k.ScheduleTask(s.ctx, 10, "test/ok", &data)
+	s.NoError(err)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.k.PerfomSchedule(ctx, 10)
+
+	s.Equal(int64(42), s.app.GetStorageKeeper().GetLimit(s.ctx, user))
+	s.True(hasEvent(ctx.EventManager().Events(), "task_executed"))
+	s.Empty(s.k.GetTasks(s.ctx, 10))
+	s.Empty(s.k.GetTasks(s.ctx, 11))
+}
+
+func (s Suite) TestTaskFailed() {
+	user := app.DefaultGenesisUsers["user1"]
+	s.k.AddHook("test/fail", func(ctx sdk.Context, data []byte) {
+		s.app.GetStorageKeeper().SetLimit(ctx, sdk.AccAddress(data), 42)
+		panic("oops")
+	})
+	limit := s.app.GetStorageKeeper().GetLimit(s.ctx, user)
+
+	data := []byte(user)
+	id, err := s.k.ScheduleTask(s.ctx, 10, "test/fail", &data)
+	s.NoError(err)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.k.PerfomSchedule(ctx, 10)
+
+	s.Equal(limit, s.app.GetStorageKeeper().GetLimit(s.ctx, user))
+	s.True(hasEvent(ctx.EventManager().Events(), "task_failed"))
+	s.False(hasEvent(ctx.EventManager().Events(), "task_executed"))
+	s.Empty(s.k.GetTasks(s.ctx, 10))
+	s.Equal(schedule.Schedule{{HandlerName: "test/fail", Data: data, Attempts: 1, ID: id}}, s.k.GetTasks(s.ctx, 11))
+	s.Equal([]schedule.ScheduledTask{
+		{Task: schedule.Task{HandlerName: "test/fail", Data: data, Attempts: 1, ID: id}, Height: 11},
+	}, s.k.GetTasksByAccount(s.ctx, user))
+	s.Empty(s.k.GetFailedTasks(s.ctx, 10, 1))
+
+	for h := uint64(11); h <= 13; h++ {
+		s.k.PerfomSchedule(s.ctx, h)
+	}
+
+	s.Empty(s.k.GetTasks(s.ctx, 14))
+	s.Equal([]schedule.FailedTask{
+		{
+			Task:   schedule.Task{HandlerName: "test/fail", Data: data, Attempts: 4, ID: id},
+			Height: 13,
+			Error:  "oops",
+		},
+	}, s.k.GetFailedTasks(s.ctx, 10, 1))
+	s.Equal(limit, s.app.GetStorageKeeper().GetLimit(s.ctx, user))
+	s.Empty(s.k.GetTasksByAccount(s.ctx, user))
+	_, found := s.k.GetTask(s.ctx, id)
+	s.False(found)
+}
+
+func (s Suite) TestTaskIDs() {
+	user := app.DefaultGenesisUsers["user1"]
+	data := []byte(user)
+
+	id1, err := s.k.ScheduleTask(s.ctx, 10, "test/a", &data)
+	s.NoError(err)
+	id2, err := s.k.ScheduleTask(s.ctx, 10, "test/a", &data)
+	s.NoError(err)
+	id3, err := s.k.ScheduleTask(s.ctx, 20, "test/ab", &[]byte{0x01})
+	s.NoError(err)
+
+	s.NotZero(id1)
+	s.NotEqual(id1, id2)
+	s.NotEqual(id2, id3)
+
+	task, found := s.k.GetTask(s.ctx, id2)
+	s.True(found)
+	s.Equal(schedule.ScheduledTask{Task: schedule.Task{HandlerName: "test/a", Data: data, ID: id2}, Height: 10}, task)
+
+	s.Equal([]uint64{id1, id2}, ids(s.k.GetTasksByHandler(s.ctx, "test/a")))
+	s.Equal([]uint64{id3}, ids(s.k.GetTasksByHandler(s.ctx, "test/ab")))
+	s.Equal([]uint64{id1, id2}, ids(s.k.GetTasksByAccount(s.ctx, user)))
+}
+
+func (s Suite) TestCancelTask() {
+	executed := 0
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed++ })
+
+	id1, err := s.k.ScheduleTask(s.ctx, 10, "test/ok", &[]byte{0x01})
+	s.NoError(err)
+	id2, err := s.k.ScheduleTask(s.ctx, 10, "test/ok", &[]byte{0x02})
+	s.NoError(err)
+
+	s.NoError(s.k.CancelTask(s.ctx, id1))
+	s.True(schedule.ErrTaskNotFound.Is(s.k.CancelTask(s.ctx, id1)))
+
+	s.Equal(schedule.Schedule{{HandlerName: "test/ok", Data: []byte{0x02}, ID: id2}}, s.k.GetTasks(s.ctx, 10))
+	s.Equal([]uint64{id2}, ids(s.k.GetTasksByHandler(s.ctx, "test/ok")))
+
+	s.k.PerfomSchedule(s.ctx, 10)
+	s.Equal(1, executed)
+	s.Empty(s.k.GetTasksByHandler(s.ctx, "test/ok"))
+}
+
+func (s Suite) TestRescheduleTask() {
+	user := app.DefaultGenesisUsers["user1"]
+	data := []byte(user)
+	executed := 0
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed++ })
+
+	id, err := s.k.ScheduleTask(s.ctx, 10, "test/ok", &data)
+	s.NoError(err)
+	other, err := s.k.ScheduleTask(s.ctx, 10, "test/ok", &data)
+	s.NoError(err)
+
+	s.True(schedule.ErrInvalidHeight.Is(s.k.RescheduleTask(s.ctx, id, 1)))
+	s.True(schedule.ErrTaskNotFound.Is(s.k.RescheduleTask(s.ctx, id+100, 20)))
+	s.NoError(s.k.RescheduleTask(s.ctx, id, 20))
+
+	s.Equal([]schedule.ScheduledTask{
+		{Task: schedule.Task{HandlerName: "test/ok", Data: data, ID: other}, Height: 10},
+		{Task: schedule.Task{HandlerName: "test/ok", Data: data, ID: id}, Height: 20},
+	}, s.k.GetTasksByAccount(s.ctx, user))
+
+	s.k.PerfomSchedule(s.ctx, 10)
+	s.Equal(1, executed)
+	s.k.PerfomSchedule(s.ctx, 20)
+	s.Equal(2, executed)
+	s.Empty(s.k.GetTasksByAccount(s.ctx, user))
+}
+
+func (s Suite) TestCancelFromTask() {
+	executed := 0
+	var id2 uint64
+	s.k.AddHook("test/cancel", func(ctx sdk.Context, data []byte) {
+		executed++
+		if err := s.k.CancelTask(ctx, id2); err != nil {
+			panic(err)
+		}
+	})
+
+	_, err := s.k.ScheduleTask(s.ctx, 10, "test/cancel", &[]byte{})
+	s.NoError(err)
+	id2, err = s.k.ScheduleTask(s.ctx, 10, "test/cancel", &[]byte{})
+	s.NoError(err)
+
+	s.k.PerfomSchedule(s.ctx, 10)
+	s.Equal(1, executed)
+	s.Empty(s.k.GetTasks(s.ctx, 10))
+}
+
+func (s Suite) TestScheduleTask_Past() {
+	_, err := s.k.ScheduleTask(s.ctx, 1, "test/ok", &[]byte{})
+	s.True(schedule.ErrInvalidHeight.Is(err))
+	s.Empty(s.k.GetTasks(s.ctx, 1))
+	s.Empty(s.k.GetTasksByHandler(s.ctx, "test/ok"))
+}
+
+func (s Suite) TestScheduleFromTask() {
+	executed := 0
+	var id uint64
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed++ })
+	s.k.AddHook("test/add", func(ctx sdk.Context, data []byte) {
+		var err error
+		if id, err = s.k.ScheduleTask(ctx, 10, "test/ok", &[]byte{}); err != nil {
+			panic(err)
+		}
+	})
+
+	_, err := s.k.ScheduleTask(s.ctx, 10, "test/add", &[]byte{})
+	s.NoError(err)
+
+	s.k.PerfomSchedule(s.ctx, 10)
+	s.Equal(1, executed)
+	s.Empty(s.k.GetTasks(s.ctx, 10))
+	s.Empty(s.k.GetTasksByHandler(s.ctx, "test/ok"))
+	_, found := s.k.GetTask(s.ctx, id)
+	s.False(found)
+}
+
+func (s Suite) TestScheduleFromTask_Budget() {
+	executed := 0
+	var id uint64
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed++ })
+	s.k.AddHook("test/add", func(ctx sdk.Context, data []byte) {
+		var err error
+		if id, err = s.k.ScheduleTask(ctx, 10, "test/ok", &[]byte{}); err != nil {
+			panic(err)
+		}
+	})
+	s.k.SetParams(s.ctx, schedule.NewParams(0, 1))
+
+	_, err := s.k.ScheduleTask(s.ctx, 10, "test/add", &[]byte{})
+	s.NoError(err)
+
+	s.k.PerfomSchedule(s.ctx, 10)
+	s.Zero(executed)
+	s.Empty(s.k.GetTasks(s.ctx, 10))
+	task, found := s.k.GetTask(s.ctx, id)
+	s.True(found)
+	s.Equal(uint64(11), task.Height)
+
+	s.k.PerfomSchedule(s.ctx, 11)
+	s.Equal(1, executed)
+	s.Empty(s.k.GetTasksByHandler(s.ctx, "test/ok"))
+}
+
+func (s Suite) TestDeleteAllTasksOnBlock() {
+	id1, err := s.k.ScheduleTask(s.ctx, 10, "test/a", &[]byte{})
+	s.NoError(err)
+	id2, err := s.k.ScheduleTask(s.ctx, 10, "test/b", &[]byte{})
+	s.NoError(err)
+
+	s.k.DeleteAllTasksOnBlock(s.ctx, 10, "test/a")
+
+	_, found := s.k.GetTask(s.ctx, id1)
+	s.False(found)
+	s.Empty(s.k.GetTasksByHandler(s.ctx, "test/a"))
+	s.Equal([]uint64{id2}, ids(s.k.GetTasksByHandler(s.ctx, "test/b")))
+}
+
+func (s Suite) TestGenesis() {
+	user := app.DefaultGenesisUsers["user1"]
+	s.k.AddHook("test/fail", func(ctx sdk.Context, data []byte) { panic("oops") })
+	s.k.SetParams(s.ctx, schedule.NewParams(0, 0))
+
+	data := []byte(user)
+	for _, h := range []uint64{10, 10, 20, 30} {
+		_, err := s.k.ScheduleTask(s.ctx, h, "test/fail", &data)
+		s.NoError(err)
+	}
+	s.ctx = s.ctx.WithBlockTime(time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC))
+	_, err := s.k.ScheduleTaskAt(s.ctx, s.ctx.BlockTime().Add(time.Hour), "test/fail", &data)
+	s.NoError(err)
+	_, err = s.k.ScheduleRecurringTask(s.ctx, "test/fail", &data, 15, 5, 40)
+	s.NoError(err)
+	s.k.PerfomSchedule(s.ctx, 10)
+	s.Len(s.k.GetFailedTasks(s.ctx, 10, 1), 2)
+
+	s.app.CheckExportImport(s.T(),
+		[]string{
+			schedule.StoreKey,
+			params.StoreKey,
+		},
+		map[string]app.Decoder{
+			schedule.StoreKey: app.DummyDecoder,
+			params.StoreKey:   app.DummyDecoder,
+		},
+		map[string]app.Decoder{
+			schedule.StoreKey: app.DummyDecoder,
+			params.StoreKey:   app.DummyDecoder,
+		},
+		make(map[string][][]byte, 0),
+	)
+}
+
+func (s Suite) TestTimeTask() {
+	user := app.DefaultGenesisUsers["user1"]
+	data := []byte(user)
+	executed := 0
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed++ })
+
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(t0)
+
+	_, err := s.k.ScheduleTaskAt(s.ctx, t0, "test/ok", &data)
+	s.True(schedule.ErrInvalidTime.Is(err))
+
+	deadline := t0.Add(time.Hour)
+	id, err := s.k.ScheduleTaskAt(s.ctx, deadline, "test/ok", &data)
+	s.NoError(err)
+	blockID, err := s.k.ScheduleTask(s.ctx, 100, "test/ok", &data)
+	s.NoError(err)
+
+	task, found := s.k.GetTask(s.ctx, id)
+	s.True(found)
+	s.Equal(schedule.ScheduledTask{Task: schedule.Task{HandlerName: "test/ok", Data: data, ID: id}, Time: &deadline}, task)
+	s.Equal([]uint64{blockID, id}, ids(s.k.GetTasksByAccount(s.ctx, user)))
+
+	s.k.PerformTimeSchedule(s.ctx.WithBlockHeight(2).WithBlockTime(deadline.Add(-time.Second)))
+	s.Equal(0, executed)
+
+	ctx := s.ctx.WithBlockHeight(3).WithBlockTime(deadline.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	s.k.PerformTimeSchedule(ctx)
+	s.Equal(1, executed)
+	s.True(hasEvent(ctx.EventManager().Events(), "task_executed"))
+
+	_, found = s.k.GetTask(s.ctx, id)
+	s.False(found)
+	s.Equal([]uint64{blockID}, ids(s.k.GetTasksByAccount(s.ctx, user)))
+}
+
+func (s Suite) TestTimeTask_CancelAndReschedule() {
+	executed := 0
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed++ })
+
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(t0)
+
+	id1, err := s.k.ScheduleTaskAt(s.ctx, t0.Add(time.Minute), "test/ok", &[]byte{0x01})
+	s.NoError(err)
+	id2, err := s.k.ScheduleTaskAt(s.ctx, t0.Add(time.Minute), "test/ok", &[]byte{0x01})
+	s.NoError(err)
+	id3, err := s.k.ScheduleTask(s.ctx, 10, "test/ok", &[]byte{0x01})
+	s.NoError(err)
+
+	s.NoError(s.k.CancelTask(s.ctx, id1))
+	s.NoError(s.k.RescheduleTask(s.ctx, id2, 20))
+	s.NoError(s.k.RescheduleTaskAt(s.ctx, id3, t0.Add(time.Hour)))
+
+	s.k.PerformTimeSchedule(s.ctx.WithBlockTime(t0.Add(2 * time.Minute)))
+	s.Equal(0, executed)
+	s.Empty(s.k.GetTasks(s.ctx, 10))
+	s.Equal(schedule.Schedule{{HandlerName: "test/ok", Data: []byte{0x01}, ID: id2}}, s.k.GetTasks(s.ctx, 20))
+
+	s.k.PerformTimeSchedule(s.ctx.WithBlockTime(t0.Add(time.Hour)))
+	s.Equal(1, executed)
+	s.Equal([]uint64{id2}, ids(s.k.GetTasksByHandler(s.ctx, "test/ok")))
+}
+
+func (s Suite) TestReassignTasks() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		data  = []byte(user1)
+	)
+
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(t0)
+
+	id1, err := s.k.ScheduleTask(s.ctx, 10, "test/ok", &data)
+	s.NoError(err)
+	id2, err := s.k.ScheduleTaskAt(s.ctx, t0.Add(time.Hour), "test/ok", &data)
+	s.NoError(err)
+	id3, err := s.k.ScheduleTask(s.ctx, 10, "test/ok", &[]byte{0x01})
+	s.NoError(err)
+	rid, err := s.k.ScheduleRecurringTask(s.ctx, "test/recurring", &data, 20, 5, 0)
+	s.NoError(err)
+
+	s.k.ReassignTasks(s.ctx, user1, user2)
+
+	s.Empty(s.k.GetTasksByAccount(s.ctx, user1))
+	rt, found := s.k.GetRecurringTask(s.ctx, rid)
+	s.True(found)
+	s.Equal([]byte(user2), rt.Data)
+	s.Equal([]uint64{id1, rt.Occurrence, id2}, ids(s.k.GetTasksByAccount(s.ctx, user2)))
+	s.Equal(schedule.Schedule{
+		{HandlerName: "test/ok", Data: []byte{0x01}, ID: id3},
+		{HandlerName: "test/ok", Data: []byte(user2), ID: id1},
+	}, s.k.GetTasks(s.ctx, 10))
+
+	task, found := s.k.GetTask(s.ctx, id2)
+	s.True(found)
+	s.Equal([]byte(user2), task.Data)
+	s.Equal(t0.Add(time.Hour), *task.Time)
+}
+
+func (s Suite) TestGenesis_LegacyTasks() {
+	user := app.DefaultGenesisUsers["user1"]
+	data := []byte(user)
+
+	s.k.InitSchedule(s.ctx, []schedule.GenesisSchedule{
+		{Height: 10, Schedule: schedule.Schedule{{HandlerName: "test/a", Data: data}, {HandlerName: "test/b", ID: 7}}},
+		{Height: 20, Schedule: schedule.Schedule{{HandlerName: "test/a", Data: data}}},
+	}, 0)
+
+	s.Equal([]schedule.ScheduledTask{
+		{Task: schedule.Task{HandlerName: "test/a", Data: data, ID: 8}, Height: 10},
+		{Task: schedule.Task{HandlerName: "test/a", Data: data, ID: 9}, Height: 20},
+	}, s.k.GetTasksByAccount(s.ctx, user))
+	s.Equal([]uint64{7}, ids(s.k.GetTasksByHandler(s.ctx, "test/b")))
+	s.Equal(uint64(10), s.k.GetNextTaskID(s.ctx))
+}
+
+func (s Suite) TestRecurringTask() {
+	executed := make([]uint64, 0)
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed = append(executed, uint64(ctx.BlockHeight())) })
+
+	_, err := s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 1, 5, 0)
+	s.True(schedule.ErrInvalidHeight.Is(err))
+	_, err = s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 10, 0, 0)
+	s.True(schedule.ErrInvalidRecurrence.Is(err))
+	_, err = s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 10, 5, 9)
+	s.True(schedule.ErrInvalidRecurrence.Is(err))
+
+	id, err := s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 10, 5, 22)
+	s.NoError(err)
+
+	rt, found := s.k.GetRecurringTask(s.ctx, id)
+	s.True(found)
+	s.Equal([]uint64{10, 15, 20}, rt.Upcoming(5))
+	s.Equal([]uint64{10, 15}, rt.Upcoming(2))
+
+	for h := uint64(2); h <= 30; h++ {
+		s.k.PerfomSchedule(s.ctx.WithBlockHeight(int64(h)), h)
+	}
+
+	s.Equal([]uint64{10, 15, 20}, executed)
+	_, found = s.k.GetRecurringTask(s.ctx, id)
+	s.False(found)
+	s.Empty(s.k.GetTasksByHandler(s.ctx, "test/ok"))
+}
+
+func (s Suite) TestRecurringTask_ByHandler() {
+	id1, err := s.k.ScheduleRecurringTask(s.ctx, "test/a", &[]byte{0x01}, 10, 5, 0)
+	s.NoError(err)
+	id2, err := s.k.ScheduleRecurringTask(s.ctx, "test/ab", &[]byte{0x02}, 10, 5, 12)
+	s.NoError(err)
+	id3, err := s.k.ScheduleRecurringTask(s.ctx, "test/a", &[]byte{0x03}, 10, 5, 0)
+	s.NoError(err)
+
+	s.Equal([]uint64{id1, id3}, recurringIDs(s.k.GetRecurringTasksByHandler(s.ctx, "test/a")))
+	s.Equal([]uint64{id2}, recurringIDs(s.k.GetRecurringTasksByHandler(s.ctx, "test/ab")))
+
+	s.NoError(s.k.CancelRecurringTask(s.ctx, id1))
+	s.k.PerfomSchedule(s.ctx.WithBlockHeight(10), 10)
+
+	s.Equal([]uint64{id3}, recurringIDs(s.k.GetRecurringTasksByHandler(s.ctx, "test/a")))
+	s.Empty(s.k.GetRecurringTasksByHandler(s.ctx, "test/ab"))
+
+	store := s.ctx.KVStore(s.app.GetKeys()[schedule.StoreKey])
+	it := sdk.KVStorePrefixIterator(store, types.RecurringHandlerIndexPrefix)
+	defer it.Close()
+	s.True(it.Valid())
+	it.Next()
+	s.False(it.Valid())
+}
+
+func (s Suite) TestRecurringTask_Upcoming() {
+	id, err := s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 10, 5, 0)
+	s.NoError(err)
+
+	querier := schedule.NewQuerier(s.k)
+	query := func(limit int32) ([]byte, error) {
+		return querier(s.ctx, []string{types.QueryUpcoming}, abci.RequestQuery{
+			Data: schedule.ModuleCdc.MustMarshalJSON(types.NewQueryUpcomingParams(id, limit)),
+		})
+	}
+	bz, err := query(types.MaxUpcomingLimit)
+	s.NoError(err)
+	var res types.QueryUpcomingRes
+	schedule.ModuleCdc.MustUnmarshalJSON(bz, &res)
+	s.Len(res, types.MaxUpcomingLimit)
+	_, err = query(types.MaxUpcomingLimit + 1)
+	s.True(sdkerrors.ErrInvalidRequest.Is(err))
+	_, err = query(0)
+	s.True(sdkerrors.ErrInvalidRequest.Is(err))
+
+	rt := schedule.RecurringTask{Next: math.MaxUint64 - 7, Interval: 5}
+	s.Equal([]uint64{math.MaxUint64 - 7, math.MaxUint64 - 2}, rt.Upcoming(5))
+}
+
+func (s Suite) TestRecurringTask_Cancel() {
+	executed := 0
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed++ })
+
+	id, err := s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 10, 1, 0)
+	s.NoError(err)
+	other, err := s.k.ScheduleRecurringTask(s.ctx, "test/other", &[]byte{0x01}, 10, 1, 0)
+	s.NoError(err)
+	s.Equal([]uint64{id}, recurringIDs(s.k.GetRecurringTasksByHandler(s.ctx, "test/ok")))
+
+	s.k.PerfomSchedule(s.ctx, 10)
+	s.k.PerfomSchedule(s.ctx, 11)
+	s.Equal(2, executed)
+
+	rt, found := s.k.GetRecurringTask(s.ctx, id)
+	s.True(found)
+	s.Equal(uint64(12), rt.Next)
+	s.Equal([]uint64{rt.Occurrence}, ids(s.k.GetTasksByHandler(s.ctx, "test/ok")))
+
+	s.NoError(s.k.CancelRecurringTask(s.ctx, id))
+	s.True(schedule.ErrRecurringTaskNotFound.Is(s.k.CancelRecurringTask(s.ctx, id)))
+
+	s.k.PerfomSchedule(s.ctx, 12)
+	s.Equal(2, executed)
+	s.Empty(s.k.GetTasksByHandler(s.ctx, "test/ok"))
+	s.Equal([]uint64{other}, recurringIDs(s.k.GetRecurringTasks(s.ctx)))
+}
+
+func (s Suite) TestRecurringTask_SetData() {
+	var payloads [][]byte
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { payloads = append(payloads, data) })
+
+	id, err := s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 10, 5, 0)
+	s.NoError(err)
+	s.k.PerfomSchedule(s.ctx.WithBlockHeight(10), 10)
+
+	s.NoError(s.k.SetRecurringTaskData(s.ctx, id, []byte{0x02}))
+	s.True(schedule.ErrRecurringTaskNotFound.Is(s.k.SetRecurringTaskData(s.ctx, id+100, []byte{0x02})))
+
+	rt, found := s.k.GetRecurringTask(s.ctx, id)
+	s.True(found)
+	s.Equal([]byte{0x02}, rt.Data)
+	s.Equal([]uint64{rt.Occurrence}, ids(s.k.GetTasksByHandler(s.ctx, "test/ok")))
+
+	s.k.PerfomSchedule(s.ctx.WithBlockHeight(15), 15)
+	s.k.PerfomSchedule(s.ctx.WithBlockHeight(20), 20)
+	s.Equal([][]byte{{0x01}, {0x02}, {0x02}}, payloads)
+}
+
+func (s Suite) TestRecurringTask_SetDataRescheduled() {
+	var payloads [][]byte
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { payloads = append(payloads, data) })
+
+	id, err := s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 10, 5, 0)
+	s.NoError(err)
+	rt, _ := s.k.GetRecurringTask(s.ctx, id)
+	s.NoError(s.k.RescheduleTask(s.ctx, rt.Occurrence, 12))
+
+	s.NoError(s.k.SetRecurringTaskData(s.ctx, id, []byte{0x02}))
+	task, found := s.k.GetTask(s.ctx, rt.Occurrence)
+	s.True(found)
+	s.Equal(uint64(12), task.Height)
+	s.Equal([]byte{0x02}, task.Data)
+
+	s.k.PerfomSchedule(s.ctx.WithBlockHeight(12), 12)
+	s.Equal([][]byte{{0x02}}, payloads)
+	rt, _ = s.k.GetRecurringTask(s.ctx, id)
+	s.Equal(uint64(15), rt.Next)
+}
+
+func (s Suite) TestRecurringTask_RescheduleAt() {
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(t0)
+
+	id, err := s.k.ScheduleRecurringTask(s.ctx, "test/ok", &[]byte{0x01}, 10, 5, 0)
+	s.NoError(err)
+	rt, _ := s.k.GetRecurringTask(s.ctx, id)
+
+	err = s.k.RescheduleTaskAt(s.ctx, rt.Occurrence, t0.Add(time.Hour))
+	s.True(schedule.ErrInvalidRecurrence.Is(err))
+	task, found := s.k.GetTask(s.ctx, rt.Occurrence)
+	s.True(found)
+	s.Nil(task.Time)
+	s.Equal(uint64(10), task.Height)
+}
+
+func (s Suite) TestRecurringTask_Failed() {
+	executed := 0
+	s.k.AddHook("test/fail", func(ctx sdk.Context, data []byte) {
+		executed++
+		panic("oops")
+	})
+	s.k.SetParams(s.ctx, schedule.NewParams(1, 0))
+
+	id, err := s.k.ScheduleRecurringTask(s.ctx, "test/fail", &[]byte{0x01}, 10, 10, 0)
+	s.NoError(err)
+
+	for h := uint64(10); h <= 20; h++ {
+		s.k.PerfomSchedule(s.ctx, h)
+	}
+
+	s.Equal(3, executed) // at 10, a retry at 11 and at 20
+	s.Len(s.k.GetFailedTasks(s.ctx, 10, 1), 1)
+	rt, found := s.k.GetRecurringTask(s.ctx, id)
+	s.True(found)
+	s.Equal(uint64(30), rt.Next)
+}
+
+func (s Suite) TestTaskBudget() {
+	executed := make([]byte, 0)
+	s.k.AddHook("test/ok", func(ctx sdk.Context, data []byte) { executed = append(executed, data[0]) })
+	s.k.SetParams(s.ctx, schedule.NewParams(0, 2))
+
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(t0)
+	for i, h := range []uint64{10, 10, 10, 11} {
+		_, err := s.k.ScheduleTask(s.ctx, h, "test/ok", &[]byte{byte(i + 1)})
+		s.NoError(err)
+	}
+	_, err := s.k.ScheduleTaskAt(s.ctx, t0.Add(time.Minute), "test/ok", &[]byte{0x05})
+	s.NoError(err)
+
+	ctx := s.ctx.WithBlockHeight(10).WithBlockTime(t0.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	s.k.PerformBlock(ctx)
+	s.Equal([]byte{0x01, 0x02}, executed)
+	s.Equal(schedule.Schedule{{HandlerName: "test/ok", Data: []byte{0x03}, ID: 3}, {HandlerName: "test/ok", Data: []byte{0x04}, ID: 4}}, s.k.GetTasks(s.ctx, 11))
+	s.Equal([]uint64{3, 4, 5}, ids(s.k.GetTasksByHandler(s.ctx, "test/ok")))
+	s.Equal(map[string]string{"height": "10", "carried": "1", "pending": "true"}, eventAttributes(ctx.EventManager().Events(), "task_backlog"))
+
+	ctx = s.ctx.WithBlockHeight(11).WithBlockTime(t0.Add(time.Hour + 5*time.Second)).WithEventManager(sdk.NewEventManager())
+	s.k.PerformBlock(ctx)
+	s.Equal([]byte{0x01, 0x02, 0x03, 0x04}, executed)
+	s.Equal(map[string]string{"height": "11", "carried": "0", "pending": "true"}, eventAttributes(ctx.EventManager().Events(), "task_backlog"))
+
+	ctx = s.ctx.WithBlockHeight(12).WithBlockTime(t0.Add(time.Hour + 10*time.Second)).WithEventManager(sdk.NewEventManager())
+	s.k.PerformBlock(ctx)
+	s.Equal([]byte{0x01, 0x02, 0x03, 0x04, 0x05}, executed)
+	s.False(hasEvent(ctx.EventManager().Events(), "task_backlog"))
+	s.Empty(s.k.GetTasksByHandler(s.ctx, "test/ok"))
+}
+
+func eventAttributes(events sdk.Events, eventType string) map[string]string {
+	for _, event := range events {
+		if event.Type == eventType {
+			result := make(map[string]string, len(event.Attributes))
+			for _, attr := range event.Attributes {
+				result[string(attr.Key)] = string(attr.Value)
+			}
+			return result
+		}
+	}
+	return nil
+}
+
+func recurringIDs(tasks []schedule.RecurringTask) []uint64 {
+	result := make([]uint64, len(tasks))
+	for i, t := range tasks {
+		result[i] = t.ID
+	}
+	return result
+}
+
+func ids(tasks []schedule.ScheduledTask) []uint64 {
+	result := make([]uint64, len(tasks))
+	for i, t := range tasks {
+		result[i] = t.ID
+	}
+	return result
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -17,6 +17,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryTasks(ctx, req, k)
 		case types.QueryParams:
 			return queryParams(ctx, k)
+		case types.QueryFailed:
+			return queryFailed(ctx, req, k)
+		case types.QueryTask:
+			return queryTask(ctx, req, k)
+		case types.QueryTasksByHandler:
+			return queryTasksByHandler(ctx, req, k)
+		case types.QueryTasksByAccount:
+			return queryTasksByAccount(ctx, req, k)
+		case types.QueryRecurring:
+			return queryRecurring(ctx, k)
+		case types.QueryUpcoming:
+			return queryUpcoming(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown schedule query endpoint")
 		}
@@ -50,3 +62,110 @@ func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+func queryFailed(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryFailedParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryFailedRes(k.GetFailedTasks(ctx, params.Limit, params.Page))
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryTask(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTaskParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	task, found := k.GetTask(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTaskNotFound, "id: %d", params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, task)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryTasksByHandler(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTasksByHandlerParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryScheduledTasksRes(k.GetTasksByHandler(ctx, params.Handler))
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryTasksByAccount(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTasksByAccountParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryScheduledTasksRes(k.GetTasksByAccount(ctx, params.Address))
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryRecurring(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res := types.QueryRecurringRes(k.GetRecurringTasks(ctx))
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryUpcoming(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryUpcomingParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Limit <= 0 || params.Limit > types.MaxUpcomingLimit {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "limit must be from 1 to %d", types.MaxUpcomingLimit)
+	}
+
+	rt, found := k.GetRecurringTask(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRecurringTaskNotFound, "id: %d", params.ID)
+	}
+
+	res := types.QueryUpcomingRes(rt.Upcoming(int(params.Limit)))
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/x/schedule/types"
+)
+
+// ScheduleRecurringTask registers an event performed every interval blocks starting from the start height
+// until the end one (zero means until cancelled). Returns a unique ID of the recurring task.
+func (k Keeper) ScheduleRecurringTask(ctx sdk.Context, event string, data *[]byte, start, interval, end uint64) (uint64, error) {
+	if start <= uint64(ctx.BlockHeight()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidHeight, "%d <= %d", start, ctx.BlockHeight())
+	}
+	rt := types.NewRecurringTask(event, *data, start, interval, end)
+	if err := rt.Validate(); err != nil {
+		return 0, err
+	}
+
+	rt.ID = k.nextTaskID(ctx)
+	k.scheduleOccurrence(ctx, &rt)
+	k.setRecurringTask(ctx, rt)
+
+	return rt.ID, nil
+}
+
+// GetRecurringTask returns a recurring task definition by its ID
+func (k Keeper) GetRecurringTask(ctx sdk.Context, id uint64) (types.RecurringTask, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(recurringKey(id))
+	if bz == nil {
+		return types.RecurringTask{}, false
+	}
+	var rt types.RecurringTask
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rt)
+	return rt, true
+}
+
+// GetRecurringTasks returns all the recurring task definitions ordered by ID
+func (k Keeper) GetRecurringTasks(ctx sdk.Context) []types.RecurringTask {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.RecurringPrefix)
+	defer it.Close()
+
+	result := make([]types.RecurringTask, 0)
+	for ; it.Valid(); it.Next() {
+		var rt types.RecurringTask
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &rt)
+		result = append(result, rt)
+	}
+	return result
+}
+
+// GetRecurringTasksByHandler returns all the recurring task definitions with the handler name ordered by ID
+func (k Keeper) GetRecurringTasksByHandler(ctx sdk.Context, handler string) []types.RecurringTask {
+	store := ctx.KVStore(k.storeKey)
+	prefix := recurringHandlerIndexKey(handler)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	result := make([]types.RecurringTask, 0)
+	for ; it.Valid(); it.Next() {
+		if rt, found := k.GetRecurringTask(ctx, binary.BigEndian.Uint64(it.Key()[len(prefix):])); found {
+			result = append(result, rt)
+		}
+	}
+	return result
+}
+
+// CancelRecurringTask removes a recurring task definition along with its next execution
+func (k Keeper) CancelRecurringTask(ctx sdk.Context, id uint64) error {
+	rt, found := k.GetRecurringTask(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRecurringTaskNotFound, "id: %d", id)
+	}
+	if task, found := k.GetTask(ctx, rt.Occurrence); found && task.RecurringID == id {
+		k.unschedule(ctx, task)
+	}
+	k.deleteRecurringTask(ctx, rt)
+	return nil
+}
+
+// SetRecurringTaskData replaces a recurring task payload, both in its definition and in its next execution
+func (k Keeper) SetRecurringTaskData(ctx sdk.Context, id uint64, data []byte) error {
+	rt, found := k.GetRecurringTask(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRecurringTaskNotFound, "id: %d", id)
+	}
+	if task, found := k.GetTask(ctx, rt.Occurrence); found && task.RecurringID == id {
+		k.unschedule(ctx, task)
+		task.Data = data
+		if task.Time != nil {
+			k.addTimeTask(ctx, *task.Time, task.Task)
+		} else {
+			k.addTask(ctx, task.Height, task.Task)
+		}
+	}
+	rt.Data = data
+	k.setRecurringTask(ctx, rt)
+	return nil
+}
+
+// scheduleNextOccurrence is called when an execution of a recurring task is about to be performed (for the
+// first time, retries don't count). It's done beforehand, so that a failure of the execution doesn't break
+// the chain.
+func (k Keeper) scheduleNextOccurrence(ctx sdk.Context, task types.Task, block uint64) {
+	rt, found := k.GetRecurringTask(ctx, task.RecurringID)
+	if !found || rt.Occurrence != task.ID {
+		return
+	}
+
+	rt.Next += rt.Interval
+	if rt.Next <= block {
+		// the execution was delayed by the per-block task budget
+		rt.Next = block + 1
+	}
+	if rt.End != 0 && rt.Next > rt.End {
+		k.deleteRecurringTask(ctx, rt)
+		return
+	}
+	k.scheduleOccurrence(ctx, &rt)
+	k.setRecurringTask(ctx, rt)
+}
+
+func (k Keeper) scheduleOccurrence(ctx sdk.Context, rt *types.RecurringTask) {
+	task := types.Task{
+		ID:          k.nextTaskID(ctx),
+		HandlerName: rt.HandlerName,
+		Data:        rt.Data,
+		RecurringID: rt.ID,
+	}
+	k.addTask(ctx, rt.Next, task)
+	rt.Occurrence = task.ID
+}
+
+func (k Keeper) setRecurringTask(ctx sdk.Context, rt types.RecurringTask) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(recurringKey(rt.ID), k.cdc.MustMarshalBinaryLengthPrefixed(rt))
+	store.Set(recurringHandlerIndexEntryKey(rt.HandlerName, rt.ID), []byte{})
+}
+
+func (k Keeper) deleteRecurringTask(ctx sdk.Context, rt types.RecurringTask) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(recurringKey(rt.ID))
+	store.Delete(recurringHandlerIndexEntryKey(rt.HandlerName, rt.ID))
+}
+
+func (k Keeper) InitRecurringTasks(ctx sdk.Context, tasks []types.RecurringTask) {
+	nextID := k.GetNextTaskID(ctx)
+	for _, rt := range tasks {
+		k.setRecurringTask(ctx, rt)
+		if rt.ID >= nextID {
+			nextID = rt.ID + 1
+		}
+	}
+	k.SetNextTaskID(ctx, nextID)
+}
+
+func recurringKey(id uint64) []byte {
+	key := make([]byte, len(types.RecurringPrefix)+8)
+	copy(key, types.RecurringPrefix)
+	binary.BigEndian.PutUint64(key[len(types.RecurringPrefix):], id)
+	return key
+}
+
+// recurringHandlerIndexKey is a prefix for the handler's recurring tasks, the name is zero-terminated just like in
+// handlerIndexKey
+func recurringHandlerIndexKey(handler string) []byte {
+	key := append(append([]byte(nil), types.RecurringHandlerIndexPrefix...), handler...)
+	return append(key, 0x00)
+}
+
+func recurringHandlerIndexEntryKey(handler string, id uint64) []byte {
+	prefix := recurringHandlerIndexKey(handler)
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], id)
+	return key
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/x/schedule/types"
+)
+
+// ScheduleTaskAt schedules an event to be performed in the first block which header time is not before t.
+// Returns a unique ID of the task scheduled.
+func (k Keeper) ScheduleTaskAt(ctx sdk.Context, t time.Time, event string, data *[]byte) (uint64, error) {
+	if !t.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidTime, "%s <= %s", t.Format(time.RFC3339), ctx.BlockTime().Format(time.RFC3339))
+	}
+
+	task := types.Task{
+		ID:          k.nextTaskID(ctx),
+		HandlerName: event,
+		Data:        *data,
+	}
+	k.addTimeTask(ctx, t, task)
+
+	return task.ID, nil
+}
+
+// RescheduleTaskAt moves a task (either height- or time-keyed one) to another time. An execution of a recurring task
+// cannot be moved, for recurring tasks are height-keyed.
+func (k Keeper) RescheduleTaskAt(ctx sdk.Context, id uint64, t time.Time) error {
+	if !t.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidTime, "%s <= %s", t.Format(time.RFC3339), ctx.BlockTime().Format(time.RFC3339))
+	}
+	task, found := k.GetTask(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTaskNotFound, "id: %d", id)
+	}
+	if task.RecurringID != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidRecurrence, "task %d is an execution of recurring task %d", id, task.RecurringID)
+	}
+	k.unschedule(ctx, task)
+	k.addTimeTask(ctx, t, task.Task)
+	return nil
+}
+
+// PerformTimeSchedule performs the time-keyed tasks which time has come (up to MaxTasksPerBlock of them)
+func (k Keeper) PerformTimeSchedule(ctx sdk.Context) {
+	k.performTimeTasks(ctx, k.taskLimit(ctx))
+}
+
+// performTimeTasks performs up to limit (negative means no limit) time-keyed tasks which time has come, the
+// earliest first. Returns how many tasks are performed and whether any due ones are left for the next blocks.
+// Due tasks are read one at a time, so that the ones exceeding the limit aren't even loaded.
+func (k Keeper) performTimeTasks(ctx sdk.Context, limit int) (performed int, pending bool) {
+	now := ctx.BlockTime()
+	if now.IsZero() {
+		return 0, false
+	}
+	block := uint64(ctx.BlockHeight())
+	store := ctx.KVStore(k.storeKey)
+	end := timeTaskKey(now.Add(time.Nanosecond), 0)
+
+	var maxRetries uint32
+	k.paramspace.Get(ctx, types.KeyMaxRetries, &maxRetries)
+
+	for {
+		// A task can cancel or reschedule other ones, so the iterator is reopened each time
+		it := store.Iterator(types.TimeTaskPrefix, end)
+		if !it.Valid() {
+			it.Close()
+			return performed, false
+		}
+		if limit >= 0 && performed >= limit {
+			it.Close()
+			return performed, true
+		}
+		key := it.Key()
+		var task types.Task
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &task)
+		it.Close()
+
+		store.Delete(key)
+		k.deleteTimeIndex(ctx, timeFromKey(key), task)
+
+		k.performTask(ctx, block, task, maxRetries)
+		performed++
+	}
+}
+
+func (k Keeper) addTimeTask(ctx sdk.Context, t time.Time, task types.Task) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(timeTaskKey(t, task.ID), k.cdc.MustMarshalBinaryBare(task))
+	k.setTimeIndex(ctx, t, task)
+}
+
+func (k Keeper) getTimeTask(ctx sdk.Context, id uint64) (types.ScheduledTask, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(timeTaskIDKey(id))
+	if bz == nil {
+		return types.ScheduledTask{}, false
+	}
+	t := time.Unix(0, int64(binary.BigEndian.Uint64(bz))).UTC()
+
+	bz = store.Get(timeTaskKey(t, id))
+	if bz == nil {
+		return types.ScheduledTask{}, false
+	}
+	var task types.Task
+	k.cdc.MustUnmarshalBinaryBare(bz, &task)
+	return types.ScheduledTask{Task: task, Time: &t}, true
+}
+
+func (k Keeper) removeTimeTask(ctx sdk.Context, t time.Time, task types.Task) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(timeTaskKey(t, task.ID))
+	k.deleteTimeIndex(ctx, t, task)
+}
+
+func (k Keeper) ExportTimeTasks(ctx sdk.Context) []types.GenesisTimeTask {
+	var result []types.GenesisTimeTask
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.TimeTaskPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var task types.Task
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &task)
+		result = append(result, types.GenesisTimeTask{
+			Task: task,
+			Time: timeFromKey(it.Key()),
+		})
+	}
+	return result
+}
+
+func (k Keeper) InitTimeTasks(ctx sdk.Context, tasks []types.GenesisTimeTask) {
+	nextID := k.GetNextTaskID(ctx)
+	for _, task := range tasks {
+		k.addTimeTask(ctx, task.Time, task.Task)
+		if task.ID >= nextID {
+			nextID = task.ID + 1
+		}
+	}
+	k.SetNextTaskID(ctx, nextID)
+}
+
+func timeTaskKey(t time.Time, id uint64) []byte {
+	key := make([]byte, len(types.TimeTaskPrefix)+16)
+	copy(key, types.TimeTaskPrefix)
+	binary.BigEndian.PutUint64(key[len(types.TimeTaskPrefix):], uint64(t.UnixNano()))
+	binary.BigEndian.PutUint64(key[len(types.TimeTaskPrefix)+8:], id)
+	return key
+}
+
+func timeFromKey(key []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key[len(types.TimeTaskPrefix):]))).UTC()
+}
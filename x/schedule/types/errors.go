@@ -1,6 +1,14 @@
 package types
 
-// You can see how they are constructed below:
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 var (
-//ErrInvalid = sdkerrors.Register(ModuleName, 1, "custom error message")
+	ErrTaskNotFound  = sdkerrors.Register(ModuleName, 1, "task not found")
+	ErrInvalidHeight = sdkerrors.Register(ModuleName, 2, "task cannot be scheduled on a past block")
+	ErrInvalidTime   = sdkerrors.Register(ModuleName, 3, "task cannot be scheduled in the past")
+
+	ErrRecurringTaskNotFound = sdkerrors.Register(ModuleName, 4, "recurring task not found")
+	ErrInvalidRecurrence     = sdkerrors.Register(ModuleName, 5, "invalid recurring task")
 )
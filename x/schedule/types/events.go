@@ -2,5 +2,17 @@ package types
 
 // schedule module event types
 const (
+	EventTypeTaskExecuted = "task_executed"
+	EventTypeTaskFailed   = "task_failed"
+	EventTypeBacklog      = "task_backlog"
+
+	AttributeKeyHandler  = "handler"
+	AttributeKeyHeight   = "height"
+	AttributeKeyAttempts = "attempts"
+	AttributeKeyError    = "error"
+	AttributeKeyRetry    = "retry"
+	AttributeKeyCarried  = "carried"
+	AttributeKeyPending  = "pending"
+
 	AttributeValueCategory = ModuleName
 )
@@ -1,11 +1,21 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // GenesisState - all schedule state that must be provided at genesis
 type GenesisState struct {
 	Params Params            `json:"params"`
 	Tasks  []GenesisSchedule `json:"tasks"`
+	Failed []FailedTask      `json:"failed,omitempty"`
+	// NextTaskID is an ID the next scheduled task will get
+	NextTaskID uint64 `json:"next_task_id,omitempty"`
+	// TimeTasks are tasks keyed by block time
+	TimeTasks []GenesisTimeTask `json:"time_tasks,omitempty"`
+	// Recurring are recurring task definitions (their next executions are in Tasks)
+	Recurring []RecurringTask `json:"recurring,omitempty"`
 }
 
 type GenesisSchedule struct {
@@ -13,17 +23,28 @@ type GenesisSchedule struct {
 	Height uint64 `json:"height"`
 }
 
+type GenesisTimeTask struct {
+	Task
+	Time time.Time `json:"time"`
+}
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, tasks []GenesisSchedule) GenesisState {
+func NewGenesisState(params Params, tasks []GenesisSchedule, failed []FailedTask, nextTaskID uint64, timeTasks []GenesisTimeTask, recurring []RecurringTask) GenesisState {
 	return GenesisState{
-		Params: params,
-		Tasks:  tasks,
+		Params:     params,
+		Tasks:      tasks,
+		Failed:     failed,
+		NextTaskID: nextTaskID,
+		TimeTasks:  timeTasks,
+		Recurring:  recurring,
 	}
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // ValidateGenesis validates the schedule genesis parameters
@@ -35,5 +56,46 @@ func ValidateGenesis(data GenesisState) error {
 		}
 		keys[t.Height] = true
 	}
+	ids := make(map[uint64]bool)
+	for _, sch := range data.Tasks {
+		for _, t := range sch.Schedule {
+			if t.ID == 0 {
+				continue
+			}
+			if ids[t.ID] {
+				return fmt.Errorf("duplicating task ID: %d", t.ID)
+			}
+			ids[t.ID] = true
+		}
+	}
+	for i, t := range data.TimeTasks {
+		if t.ID == 0 {
+			return fmt.Errorf("missing task ID (time_tasks.#%d)", i)
+		}
+		if ids[t.ID] {
+			return fmt.Errorf("duplicating task ID: %d", t.ID)
+		}
+		ids[t.ID] = true
+		if t.HandlerName == "" {
+			return fmt.Errorf("empty handler name (time_tasks.#%d)", i)
+		}
+	}
+	for i, t := range data.Recurring {
+		if t.ID == 0 {
+			return fmt.Errorf("missing task ID (recurring.#%d)", i)
+		}
+		if ids[t.ID] {
+			return fmt.Errorf("duplicating task ID: %d", t.ID)
+		}
+		ids[t.ID] = true
+		if err := t.Validate(); err != nil {
+			return fmt.Errorf("recurring.#%d: %w", i, err)
+		}
+	}
+	for i, t := range data.Failed {
+		if t.HandlerName == "" {
+			return fmt.Errorf("empty handler name (failed.#%d)", i)
+		}
+	}
 	return nil
 }
@@ -13,3 +13,27 @@ const (
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
 )
+
+var (
+	// TaskPrefix is a common prefix of per-block task lists keys. They are just 8-byte big-endian heights, so the
+	// first byte is always zero.
+	TaskPrefix = []byte{0x00}
+	// DeadLetterPrefix is a prefix for failed tasks
+	DeadLetterPrefix = []byte{0x01}
+	// TaskIDPrefix is a prefix for the task ID -> height index
+	TaskIDPrefix = []byte{0x02}
+	// HandlerIndexPrefix is a prefix for the handler name -> task IDs index
+	HandlerIndexPrefix = []byte{0x03}
+	// AccountIndexPrefix is a prefix for the account -> task IDs index (for tasks having an account address as payload)
+	AccountIndexPrefix = []byte{0x04}
+	// NextTaskIDKey is a key the next task ID is stored under
+	NextTaskIDKey = []byte{0x05}
+	// TimeTaskPrefix is a prefix for tasks keyed by block time (deadline in Unix nanoseconds + task ID)
+	TimeTaskPrefix = []byte{0x06}
+	// TimeTaskIDPrefix is a prefix for the time-keyed task ID -> deadline index
+	TimeTaskIDPrefix = []byte{0x07}
+	// RecurringPrefix is a prefix for recurring task definitions
+	RecurringPrefix = []byte{0x08}
+	// RecurringHandlerIndexPrefix is a prefix for the handler name -> recurring task IDs index
+	RecurringHandlerIndexPrefix = []byte{0x09}
+)
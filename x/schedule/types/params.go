@@ -11,9 +11,17 @@ const (
 	DefaultParamspace = ModuleName
 )
 
+// Default parameter values
+const (
+	DefaultMaxRetries       uint32 = 3
+	DefaultMaxTasksPerBlock uint32 = 1000
+)
+
 // Parameter store keys
 var (
-	KeyInitialHeight = []byte("InitialHeight")
+	KeyInitialHeight    = []byte("InitialHeight")
+	KeyMaxRetries       = []byte("MaxRetries")
+	KeyMaxTasksPerBlock = []byte("MaxTasksPerBlock")
 )
 
 // ParamKeyTable for schedule module
@@ -24,30 +32,43 @@ func ParamKeyTable() params.KeyTable {
 // Params - used for initializing default parameter for schedule at genesis
 type Params struct {
 	InitialHeight int64 `json:"initial_height"`
+	// MaxRetries is how many times a failed task is retried (one block later each time) before it's moved
+	// to the dead-letter store
+	MaxRetries uint32 `json:"max_retries"`
+	// MaxTasksPerBlock is how many tasks can be performed in a single block, the rest is carried over to the
+	// next blocks. Zero means no limit.
+	MaxTasksPerBlock uint32 `json:"max_tasks_per_block"`
 }
 
 // NewParams creates a new Params object
-func NewParams() Params {
-	return Params{}
+func NewParams(maxRetries uint32, maxTasksPerBlock uint32) Params {
+	return Params{
+		MaxRetries:       maxRetries,
+		MaxTasksPerBlock: maxTasksPerBlock,
+	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	return fmt.Sprintf(`
 InitialHeight: %d
-	`, p.InitialHeight)
+MaxRetries: %d
+MaxTasksPerBlock: %d
+	`, p.InitialHeight, p.MaxRetries, p.MaxTasksPerBlock)
 }
 
 // ParamSetPairs - Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyInitialHeight, &p.InitialHeight, validateInitialHeight),
+		params.NewParamSetPair(KeyMaxRetries, &p.MaxRetries, validateMaxRetries),
+		params.NewParamSetPair(KeyMaxTasksPerBlock, &p.MaxTasksPerBlock, validateMaxTasksPerBlock),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxRetries, DefaultMaxTasksPerBlock)
 }
 
 func validateInitialHeight(i interface{}) error {
@@ -60,3 +81,19 @@ func validateInitialHeight(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMaxRetries(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("unexpected MaxRetries type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxTasksPerBlock(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("unexpected MaxTasksPerBlock type: %T", i)
+	}
+	return nil
+}
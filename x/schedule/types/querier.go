@@ -1,11 +1,24 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Query endpoints supported by the schedule querier
 const (
 	QueryTasks  = "tasks"
 	QueryParams = "params"
+	QueryFailed = "failed"
+
+	QueryTask           = "task"
+	QueryTasksByHandler = "tasks_by_handler"
+	QueryTasksByAccount = "tasks_by_account"
+
+	QueryRecurring = "recurring"
+	QueryUpcoming  = "upcoming"
 )
 
 type QueryTasksParams struct {
@@ -21,3 +34,94 @@ func NewQueryTasksParams(blockHeight int64) QueryTasksParams {
 		BlockHeight: blockHeight,
 	}
 }
+
+type QueryFailedParams struct {
+	Limit int32 `json:"limit" yaml:"limit"`
+	Page  int32 `json:"page" yaml:"page"`
+}
+
+func NewQueryFailedParams(limit int32, page int32) QueryFailedParams {
+	return QueryFailedParams{
+		Limit: limit,
+		Page:  page,
+	}
+}
+
+type QueryFailedRes []FailedTask
+
+func (res QueryFailedRes) String() string {
+	lines := make([]string, len(res))
+	for i, t := range res {
+		lines[i] = t.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
+
+type QueryTaskParams struct {
+	ID uint64 `json:"id" yaml:"id"`
+}
+
+func NewQueryTaskParams(id uint64) QueryTaskParams {
+	return QueryTaskParams{ID: id}
+}
+
+type QueryTasksByHandlerParams struct {
+	Handler string `json:"handler" yaml:"handler"`
+}
+
+func NewQueryTasksByHandlerParams(handler string) QueryTasksByHandlerParams {
+	return QueryTasksByHandlerParams{Handler: handler}
+}
+
+type QueryTasksByAccountParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewQueryTasksByAccountParams(address sdk.AccAddress) QueryTasksByAccountParams {
+	return QueryTasksByAccountParams{Address: address}
+}
+
+// MaxUpcomingLimit is the largest number of executions the upcoming query returns at once
+const MaxUpcomingLimit = 100
+
+type QueryUpcomingParams struct {
+	ID    uint64 `json:"id" yaml:"id"`
+	Limit int32  `json:"limit" yaml:"limit"`
+}
+
+func NewQueryUpcomingParams(id uint64, limit int32) QueryUpcomingParams {
+	return QueryUpcomingParams{
+		ID:    id,
+		Limit: limit,
+	}
+}
+
+type QueryRecurringRes []RecurringTask
+
+func (res QueryRecurringRes) String() string {
+	lines := make([]string, len(res))
+	for i, t := range res {
+		lines[i] = t.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
+
+type QueryUpcomingRes []uint64
+
+func (res QueryUpcomingRes) String() string {
+	lines := make([]string, len(res))
+	for i, h := range res {
+		lines[i] = fmt.Sprint(h)
+	}
+	return strings.Join(lines, "\n")
+}
+
+type QueryScheduledTasksRes []ScheduledTask
+
+func (res QueryScheduledTasksRes) String() string {
+	lines := make([]string, len(res))
+	for i, t := range res {
+		lines[i] = t.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
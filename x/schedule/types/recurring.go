@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RecurringTask is a definition of a task performed every Interval blocks starting from the Start height.
+// Only the next execution is in the schedule at any moment, the one after it is scheduled when it's performed.
+type RecurringTask struct {
+	ID          uint64 `json:"id" yaml:"id"`
+	HandlerName string `json:"handler_name" yaml:"handler_name"`
+	Data        []byte `json:"data" yaml:"data"`
+	Start       uint64 `json:"start" yaml:"start"`
+	Interval    uint64 `json:"interval" yaml:"interval"`
+	// End is the last block height the task can be performed at, zero means it's performed until cancelled
+	End uint64 `json:"end,omitempty" yaml:"end,omitempty"`
+	// Next is a block height of the next execution
+	Next uint64 `json:"next" yaml:"next"`
+	// Occurrence is an ID of the scheduled task for the next execution
+	Occurrence uint64 `json:"occurrence" yaml:"occurrence"`
+}
+
+func NewRecurringTask(handler string, data []byte, start, interval, end uint64) RecurringTask {
+	return RecurringTask{
+		HandlerName: handler,
+		Data:        data,
+		Start:       start,
+		Interval:    interval,
+		End:         end,
+		Next:        start,
+	}
+}
+
+func (t RecurringTask) Validate() error {
+	if t.HandlerName == "" {
+		return sdkerrors.Wrap(ErrInvalidRecurrence, "empty handler name")
+	}
+	if t.Interval == 0 {
+		return sdkerrors.Wrap(ErrInvalidRecurrence, "zero interval")
+	}
+	if t.End != 0 && t.End < t.Start {
+		return sdkerrors.Wrapf(ErrInvalidRecurrence, "end (%d) is before start (%d)", t.End, t.Start)
+	}
+	return nil
+}
+
+// Upcoming returns block heights of up to n next executions
+func (t RecurringTask) Upcoming(n int) []uint64 {
+	result := make([]uint64, 0)
+	for h := t.Next; len(result) < n && (t.End == 0 || h <= t.End); h += t.Interval {
+		result = append(result, h)
+		if h+t.Interval < h {
+			// the next one would overflow uint64
+			break
+		}
+	}
+	return result
+}
+
+func (t RecurringTask) String() string {
+	return strings.TrimSpace(fmt.Sprintf(
+		"ID: %d\n"+
+			"Handler: %s\n"+
+			"Start: %d\n"+
+			"Interval: %d\n"+
+			"End: %d\n"+
+			"Next: %d\n"+
+			"Data: %X",
+		t.ID,
+		t.HandlerName,
+		t.Start,
+		t.Interval,
+		t.End,
+		t.Next,
+		t.Data,
+	))
+}
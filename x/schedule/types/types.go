@@ -1,8 +1,69 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 type Task struct {
 	HandlerName string `json:"handler_name" yaml:"handler_name"`
 	Data        []byte `json:"data" yaml:"data"`
+	// Attempts is a number of failed attempts to perform the task
+	Attempts uint32 `json:"attempts,omitempty" yaml:"attempts,omitempty"`
+	// ID is a unique task identifier returned by ScheduleTask (tasks scheduled before IDs were introduced get them
+	// during the upgrade)
+	ID uint64 `json:"id,omitempty" yaml:"id,omitempty"`
+	// RecurringID is set for executions of recurring tasks
+	RecurringID uint64 `json:"recurring_id,omitempty" yaml:"recurring_id,omitempty"`
 }
 
 type Schedule []Task
+
+// ScheduledTask is a task along with a block height (or a block time) it's scheduled on
+type ScheduledTask struct {
+	Task
+	Height uint64 `json:"height,omitempty" yaml:"height,omitempty"`
+	// Time is set for time-keyed tasks, they are performed in the first block with header time not before it
+	Time *time.Time `json:"time,omitempty" yaml:"time,omitempty"`
+}
+
+func (t ScheduledTask) String() string {
+	when := fmt.Sprintf("Height: %d", t.Height)
+	if t.Time != nil {
+		when = fmt.Sprintf("Time: %s", t.Time.Format(time.RFC3339))
+	}
+	return strings.TrimSpace(fmt.Sprintf(
+		"ID: %d\n"+
+			"Handler: %s\n"+
+			"%s\n"+
+			"Data: %X",
+		t.ID,
+		t.HandlerName,
+		when,
+		t.Data,
+	))
+}
+
+// FailedTask is a task that failed all its attempts and was moved to the dead-letter store
+type FailedTask struct {
+	Task
+	// Height is a block height the last attempt was made at
+	Height uint64 `json:"height" yaml:"height"`
+	Error  string `json:"error" yaml:"error"`
+}
+
+func (t FailedTask) String() string {
+	return strings.TrimSpace(fmt.Sprintf(
+		"ID: %d\n"+
+			"Handler: %s\n"+
+			"Height: %d\n"+
+			"Attempts: %d\n"+
+			"Error: %s",
+		t.ID,
+		t.HandlerName,
+		t.Height,
+		t.Attempts,
+		t.Error,
+	))
+}
@@ -12,23 +12,38 @@ const (
 	DefaultParamspace = types.DefaultParamspace
 	//QueryParams       = types.QueryParams
 	QuerierRoute = types.QuerierRoute
+
+	HookQuotaExceeded = types.HookQuotaExceeded
+	MaxUsageHistory   = types.MaxUsageHistory
 )
 
 var (
 	// functions aliases
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
-	RegisterCodec       = types.RegisterCodec
-	NewGenesisState     = types.NewGenesisState
-	DefaultGenesisState = types.DefaultGenesisState
-	ValidateGenesis     = types.ValidateGenesis
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	NewMsgSetStorageData = types.NewMsgSetStorageData
+	NewParams            = types.NewParams
+	DefaultParams        = types.DefaultParams
+	NewMsgReportUsage    = types.NewMsgReportUsage
+	NewMsgSetManifest    = types.NewMsgSetManifest
+	RegisterCodec        = types.RegisterCodec
+	NewGenesisState      = types.NewGenesisState
+	DefaultGenesisState  = types.DefaultGenesisState
+	ValidateGenesis      = types.ValidateGenesis
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
+
+	// error aliases
+	ErrNegativeUsage    = types.ErrNegativeUsage
+	ErrInvalidManifest  = types.ErrInvalidManifest
+	ErrManifestConflict = types.ErrManifestConflict
 )
 
 type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
 	Params       = types.Params
+	UsageRecord  = types.UsageRecord
+	Manifest     = types.Manifest
 )
@@ -0,0 +1,12 @@
+package cli
+
+const (
+	FlagLimit = "limit"
+	FlagPage  = "page"
+
+	FlagEncryption   = "encryption"
+	FlagPrevRootHash = "prev-root-hash"
+
+	FlagLimitDefault = int(30)
+	FlagPageDefault  = int(1)
+)
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/arterynetwork/artr/x/storage/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -27,6 +28,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		flags.GetCommands(
 			GetDataCmd(queryRoute, cdc),
 			GetInfoCmd(queryRoute, cdc),
+			GetUsageCmd(queryRoute, cdc),
+			GetManifestCmd(queryRoute, cdc),
+			GetManifestHistoryCmd(queryRoute, cdc),
+			getCmdParams(queryRoute, cdc),
 		)...,
 	)
 
@@ -92,3 +97,125 @@ func GetInfoCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetUsageCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage <address>",
+		Short: "Query storage usage history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryUsageParams(
+				addr,
+				int32(viper.GetInt(FlagLimit)),
+				int32(viper.GetInt(FlagPage)),
+			))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryUsage), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryUsageRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of history records per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
+
+func getCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "params",
+		Aliases: []string{"p"},
+		Short:   "Get the module params",
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetManifestCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest <address>",
+		Short: "Query current storage manifest",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryStorageParams(addr))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryManifest), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryManifestRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
+
+func GetManifestHistoryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest-history <address>",
+		Short: "Query previous storage manifests",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryManifestHistoryParams(
+				addr,
+				int32(viper.GetInt(FlagLimit)),
+				int32(viper.GetInt(FlagPage)),
+			))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryManifestHistory), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryManifestHistoryRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of history records per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
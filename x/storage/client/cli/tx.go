@@ -2,7 +2,6 @@ package cli
 
 import (
 	"bufio"
-	b64 "encoding/base64"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,7 +10,9 @@ import (
 	"strconv"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 	"github.com/arterynetwork/artr/x/storage/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -29,39 +30,85 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 
 	storageTxCmd.AddCommand(flags.PostCommands(
-		GetSetStorageDataCmd(cdc),
+		GetReportUsageCmd(cdc),
+		GetSetManifestCmd(cdc),
 	)...)
 
 	return storageTxCmd
 }
 
-// GetSetProfileCmd will create a send tx and sign it with the given key.
-func GetSetStorageDataCmd(cdc *codec.Codec) *cobra.Command {
+func GetReportUsageCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "data [size] [base64_data]",
-		Short: "Create and sign a set data tx",
+		Use:   "report-usage [address] [current]",
+		Short: "Report storage space (in bytes) used by an account",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			size, err := strconv.ParseInt(args[0], 10, 64)
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
 
-			bz, err := b64.StdEncoding.DecodeString(args[1])
+			current, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReportUsage(cliCtx.FromAddress, addr, current)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func GetSetManifestCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest [root_hash] [file_count] [total_size]",
+		Short: "Create and sign a set storage manifest tx",
+		Long: `Set a new storage directory manifest.
+The root hash is a hex-encoded SHA-256 hash, total size is in bytes.
+Use --prev-root-hash to make sure the manifest hasn't been changed by another device since the last sync.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			fileCount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
 
-			if len(bz) > 10*1024 {
-				return types.ErrDataToLong
+			totalSize, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
 			}
 
-			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgSetStorageData(cliCtx.FromAddress, size, args[1])
+			manifest := types.Manifest{
+				Version:    types.ManifestVersion,
+				RootHash:   args[0],
+				FileCount:  fileCount,
+				TotalSize:  totalSize,
+				Encryption: viper.GetString(FlagEncryption),
+			}
+			msg := types.NewMsgSetManifest(cliCtx.FromAddress, manifest, viper.GetString(FlagPrevRootHash))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
+	cmd.Flags().String(FlagEncryption, "", "Encryption scheme the files are encrypted with")
+	cmd.Flags().String(FlagPrevRootHash, "", "Expected root hash of the current manifest")
+	_ = cmd.MarkFlagRequired(FlagEncryption)
+
 	return cmd
 }
@@ -11,6 +11,7 @@ import (
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	k.Logger(ctx).Info("Starting from genesis...")
+	k.SetParams(ctx, data.Params)
 	for _, limit := range data.Limits {
 		k.SetLimit(ctx, limit.Account, int64(limit.Volume))
 	}
@@ -24,11 +25,28 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		}
 		k.SetData(ctx, d.Account, bz)
 	}
+	for _, record := range data.UsageHistory {
+		k.SetUsageRecord(ctx, record.Account, record.Seq, record.UsageRecord)
+	}
+	for _, m := range data.Manifests {
+		k.ImportManifest(ctx, m.Account, m.Manifest)
+	}
+	for _, m := range data.ManifestHistory {
+		k.SetManifestHistoryRecord(ctx, m.Account, m.Seq, m.Manifest)
+	}
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
-	return NewGenesisState(k.ExportLimits(ctx), k.ExportCurrent(ctx), k.ExportData(ctx))
+	return NewGenesisState(
+		k.GetParams(ctx),
+		k.ExportLimits(ctx),
+		k.ExportCurrent(ctx),
+		k.ExportData(ctx),
+		k.ExportUsageHistory(ctx),
+		k.ExportManifests(ctx),
+		k.ExportManifestHistory(ctx),
+	)
 }
@@ -3,6 +3,8 @@
 package storage_test
 
 import (
+	"bytes"
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -10,6 +12,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/arterynetwork/artr/app"
 	"github.com/arterynetwork/artr/util"
@@ -51,16 +54,76 @@ func (s Suite) TestFullData() {
 	s.checkExportImport()
 }
 
+func (s Suite) TestUsageHistory() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	user4 := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user1, 5*util.GBSize)
+	s.NoError(s.k.ReportUsage(s.ctx, user1, 3*util.GBSize))
+	s.NoError(s.k.ReportUsage(s.ctx.WithBlockHeight(2), user1, 4*util.GBSize))
+	s.NoError(s.k.ReportUsage(s.ctx, user4, util.GBSize))
+	s.checkExportImport()
+}
+
+func (s Suite) TestUsageHistory_SameBlock() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	s.k.SetLimit(s.ctx, user1, 5*util.GBSize)
+	for i := int64(1); i <= 3; i++ {
+		s.NoError(s.k.ReportUsage(s.ctx, user1, i*util.GBSize))
+	}
+	s.checkExportImport()
+}
+
+func (s Suite) TestManifests() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	s.k.SetLimit(s.ctx, user1, 5*util.GBSize)
+	for i := int64(1); i <= 3; i++ {
+		s.NoError(s.k.SetManifest(s.ctx.WithBlockHeight(i), user1, storage.Manifest{
+			Version:    1,
+			RootHash:   hex.EncodeToString(bytes.Repeat([]byte{byte(i)}, 32)),
+			FileCount:  uint64(i),
+			TotalSize:  i * 1000,
+			Encryption: "aes-256-gcm",
+		}, ""))
+	}
+	s.checkExportImport()
+}
+
+func (s Suite) TestManifests_SameBlock() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	s.k.SetLimit(s.ctx, user1, 5*util.GBSize)
+	for i := int64(1); i <= 3; i++ {
+		s.NoError(s.k.SetManifest(s.ctx, user1, storage.Manifest{
+			Version:    1,
+			RootHash:   hex.EncodeToString(bytes.Repeat([]byte{byte(i)}, 32)),
+			FileCount:  uint64(i),
+			TotalSize:  i * 1000,
+			Encryption: "aes-256-gcm",
+		}, ""))
+	}
+	s.checkExportImport()
+}
+
+func (s Suite) TestParams() {
+	s.k.SetParams(s.ctx, storage.NewParams(
+		[]sdk.AccAddress{app.DefaultGenesisUsers["user3"]},
+		util.Percent(75),
+	))
+	s.checkExportImport()
+}
+
 func (s Suite) checkExportImport() {
 	s.app.CheckExportImport(s.T(),
 		[]string{
 			storage.StoreKey,
+			params.StoreKey,
 		},
 		map[string]app.Decoder{
 			storage.StoreKey: app.DummyDecoder,
+			params.StoreKey:  app.DummyDecoder,
 		},
 		map[string]app.Decoder{
 			storage.StoreKey: app.DummyDecoder,
+			params.StoreKey:  app.DummyDecoder,
 		},
 		make(map[string][][]byte, 0),
 	)
@@ -4,8 +4,6 @@ import (
 	"github.com/arterynetwork/artr/x/storage/types"
 	"fmt"
 
-	b64 "encoding/base64"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -16,7 +14,11 @@ func NewHandler(k Keeper) sdk.Handler {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
 		case types.MsgSetStorageData:
-			return handleMsgSetStorageData(ctx, k, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "storage data blob is deprecated, use MsgSetManifest instead")
+		case types.MsgReportUsage:
+			return handleMsgReportUsage(ctx, k, msg)
+		case types.MsgSetManifest:
+			return handleMsgSetManifest(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -24,19 +26,19 @@ func NewHandler(k Keeper) sdk.Handler {
 	}
 }
 
-// handleMsgPaySubscription process payments for subscription
-func handleMsgSetStorageData(ctx sdk.Context, k Keeper, msg types.MsgSetStorageData) (*sdk.Result, error) {
-	bz, err := b64.StdEncoding.DecodeString(msg.Data)
-
-	if len(bz) > 10*1024 {
-		return nil, types.ErrDataToLong
+func handleMsgReportUsage(ctx sdk.Context, k Keeper, msg types.MsgReportUsage) (*sdk.Result, error) {
+	if !k.IsSigner(ctx, msg.Sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s is not in allowed sender list", msg.Sender.String())
 	}
-
-	if err != nil {
+	if err := k.ReportUsage(ctx, msg.Address, msg.Current); err != nil {
 		return nil, err
 	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
 
-	k.SetData(ctx, msg.Address, bz)
-	k.SetCurrent(ctx, msg.Address, msg.Size)
+func handleMsgSetManifest(ctx sdk.Context, k Keeper, msg types.MsgSetManifest) (*sdk.Result, error) {
+	if err := k.SetManifest(ctx, msg.Address, msg.Manifest, msg.PrevRootHash); err != nil {
+		return nil, err
+	}
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
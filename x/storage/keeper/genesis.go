@@ -59,3 +59,62 @@ func (k Keeper) ExportData(ctx sdk.Context) []types.Data {
 	}
 	return result
 }
+
+func (k Keeper) ExportUsageHistory(ctx sdk.Context) []types.GenesisUsage {
+	var result []types.GenesisUsage
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, usagePrefix)
+	defer it.Close()
+	offset := len(usagePrefix) + len(auth.AddressStoreKeyPrefix)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		var record types.UsageRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &record)
+		result = append(result, types.GenesisUsage{
+			UsageRecord: record,
+			Account:     sdk.AccAddress(key[offset : len(key)-8]),
+			Seq:         binary.BigEndian.Uint64(key[len(key)-8:]),
+		})
+	}
+	return result
+}
+
+func (k Keeper) ExportManifests(ctx sdk.Context) []types.GenesisManifest {
+	var result []types.GenesisManifest
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, manifestPrefix)
+	defer it.Close()
+	offset := len(manifestPrefix) + len(auth.AddressStoreKeyPrefix)
+	for ; it.Valid(); it.Next() {
+		var manifest types.Manifest
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &manifest)
+		result = append(result, types.GenesisManifest{
+			Manifest: manifest,
+			Account:  sdk.AccAddress(it.Key()[offset:]),
+		})
+	}
+	return result
+}
+
+func (k Keeper) ExportManifestHistory(ctx sdk.Context) []types.GenesisManifest {
+	var result []types.GenesisManifest
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, manifestHistoryPrefix)
+	defer it.Close()
+	offset := len(manifestHistoryPrefix) + len(auth.AddressStoreKeyPrefix)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		var manifest types.Manifest
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &manifest)
+		result = append(result, types.GenesisManifest{
+			Manifest: manifest,
+			Account:  sdk.AccAddress(key[offset : len(key)-8]),
+			Seq:      binary.BigEndian.Uint64(key[len(key)-8:]),
+		})
+	}
+	return result
+}
+
+func (k Keeper) ImportManifest(ctx sdk.Context, addr sdk.AccAddress, manifest types.Manifest) {
+	k.setManifest(ctx, addr, manifest)
+}
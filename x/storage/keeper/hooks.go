@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k *Keeper) AddHook(eventName string, callback func(ctx sdk.Context, acc sdk.AccAddress) error) {
+	lst, found := k.eventHooks[eventName]
+	if !found {
+		lst = make([]func(ctx sdk.Context, acc sdk.AccAddress) error, 0, 1)
+	}
+	lst = append(lst, callback)
+	k.eventHooks[eventName] = lst
+}
+
+func (k Keeper) callback(eventName string, ctx sdk.Context, acc sdk.AccAddress) error {
+	lst, found := k.eventHooks[eventName]
+	if !found {
+		return nil
+	}
+	for _, hook := range lst {
+		if err := hook(ctx, acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
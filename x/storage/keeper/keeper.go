@@ -7,6 +7,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/storage/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,10 @@ var (
 	currentPrefix = []byte{0x00}
 	limitPrefix   = []byte{0x01}
 	dirPrefix     = []byte{0x02}
+	usagePrefix   = []byte{0x03}
+
+	manifestPrefix        = []byte{0x04}
+	manifestHistoryPrefix = []byte{0x05}
 )
 
 // Keeper of the storage store
@@ -23,6 +28,7 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	cdc        *codec.Codec
 	paramspace types.ParamSubspace
+	eventHooks map[string][]func(ctx sdk.Context, acc sdk.AccAddress) error
 }
 
 // NewKeeper creates a storage keeper
@@ -31,6 +37,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramspace types.ParamSubspac
 		storeKey:   key,
 		cdc:        cdc,
 		paramspace: paramspace.WithKeyTable(types.ParamKeyTable()),
+		eventHooks: make(map[string][]func(ctx sdk.Context, acc sdk.AccAddress) error),
 	}
 	return keeper
 }
@@ -40,6 +47,22 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// MigrateAccount moves all the account's storage data (limit, usage, directory, manifest and their histories)
+// to a new address
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{
+		currentPrefix, limitPrefix, dirPrefix, usagePrefix, manifestPrefix, manifestHistoryPrefix,
+	} {
+		util.MoveKeys(
+			store,
+			append(append([]byte(nil), prefix...), auth.AddressStoreKey(from)...),
+			append(append([]byte(nil), prefix...), auth.AddressStoreKey(to)...),
+		)
+	}
+	return nil
+}
+
 func (k Keeper) AddLimit(ctx sdk.Context, addr sdk.AccAddress, volume int64) (int64, error) {
 	limit := k.GetLimit(ctx, addr)
 	limit += volume
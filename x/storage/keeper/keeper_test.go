@@ -0,0 +1,283 @@
+// +build testing
+
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/app"
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/storage"
+)
+
+func TestStorageKeeper(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+type Suite struct {
+	suite.Suite
+
+	app     *app.ArteryApp
+	cleanup func()
+	ctx     sdk.Context
+	k       storage.Keeper
+	handler sdk.Handler
+}
+
+func (s *Suite) SetupTest() {
+	s.app, s.cleanup = app.NewAppFromGenesis(nil)
+	s.ctx = s.app.NewContext(true, abci.Header{Height: 1})
+	s.k = s.app.GetStorageKeeper()
+	s.handler = storage.NewHandler(s.k)
+}
+
+func (s *Suite) TearDownTest() {
+	s.cleanup()
+}
+
+func (s Suite) TestReportUsage() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 10*util.GBSize)
+
+	res, err := s.handler(s.ctx, storage.NewMsgReportUsage(app.DefaultGenesisUsers["user1"], user, 5*util.GBSize))
+	s.NoError(err)
+	s.False(hasEvent(res.Events, "storage_near_quota"))
+	s.False(hasEvent(res.Events, "storage_quota_exceeded"))
+
+	res, err = s.handler(s.ctx, storage.NewMsgReportUsage(app.DefaultGenesisUsers["user2"], user, 9*util.GBSize))
+	s.NoError(err)
+	s.True(hasEvent(res.Events, "storage_near_quota"))
+	s.False(hasEvent(res.Events, "storage_quota_exceeded"))
+
+	res, err = s.handler(s.ctx, storage.NewMsgReportUsage(app.DefaultGenesisUsers["user1"], user, 11*util.GBSize))
+	s.NoError(err)
+	s.False(hasEvent(res.Events, "storage_near_quota"))
+	s.True(hasEvent(res.Events, "storage_quota_exceeded"))
+
+	s.Equal(int64(11*util.GBSize), s.k.GetCurrent(s.ctx, user))
+	s.Equal(int64(10*util.GBSize), s.k.GetLimit(s.ctx, user))
+}
+
+func (s Suite) TestReportUsage_Unauthorized() {
+	user := app.DefaultGenesisUsers["user4"]
+	_, err := s.handler(s.ctx, storage.NewMsgReportUsage(app.DefaultGenesisUsers["user3"], user, util.GBSize))
+	s.Error(err)
+	s.Zero(s.k.GetCurrent(s.ctx, user))
+}
+
+func (s Suite) TestAutoBuy() {
+	user := app.DefaultGenesisUsers["user1"]
+	s.NoError(s.app.GetSubscriptionKeeper().PayForSubscription(s.ctx, user, 5*util.GBSize))
+	s.Equal(int64(5*util.GBSize), s.k.GetLimit(s.ctx, user))
+
+	_, err := s.handler(s.ctx, storage.NewMsgReportUsage(app.DefaultGenesisUsers["user2"], user, 6*util.GBSize+1))
+	s.NoError(err)
+	s.Equal(int64(5*util.GBSize), s.k.GetLimit(s.ctx, user))
+
+	pk := s.app.GetProfileKeeper()
+	profile := pk.GetProfile(s.ctx, user)
+	profile.StorageAutoBuy = true
+	s.NoError(pk.SetProfile(s.ctx, user, *profile))
+
+	res, err := s.handler(s.ctx, storage.NewMsgReportUsage(app.DefaultGenesisUsers["user2"], user, 6*util.GBSize+1))
+	s.NoError(err)
+	s.True(hasEvent(res.Events, "transfer"))
+	s.Equal(int64(7*util.GBSize), s.k.GetLimit(s.ctx, user))
+}
+
+func (s Suite) TestAutoBuy_Failed() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 5*util.GBSize)
+
+	sk := s.app.GetSubscriptionKeeper()
+	info := sk.GetActivityInfo(s.ctx, user)
+	info.Active = false
+	info.ExpireAt = 0
+	sk.SetActivityInfo(s.ctx, user, info)
+
+	pk := s.app.GetProfileKeeper()
+	profile := pk.GetProfile(s.ctx, user)
+	profile.StorageAutoBuy = true
+	s.NoError(pk.SetProfile(s.ctx, user, *profile))
+
+	res, err := s.handler(s.ctx, storage.NewMsgReportUsage(app.DefaultGenesisUsers["user2"], user, 6*util.GBSize))
+	s.NoError(err)
+	s.True(hasEvent(res.Events, "storage_auto_buy_failed"))
+	s.Equal(int64(5*util.GBSize), s.k.GetLimit(s.ctx, user))
+	s.Equal(int64(6*util.GBSize), s.k.GetCurrent(s.ctx, user))
+}
+
+func (s Suite) TestUsageHistory() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 10*util.GBSize)
+
+	for i := int64(1); i <= 3; i++ {
+		s.ctx = s.ctx.WithBlockHeight(i * 10)
+		s.NoError(s.k.ReportUsage(s.ctx, user, i*util.GBSize))
+	}
+
+	s.Equal([]storage.UsageRecord{
+		{Height: 30, Current: 3 * util.GBSize, Limit: 10 * util.GBSize},
+		{Height: 20, Current: 2 * util.GBSize, Limit: 10 * util.GBSize},
+	}, s.k.GetUsageHistory(s.ctx, user, 2, 1))
+	s.Equal([]storage.UsageRecord{
+		{Height: 10, Current: 1 * util.GBSize, Limit: 10 * util.GBSize},
+	}, s.k.GetUsageHistory(s.ctx, user, 2, 2))
+}
+
+func (s Suite) TestUsageHistory_SameBlock() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 10*util.GBSize)
+
+	for i := int64(1); i <= 3; i++ {
+		s.NoError(s.k.ReportUsage(s.ctx, user, i*util.GBSize))
+	}
+
+	s.Equal([]storage.UsageRecord{
+		{Height: 1, Current: 3 * util.GBSize, Limit: 10 * util.GBSize},
+		{Height: 1, Current: 2 * util.GBSize, Limit: 10 * util.GBSize},
+		{Height: 1, Current: 1 * util.GBSize, Limit: 10 * util.GBSize},
+	}, s.k.GetUsageHistory(s.ctx, user, 10, 1))
+}
+
+func (s Suite) TestUsageHistory_Pruned() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 10*util.GBSize)
+
+	for i := int64(1); i <= storage.MaxUsageHistory+5; i++ {
+		s.ctx = s.ctx.WithBlockHeight(i)
+		s.NoError(s.k.ReportUsage(s.ctx, user, i))
+	}
+
+	history := s.k.GetUsageHistory(s.ctx, user, 1000, 1)
+	s.Len(history, storage.MaxUsageHistory)
+	s.Equal(int64(storage.MaxUsageHistory+5), history[0].Height)
+	s.Equal(int64(6), history[storage.MaxUsageHistory-1].Height)
+}
+
+func (s Suite) TestSetData_Rejected() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 5*util.GBSize)
+	s.NoError(s.k.ReportUsage(s.ctx, user, util.GBSize))
+
+	_, err := s.handler(s.ctx, storage.NewMsgSetStorageData(user, 0, "AAECAw=="))
+	s.Error(err)
+	s.Empty(s.k.GetData(s.ctx, user))
+	s.Equal(int64(util.GBSize), s.k.GetCurrent(s.ctx, user))
+}
+
+func (s Suite) TestSetManifest() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 5*util.GBSize)
+
+	first := newManifest(0x01, 3, 1000)
+	_, err := s.handler(s.ctx, storage.NewMsgSetManifest(user, first, ""))
+	s.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(2)
+	second := newManifest(0x02, 4, 2000)
+	_, err = s.handler(s.ctx, storage.NewMsgSetManifest(user, second, first.RootHash))
+	s.NoError(err)
+
+	second.UpdatedAt = 2
+	s.Equal(&second, s.k.GetManifest(s.ctx, user))
+
+	first.UpdatedAt = 1
+	s.Equal([]storage.Manifest{first}, s.k.GetManifestHistory(s.ctx, user, 10, 1))
+}
+
+func (s Suite) TestSetManifest_Conflict() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 5*util.GBSize)
+
+	s.NoError(s.k.SetManifest(s.ctx, user, newManifest(0x01, 3, 1000), ""))
+	stale := newManifest(0x02, 3, 1000).RootHash
+
+	_, err := s.handler(s.ctx, storage.NewMsgSetManifest(user, newManifest(0x03, 4, 2000), stale))
+	s.True(storage.ErrManifestConflict.Is(err))
+	s.Equal(newManifest(0x01, 3, 1000).RootHash, s.k.GetManifest(s.ctx, user).RootHash)
+}
+
+func (s Suite) TestSetManifest_OverLimit() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 5*util.GBSize)
+
+	_, err := s.handler(s.ctx, storage.NewMsgSetManifest(user, newManifest(0x01, 3, 5*util.GBSize+1), ""))
+	s.Error(err)
+	s.Nil(s.k.GetManifest(s.ctx, user))
+}
+
+func (s Suite) TestSetManifest_Invalid() {
+	user := app.DefaultGenesisUsers["user4"]
+
+	m := newManifest(0x01, 3, 1000)
+	m.Version = 2
+	s.Error(storage.NewMsgSetManifest(user, m, "").ValidateBasic())
+
+	m = newManifest(0x01, 3, 1000)
+	m.RootHash = "abcd"
+	s.Error(storage.NewMsgSetManifest(user, m, "").ValidateBasic())
+
+	m = newManifest(0x01, 0, 1000)
+	s.Error(storage.NewMsgSetManifest(user, m, "").ValidateBasic())
+
+	m = newManifest(0x01, 3, 1000)
+	m.Encryption = ""
+	s.Error(storage.NewMsgSetManifest(user, m, "").ValidateBasic())
+}
+
+func (s Suite) TestManifestHistory_Pruned() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 5*util.GBSize)
+
+	for i := int64(1); i <= 15; i++ {
+		s.ctx = s.ctx.WithBlockHeight(i)
+		s.NoError(s.k.SetManifest(s.ctx, user, newManifest(byte(i), uint64(i), i), ""))
+	}
+
+	history := s.k.GetManifestHistory(s.ctx, user, 100, 1)
+	s.Len(history, 10)
+	s.Equal(int64(14), history[0].UpdatedAt)
+	s.Equal(int64(5), history[9].UpdatedAt)
+}
+
+func (s Suite) TestManifestHistory_SameBlock() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.SetLimit(s.ctx, user, 5*util.GBSize)
+
+	for i := byte(1); i <= 3; i++ {
+		s.NoError(s.k.SetManifest(s.ctx, user, newManifest(i, 3, 1000), ""))
+	}
+
+	history := s.k.GetManifestHistory(s.ctx, user, 10, 1)
+	s.Len(history, 2)
+	s.Equal(newManifest(0x02, 3, 1000).RootHash, history[0].RootHash)
+	s.Equal(newManifest(0x01, 3, 1000).RootHash, history[1].RootHash)
+}
+
+func newManifest(seed byte, files uint64, size int64) storage.Manifest {
+	return storage.Manifest{
+		Version:    1,
+		RootHash:   hex.EncodeToString(bytes.Repeat([]byte{seed}, 32)),
+		FileCount:  files,
+		TotalSize:  size,
+		Encryption: "aes-256-gcm",
+	}
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/arterynetwork/artr/x/storage/types"
+)
+
+// SetManifest replaces the account's storage manifest, the previous one is moved to the manifest history.
+// If prevRootHash is set, it must match the current manifest.
+func (k Keeper) SetManifest(ctx sdk.Context, addr sdk.AccAddress, manifest types.Manifest, prevRootHash string) error {
+	current := k.GetManifest(ctx, addr)
+	if prevRootHash != "" && (current == nil || !strings.EqualFold(current.RootHash, prevRootHash)) {
+		return types.ErrManifestConflict
+	}
+	if manifest.TotalSize > k.GetLimit(ctx, addr) {
+		return types.ErrLimitSmallerThenData
+	}
+
+	if current != nil {
+		k.SetManifestHistoryRecord(ctx, addr, k.nextManifestHistorySeq(ctx, addr), *current)
+		k.pruneManifestHistory(ctx, addr)
+	}
+
+	manifest.RootHash = strings.ToLower(manifest.RootHash)
+	manifest.UpdatedAt = ctx.BlockHeight()
+	k.setManifest(ctx, addr, manifest)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeManifest,
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyRootHash, manifest.RootHash),
+	))
+	return nil
+}
+
+// GetManifest returns the account's current storage manifest or nil if it's never been set
+func (k Keeper) GetManifest(ctx sdk.Context, addr sdk.AccAddress) *types.Manifest {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(manifestKey(addr))
+	if bz == nil {
+		return nil
+	}
+
+	var manifest types.Manifest
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &manifest)
+	return &manifest
+}
+
+func (k Keeper) setManifest(ctx sdk.Context, addr sdk.AccAddress, manifest types.Manifest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(manifestKey(addr), k.cdc.MustMarshalBinaryLengthPrefixed(manifest))
+}
+
+// SetManifestHistoryRecord stores a previous manifest of the account. Records are ordered by seq, not by height,
+// because a manifest can be replaced several times in the same block.
+func (k Keeper) SetManifestHistoryRecord(ctx sdk.Context, addr sdk.AccAddress, seq uint64, manifest types.Manifest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(manifestHistoryRecordKey(addr, seq), k.cdc.MustMarshalBinaryLengthPrefixed(manifest))
+}
+
+// nextManifestHistorySeq returns a sequence number for the next history record of the account. The most recent
+// record is never pruned, so it's enough to look at the last one.
+func (k Keeper) nextManifestHistorySeq(ctx sdk.Context, addr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStoreReversePrefixIterator(store, manifestHistoryKey(addr))
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	key := it.Key()
+	return binary.BigEndian.Uint64(key[len(key)-8:]) + 1
+}
+
+// GetManifestHistory returns previous manifests of the account, the most recent first
+func (k Keeper) GetManifestHistory(ctx sdk.Context, addr sdk.AccAddress, limit int32, page int32) []types.Manifest {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, manifestHistoryKey(addr))
+	defer iterator.Close()
+
+	records := make([]types.Manifest, 0)
+	start := limit * (page - 1)
+	end := limit * page
+
+	for current := int32(0); iterator.Valid() && (current < end); iterator.Next() {
+		if current < start {
+			current++
+			continue
+		}
+		current++
+		var record types.Manifest
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// pruneManifestHistory deletes the oldest manifests, so that no more than MaxManifestHistory are kept
+func (k Keeper) pruneManifestHistory(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	var keys [][]byte
+	it := sdk.KVStoreReversePrefixIterator(store, manifestHistoryKey(addr))
+	for n := 0; it.Valid(); it.Next() {
+		if n < types.MaxManifestHistory {
+			n++
+			continue
+		}
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func manifestKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte(nil), manifestPrefix...), auth.AddressStoreKey(addr)...)
+}
+
+func manifestHistoryKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte(nil), manifestHistoryPrefix...), auth.AddressStoreKey(addr)...)
+}
+
+func manifestHistoryRecordKey(addr sdk.AccAddress, seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(manifestHistoryKey(addr), bz...)
+}
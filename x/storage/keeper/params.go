@@ -1 +1,27 @@
 package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/x/storage/types"
+)
+
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramspace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramspace.SetParamSet(ctx, &params)
+}
+
+func (k Keeper) IsSigner(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for _, signer := range k.GetParams(ctx).Signers {
+		if bytes.Equal(signer, addr) {
+			return true
+		}
+	}
+	return false
+}
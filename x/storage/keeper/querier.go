@@ -18,6 +18,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryData(ctx, k, req)
 		case types.QueryStorageInfo:
 			return queryInfo(ctx, k, req)
+		case types.QueryUsage:
+			return queryUsage(ctx, k, req)
+		case types.QueryParams:
+			return queryParams(ctx, k)
+		case types.QueryManifest:
+			return queryManifest(ctx, k, req)
+		case types.QueryManifestHistory:
+			return queryManifestHistory(ctx, k, req)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown storage query endpoint")
 		}
@@ -64,3 +72,69 @@ func queryInfo(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error)
 
 	return bz, nil
 }
+
+func queryUsage(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.QueryUsageParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryUsageRes{
+		History: k.GetUsageHistory(ctx, params.Address, params.Limit, params.Page),
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryManifest(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.QueryStorageParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryManifestRes{Manifest: k.GetManifest(ctx, params.Address)}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryManifestHistory(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.QueryManifestHistoryParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.QueryManifestHistoryRes{
+		History: k.GetManifestHistory(ctx, params.Address, params.Limit, params.Page),
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
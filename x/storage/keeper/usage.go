@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/storage/types"
+)
+
+// ReportUsage sets the account's current storage usage and saves it to the usage history (only MaxUsageHistory
+// latest reports are kept). If the usage exceeds the limit, quota-exceeded hooks are called (e.g. to buy more space
+// automatically), a hook failure doesn't fail the report though.
+func (k Keeper) ReportUsage(ctx sdk.Context, addr sdk.AccAddress, current int64) error {
+	if current < 0 {
+		return types.ErrNegativeUsage
+	}
+
+	k.SetCurrent(ctx, addr, current)
+	record := types.UsageRecord{
+		Height:  ctx.BlockHeight(),
+		Current: current,
+		Limit:   k.GetLimit(ctx, addr),
+	}
+	k.SetUsageRecord(ctx, addr, k.nextUsageSeq(ctx, addr), record)
+	k.pruneUsageHistory(ctx, addr)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUsage,
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyCurrent, fmt.Sprintf("%d", record.Current)),
+		sdk.NewAttribute(types.AttributeKeyLimit, fmt.Sprintf("%d", record.Limit)),
+	))
+
+	if record.Overuse() > 0 {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyCurrent, fmt.Sprintf("%d", record.Current)),
+			sdk.NewAttribute(types.AttributeKeyLimit, fmt.Sprintf("%d", record.Limit)),
+		))
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.callback(types.HookQuotaExceeded, cacheCtx, addr); err != nil {
+			k.Logger(ctx).Info("cannot extend storage limit", "address", addr, "error", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeAutoBuyFailed,
+				sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	} else if util.FractionInt(record.Current).GTE(k.GetParams(ctx).NearQuota.MulInt64(record.Limit)) && record.Current > 0 {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeNearQuota,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyCurrent, fmt.Sprintf("%d", record.Current)),
+			sdk.NewAttribute(types.AttributeKeyLimit, fmt.Sprintf("%d", record.Limit)),
+		))
+	}
+
+	return nil
+}
+
+// SetUsageRecord stores a usage report of the account. Records are ordered by seq, not by height, because usage can
+// be reported several times in the same block.
+func (k Keeper) SetUsageRecord(ctx sdk.Context, addr sdk.AccAddress, seq uint64, record types.UsageRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(usageRecordKey(addr, seq), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+}
+
+// nextUsageSeq returns a sequence number for the next usage record of the account. The most recent record is never
+// pruned, so it's enough to look at the last one.
+func (k Keeper) nextUsageSeq(ctx sdk.Context, addr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStoreReversePrefixIterator(store, usageHistoryKey(addr))
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	key := it.Key()
+	return binary.BigEndian.Uint64(key[len(key)-8:]) + 1
+}
+
+// GetUsageHistory returns usage reports of the account, the most recent first
+func (k Keeper) GetUsageHistory(ctx sdk.Context, addr sdk.AccAddress, limit int32, page int32) []types.UsageRecord {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, usageHistoryKey(addr))
+	defer iterator.Close()
+
+	records := make([]types.UsageRecord, 0)
+	start := limit * (page - 1)
+	end := limit * page
+
+	for current := int32(0); iterator.Valid() && (current < end); iterator.Next() {
+		if current < start {
+			current++
+			continue
+		}
+		current++
+		var record types.UsageRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// pruneUsageHistory deletes the oldest usage records, so that no more than MaxUsageHistory are kept
+func (k Keeper) pruneUsageHistory(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	var keys [][]byte
+	it := sdk.KVStoreReversePrefixIterator(store, usageHistoryKey(addr))
+	for n := 0; it.Valid(); it.Next() {
+		if n < types.MaxUsageHistory {
+			n++
+			continue
+		}
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func usageHistoryKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte(nil), usagePrefix...), auth.AddressStoreKey(addr)...)
+}
+
+func usageRecordKey(addr sdk.AccAddress, seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(usageHistoryKey(addr), bz...)
+}
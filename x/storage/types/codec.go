@@ -7,6 +7,8 @@ import (
 // RegisterCodec registers concrete types on codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetStorageData{}, "storage/SetStorageData", nil)
+	cdc.RegisterConcrete(MsgReportUsage{}, "storage/ReportUsage", nil)
+	cdc.RegisterConcrete(MsgSetManifest{}, "storage/SetManifest", nil)
 }
 
 // ModuleCdc defines the module codec
@@ -8,4 +8,7 @@ var (
 	ErrDataToLong           = sdkerrors.Register(ModuleName, 1, "Directory data to long")
 	ErrLimitSmallerThenData = sdkerrors.Register(ModuleName, 2, "Size limit smaller then data length")
 	ErrLimitToLow           = sdkerrors.Register(ModuleName, 3, "Size limit smaller than minimal packet size")
+	ErrNegativeUsage        = sdkerrors.Register(ModuleName, 4, "Storage usage cannot be negative")
+	ErrInvalidManifest      = sdkerrors.Register(ModuleName, 5, "Invalid storage manifest")
+	ErrManifestConflict     = sdkerrors.Register(ModuleName, 6, "Storage manifest has been changed by another client")
 )
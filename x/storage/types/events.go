@@ -2,5 +2,17 @@ package types
 
 // storage module event types
 const (
+	EventTypeUsage         = "storage_usage"
+	EventTypeNearQuota     = "storage_near_quota"
+	EventTypeQuotaExceeded = "storage_quota_exceeded"
+	EventTypeAutoBuyFailed = "storage_auto_buy_failed"
+	EventTypeManifest      = "storage_manifest"
+
+	AttributeKeyAddress  = "address"
+	AttributeKeyCurrent  = "current"
+	AttributeKeyLimit    = "limit"
+	AttributeKeyError    = "error"
+	AttributeKeyRootHash = "root_hash"
+
 	AttributeValueCategory = ModuleName
 )
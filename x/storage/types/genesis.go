@@ -8,9 +8,13 @@ import (
 
 // GenesisState - all storage state that must be provided at genesis
 type GenesisState struct {
-	Limits  []Volume `json:"limits"`
-	Current []Volume `json:"current"`
-	Data    []Data   `json:"data"`
+	Params          Params            `json:"params" yaml:"params"`
+	Limits          []Volume          `json:"limits"`
+	Current         []Volume          `json:"current"`
+	Data            []Data            `json:"data"`
+	UsageHistory    []GenesisUsage    `json:"usage_history,omitempty" yaml:"usage_history,omitempty"`
+	Manifests       []GenesisManifest `json:"manifests,omitempty" yaml:"manifests,omitempty"`
+	ManifestHistory []GenesisManifest `json:"manifest_history,omitempty" yaml:"manifest_history,omitempty"`
 }
 
 type Volume struct {
@@ -23,26 +27,76 @@ type Data struct {
 	Base64  string         `json:"base64"`
 }
 
+type GenesisUsage struct {
+	UsageRecord
+	Account sdk.AccAddress `json:"account" yaml:"account"`
+	// Seq orders usage records of an account
+	Seq uint64 `json:"seq,omitempty" yaml:"seq,omitempty"`
+}
+
+type GenesisManifest struct {
+	Manifest
+	Account sdk.AccAddress `json:"account" yaml:"account"`
+	// Seq orders manifest history records of an account, it's not used for current manifests
+	Seq uint64 `json:"seq,omitempty" yaml:"seq,omitempty"`
+}
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(limits []Volume, current []Volume, data []Data) GenesisState {
+func NewGenesisState(
+	params Params, limits []Volume, current []Volume, data []Data, usageHistory []GenesisUsage,
+	manifests []GenesisManifest, manifestHistory []GenesisManifest,
+) GenesisState {
 	return GenesisState{
-		Limits:  limits,
-		Current: current,
-		Data:    data,
+		Params:          params,
+		Limits:          limits,
+		Current:         current,
+		Data:            data,
+		UsageHistory:    usageHistory,
+		Manifests:       manifests,
+		ManifestHistory: manifestHistory,
 	}
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // ValidateGenesis validates the storage genesis parameters
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
 	for i, limit := range data.Limits {
 		if limit.Account.Empty() {
 			return fmt.Errorf("empty account address (#%d)", i)
 		}
 	}
+	for i, record := range data.UsageHistory {
+		if record.Account.Empty() {
+			return fmt.Errorf("empty account address (usage_history.#%d)", i)
+		}
+		if record.Current < 0 {
+			return fmt.Errorf("negative usage (usage_history.#%d)", i)
+		}
+	}
+	for i, m := range data.Manifests {
+		if m.Account.Empty() {
+			return fmt.Errorf("empty account address (manifests.#%d)", i)
+		}
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("invalid manifest (manifests.#%d): %s", i, err.Error())
+		}
+	}
+	for i, m := range data.ManifestHistory {
+		if m.Account.Empty() {
+			return fmt.Errorf("empty account address (manifest_history.#%d)", i)
+		}
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("invalid manifest (manifest_history.#%d): %s", i, err.Error())
+		}
+	}
 	return nil
 }
@@ -12,4 +12,7 @@ const (
 
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
+
+	// HookQuotaExceeded is a name of callbacks called when a reported usage exceeds the account's limit
+	HookQuotaExceeded = "quota-exceeded"
 )
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ManifestVersion is the only manifest format version supported now
+	ManifestVersion uint32 = 1
+
+	// RootHashLength is a length of a manifest root hash (SHA-256) in bytes
+	RootHashLength = 32
+	// MaxEncryptionLength limits length of an encryption scheme name
+	MaxEncryptionLength = 32
+	// MaxManifestHistory is how many previous manifests are kept for an account
+	MaxManifestHistory = 10
+)
+
+// Manifest describes a state of an account's storage directory, so that clients can sync it across devices
+type Manifest struct {
+	// Version of the manifest format
+	Version uint32 `json:"version" yaml:"version"`
+	// RootHash is a hex-encoded hash of the directory tree root
+	RootHash string `json:"root_hash" yaml:"root_hash"`
+	// FileCount is a number of files stored
+	FileCount uint64 `json:"file_count" yaml:"file_count"`
+	// TotalSize is a total size of the files stored (in bytes)
+	TotalSize int64 `json:"total_size" yaml:"total_size"`
+	// Encryption is a name of an encryption scheme the files are encrypted with
+	Encryption string `json:"encryption" yaml:"encryption"`
+	// UpdatedAt is a block height the manifest was set at (it's filled in by the chain)
+	UpdatedAt int64 `json:"updated_at" yaml:"updated_at"`
+}
+
+func (m Manifest) String() string {
+	return strings.TrimSpace(fmt.Sprintf(
+		"Version: %d\n"+
+			"RootHash: %s\n"+
+			"FileCount: %d\n"+
+			"TotalSize: %d\n"+
+			"Encryption: %s\n"+
+			"UpdatedAt: %d",
+		m.Version,
+		m.RootHash,
+		m.FileCount,
+		m.TotalSize,
+		m.Encryption,
+		m.UpdatedAt,
+	))
+}
+
+// Validate checks the manifest fields set by a user
+func (m Manifest) Validate() error {
+	if m.Version != ManifestVersion {
+		return sdkerrors.Wrapf(ErrInvalidManifest, "unsupported version %d", m.Version)
+	}
+	if err := validateRootHash(m.RootHash); err != nil {
+		return err
+	}
+	if m.TotalSize < 0 {
+		return sdkerrors.Wrap(ErrInvalidManifest, "negative total size")
+	}
+	if m.FileCount == 0 && m.TotalSize != 0 {
+		return sdkerrors.Wrap(ErrInvalidManifest, "non-zero total size with no files")
+	}
+	if len(m.Encryption) == 0 {
+		return sdkerrors.Wrap(ErrInvalidManifest, "missing encryption scheme")
+	}
+	if len(m.Encryption) > MaxEncryptionLength {
+		return sdkerrors.Wrapf(ErrInvalidManifest, "encryption scheme name is too long (max %d)", MaxEncryptionLength)
+	}
+	return nil
+}
+
+func validateRootHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidManifest, "malformed root hash: %s", err.Error())
+	}
+	if len(bz) != RootHashLength {
+		return sdkerrors.Wrapf(ErrInvalidManifest, "root hash must be %d bytes long", RootHashLength)
+	}
+	return nil
+}
@@ -8,7 +8,9 @@ import (
 // verify interface at compile time
 var _ sdk.Msg = &MsgSetStorageData{}
 
-// Msg<Action> - struct for unjailing jailed validator
+// MsgSetStorageData - an opaque directory blob
+//
+// Deprecated: use MsgSetManifest instead. The message is rejected since 1.4.0 and only kept to decode old transactions.
 type MsgSetStorageData struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"` // address of the validator operator
 	Size    int64          `json:"size" yaml:"size"`
@@ -47,5 +49,114 @@ func (msg MsgSetStorageData) ValidateBasic() error {
 	if msg.Address.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing address")
 	}
+	if msg.Size != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "storage usage can be reported by a signer only")
+	}
+	return nil
+}
+
+// verify interface at compile time
+var _ sdk.Msg = &MsgReportUsage{}
+
+// MsgReportUsage - storage usage of an account reported by a trusted signer
+type MsgReportUsage struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Current int64          `json:"current" yaml:"current"`
+}
+
+// NewMsgReportUsage creates a new MsgReportUsage instance
+func NewMsgReportUsage(sender sdk.AccAddress, addr sdk.AccAddress, current int64) MsgReportUsage {
+	return MsgReportUsage{
+		Sender:  sender,
+		Address: addr,
+		Current: current,
+	}
+}
+
+const ReportUsageConst = "report_usage"
+
+// nolint
+func (msg MsgReportUsage) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgReportUsage) Type() string { return ReportUsageConst }
+
+func (msg MsgReportUsage) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgReportUsage) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgReportUsage) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing address")
+	}
+	if msg.Current < 0 {
+		return ErrNegativeUsage
+	}
+	return nil
+}
+
+// verify interface at compile time
+var _ sdk.Msg = &MsgSetManifest{}
+
+// MsgSetManifest - a new storage directory manifest of an account
+type MsgSetManifest struct {
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Manifest Manifest       `json:"manifest" yaml:"manifest"`
+	// PrevRootHash, if set, must match the current manifest's root hash, so that concurrent updates from
+	// different devices don't overwrite each other
+	PrevRootHash string `json:"prev_root_hash,omitempty" yaml:"prev_root_hash,omitempty"`
+}
+
+// NewMsgSetManifest creates a new MsgSetManifest instance
+func NewMsgSetManifest(addr sdk.AccAddress, manifest Manifest, prevRootHash string) MsgSetManifest {
+	return MsgSetManifest{
+		Address:      addr,
+		Manifest:     manifest,
+		PrevRootHash: prevRootHash,
+	}
+}
+
+const SetManifestConst = "set_manifest"
+
+// nolint
+func (msg MsgSetManifest) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetManifest) Type() string { return SetManifestConst }
+
+func (msg MsgSetManifest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSetManifest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgSetManifest) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing address")
+	}
+	if err := msg.Manifest.Validate(); err != nil {
+		return err
+	}
+	if msg.PrevRootHash != "" {
+		if err := validateRootHash(msg.PrevRootHash); err != nil {
+			return err
+		}
+	}
 	return nil
 }
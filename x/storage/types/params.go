@@ -3,7 +3,10 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/arterynetwork/artr/util"
 )
 
 // Default parameter namespace
@@ -11,8 +14,16 @@ const (
 	DefaultParamspace = ModuleName
 )
 
+// Default parameter values
+var (
+	DefaultNearQuota = util.Percent(90)
+)
+
 // Parameter store keys
-var ()
+var (
+	KeyParamSigners = []byte("Signers")
+	KeyNearQuota    = []byte("NearQuota")
+)
 
 // ParamKeyTable for storage module
 func ParamKeyTable() params.KeyTable {
@@ -21,29 +32,75 @@ func ParamKeyTable() params.KeyTable {
 
 // Params - used for initializing default parameter for storage at genesis
 type Params struct {
-	// KeyParamName string `json:"key_param_name"`
+	// Signers are accounts allowed to report storage usage
+	Signers []sdk.AccAddress `json:"signers" yaml:"signers"`
+	// NearQuota is a part of the limit, reaching which a user gets warned
+	NearQuota util.Fraction `json:"near_quota" yaml:"near_quota"`
 }
 
 // NewParams creates a new Params object
-func NewParams() Params {
-	return Params{}
+func NewParams(signers []sdk.AccAddress, nearQuota util.Fraction) Params {
+	return Params{
+		Signers:   signers,
+		NearQuota: nearQuota,
+	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	return fmt.Sprintf(`
-	// TODO: Return all the params as a string
-	`)
+Signers: %v
+NearQuota: %s
+	`, p.Signers, p.NearQuota)
 }
 
 // ParamSetPairs - Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		// params.NewParamSetPair(KeyParamName, &p.ParamName),
+		params.NewParamSetPair(KeyParamSigners, &p.Signers, validateSigners),
+		params.NewParamSetPair(KeyNearQuota, &p.NearQuota, validateNearQuota),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(nil, DefaultNearQuota)
+}
+
+func (p Params) Validate() error {
+	if err := validateSigners(p.Signers); err != nil {
+		return err
+	}
+	if err := validateNearQuota(p.NearQuota); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateSigners(i interface{}) error {
+	val, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid storage usage signers parameter type: %T", i)
+	}
+
+	for i, s := range val {
+		if s.Empty() {
+			return fmt.Errorf("empty storage usage signer address (#%d)", i)
+		}
+	}
+
+	return nil
+}
+
+func validateNearQuota(i interface{}) error {
+	v, ok := i.(util.Fraction)
+	if !ok {
+		return fmt.Errorf("invalid near quota parameter type: %T", i)
+	}
+
+	if v.IsNullValue() || !v.IsPositive() || v.GT(util.Percent(100)) {
+		return fmt.Errorf("near quota must be in (0; 1]: %s", v)
+	}
+
+	return nil
 }
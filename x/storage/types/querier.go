@@ -2,13 +2,19 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Query endpoints supported by the storage querier
 const (
-	QueryStorageData = "storage_data"
-	QueryStorageInfo = "storage_info"
+	QueryStorageData     = "storage_data"
+	QueryStorageInfo     = "storage_info"
+	QueryUsage           = "usage"
+	QueryParams          = "params"
+	QueryManifest        = "manifest"
+	QueryManifestHistory = "manifest_history"
 )
 
 type QueryStorageParams struct {
@@ -62,3 +68,66 @@ func NewQueryStorageDataRes(data string) QueryStorageDataRes {
 		Data: data,
 	}
 }
+
+type QueryUsageParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Limit   int32          `json:"limit" yaml:"limit"`
+	Page    int32          `json:"page" yaml:"page"`
+}
+
+func NewQueryUsageParams(addr sdk.AccAddress, limit int32, page int32) QueryUsageParams {
+	return QueryUsageParams{
+		Address: addr,
+		Limit:   limit,
+		Page:    page,
+	}
+}
+
+type QueryUsageRes struct {
+	History []UsageRecord `json:"history" yaml:"history"`
+}
+
+func (res QueryUsageRes) String() string {
+	lines := make([]string, len(res.History))
+	for i, record := range res.History {
+		lines[i] = record.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
+
+type QueryManifestHistoryParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Limit   int32          `json:"limit" yaml:"limit"`
+	Page    int32          `json:"page" yaml:"page"`
+}
+
+func NewQueryManifestHistoryParams(addr sdk.AccAddress, limit int32, page int32) QueryManifestHistoryParams {
+	return QueryManifestHistoryParams{
+		Address: addr,
+		Limit:   limit,
+		Page:    page,
+	}
+}
+
+type QueryManifestRes struct {
+	Manifest *Manifest `json:"manifest" yaml:"manifest"`
+}
+
+func (res QueryManifestRes) String() string {
+	if res.Manifest == nil {
+		return "No manifest"
+	}
+	return res.Manifest.String()
+}
+
+type QueryManifestHistoryRes struct {
+	History []Manifest `json:"history" yaml:"history"`
+}
+
+func (res QueryManifestHistoryRes) String() string {
+	lines := make([]string, len(res.History))
+	for i, m := range res.History {
+		lines[i] = m.String()
+	}
+	return strings.Join(lines, "\n---\n")
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxUsageHistory is how many usage reports are kept for an account
+const MaxUsageHistory = 100
+
+// UsageRecord is a storage usage reported for an account at some height
+type UsageRecord struct {
+	Height  int64 `json:"height" yaml:"height"`
+	Current int64 `json:"current" yaml:"current"`
+	Limit   int64 `json:"limit" yaml:"limit"`
+}
+
+func (r UsageRecord) String() string {
+	return strings.TrimSpace(fmt.Sprintf(
+		"Height: %d\n"+
+			"Current: %d\n"+
+			"Limit: %d",
+		r.Height,
+		r.Current,
+		r.Limit,
+	))
+}
+
+// Overuse returns how much the usage exceeds the limit (0 if it doesn't)
+func (r UsageRecord) Overuse() int64 {
+	if r.Current > r.Limit {
+		return r.Current - r.Limit
+	}
+	return 0
+}
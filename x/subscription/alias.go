@@ -13,6 +13,8 @@ const (
 	//QueryParams       = types.QueryParams
 	QuerierRoute = types.QuerierRoute
 	HookName     = types.HookName
+
+	RateTallyHookName = types.RateTallyHookName
 )
 
 var (
@@ -24,6 +26,7 @@ var (
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
 	NewActivityInfo     = types.NewActivityInfo
+	ErrNotRateSigner    = types.ErrNotRateSigner
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
@@ -34,4 +37,8 @@ type (
 	GenesisState = types.GenesisState
 	Params       = types.Params
 	ActivityInfo = types.ActivityInfo
+	RateVote     = types.RateVote
+	RateRecord   = types.RateRecord
+	PriceRecord  = types.PriceRecord
+	QuoteItem    = types.QuoteItem
 )
@@ -0,0 +1,12 @@
+package cli
+
+const (
+	FlagLimit = "limit"
+	FlagPage  = "page"
+
+	FlagExtraStorage = "extra-storage"
+	FlagExtraVPN     = "extra-vpn"
+
+	FlagLimitDefault = int(30)
+	FlagPageDefault  = int(1)
+)
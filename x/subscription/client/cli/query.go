@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -31,6 +32,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		flags.GetCommands(
 			GetActivityInfoCmd(queryRoute, cdc),
 			GetGetPricesCmd(queryRoute, cdc),
+			GetRateVotesCmd(queryRoute, cdc),
+			GetRateHistoryCmd(queryRoute, cdc),
+			GetQuoteCmd(queryRoute, cdc),
+			GetPriceHistoryCmd(queryRoute, cdc),
 			util.LineBreak(),
 			getCmdParams(queryRoute, cdc),
 		)...,
@@ -91,6 +96,124 @@ func GetGetPricesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetRateVotesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-votes",
+		Short: "Query exchange rate votes of the current window",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRateVotes))
+			if err != nil {
+				return err
+			}
+
+			var out []types.RateVote
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
+
+func GetRateHistoryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-history",
+		Short: "Query history of accepted exchange rates",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			data := cdc.MustMarshalJSON(types.QueryRateHistoryParams{
+				Limit: int32(viper.GetInt(FlagLimit)),
+				Page:  int32(viper.GetInt(FlagPage)),
+			})
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRateHistory), data)
+			if err != nil {
+				return err
+			}
+
+			var out []types.RateRecord
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of history records per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
+
+func GetQuoteCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote <address>",
+		Short: "Query itemized price of a subscription with extra storage and VPN traffic",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			data := cdc.MustMarshalJSON(types.NewQueryQuoteParams(
+				addr,
+				viper.GetInt64(FlagExtraStorage),
+				viper.GetInt64(FlagExtraVPN),
+			))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryQuote), data)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryQuoteRes
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExtraStorage, 0, "Extra storage space over the base one (in bytes)")
+	cmd.Flags().Int64(FlagExtraVPN, 0, "Extra VPN traffic (in bytes)")
+
+	return cmd
+}
+
+func GetPriceHistoryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history",
+		Short: "Query history of token course and service prices changes",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			data := cdc.MustMarshalJSON(types.QueryPriceHistoryParams{
+				Limit: int32(viper.GetInt(FlagLimit)),
+				Page:  int32(viper.GetInt(FlagPage)),
+			})
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceHistory), data)
+			if err != nil {
+				return err
+			}
+
+			var out []types.PriceRecord
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of history records per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
+
 func getCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:     "params",
@@ -128,7 +128,9 @@ func GetPayForStorageCmd(cdc *codec.Codec) *cobra.Command {
 func GetSetTokenRateCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-rate [0.01 USD to uARTRs]",
-		Short: "Set ARTR exchange rate",
+		Short: "Vote for ARTR exchange rate",
+		Long: "Vote for ARTR exchange rate. Votes are collected during a window (see rate_vote_window param), " +
+			"then the median of the votes becomes the new exchange rate.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -17,11 +17,26 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 			}
 		}
 	}
+	for _, vote := range data.RateVotes {
+		k.SetRateVote(ctx, vote)
+	}
+	for _, record := range data.RateHistory {
+		k.SetRateRecord(ctx, record)
+	}
+	for _, record := range data.PriceHistory {
+		k.SetPriceRecord(ctx, record)
+	}
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
-	return NewGenesisState(k.GetParams(ctx), k.ExportActivity(ctx))
+	return NewGenesisState(
+		k.GetParams(ctx),
+		k.ExportActivity(ctx),
+		k.GetRateVotes(ctx),
+		k.ExportRateHistory(ctx),
+		k.ExportPriceHistory(ctx),
+	)
 }
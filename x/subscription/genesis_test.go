@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/arterynetwork/artr/app"
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/schedule"
 	"github.com/arterynetwork/artr/x/subscription"
 	"github.com/arterynetwork/artr/x/subscription/types"
@@ -62,10 +63,20 @@ func (s *Suite) TestParams() {
 		BaseVPNGb:           9998,
 		BaseStorageGb:       9999,
 		CourseChangeSigners: []sdk.AccAddress{app.DefaultGenesisUsers["user13"]},
+		RateVoteWindow:      9993,
+		RateMinVotes:        9992,
+		RateMaxDeviation:    util.Permille(9991),
 	})
 	s.checkExportImport()
 }
 
+func (s Suite) TestRateVotesAndHistory() {
+	s.k.SetRateRecord(s.ctx, types.RateRecord{Height: 100, Value: 100500, Votes: 2})
+	s.k.SetRateRecord(s.ctx, types.RateRecord{Height: 220, Value: 101000, Votes: 1})
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user1"], 99000))
+	s.checkExportImport()
+}
+
 func (s Suite) checkExportImport() {
 	s.app.CheckExportImport(s.T(),
 		[]string{
@@ -1,7 +1,6 @@
 package subscription
 
 import (
-	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -72,14 +71,11 @@ func handleMsgPayStorage(ctx sdk.Context, k Keeper, msg types.MsgPayStorage) (*s
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+// handleMsgSetTokenCourse records an exchange rate vote, the course itself is changed when the voting window closes
 func handleMsgSetTokenCourse(ctx sdk.Context, k Keeper, msg types.MsgSetTokenRate) (*sdk.Result, error) {
-	for _, signer := range k.GetParams(ctx).CourseChangeSigners {
-		if !bytes.Equal(signer, msg.Sender) {
-			continue
-		}
-
-		k.SetTokenCourse(ctx, msg.Value)
-		return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	if err := k.VoteTokenRate(ctx, msg.Sender, msg.Value); err != nil {
+		return nil, err
 	}
-	return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sender is not in allowed signer list")
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
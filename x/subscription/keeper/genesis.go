@@ -10,7 +10,7 @@ import (
 func (k Keeper) ExportActivity(ctx sdk.Context) []types.GenesisActivityInfo {
 	var result []types.GenesisActivityInfo
 	store := ctx.KVStore(k.storeKey)
-	it := store.Iterator(nil, nil)
+	it := sdk.KVStorePrefixIterator(store, auth.AddressStoreKeyPrefix)
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
 		acc := sdk.AccAddress(it.Key()[len(auth.AddressStoreKeyPrefix):])
@@ -23,3 +23,29 @@ func (k Keeper) ExportActivity(ctx sdk.Context) []types.GenesisActivityInfo {
 	}
 	return result
 }
+
+func (k Keeper) ExportRateHistory(ctx sdk.Context) []types.RateRecord {
+	var result []types.RateRecord
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.RateHistoryPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var record types.RateRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &record)
+		result = append(result, record)
+	}
+	return result
+}
+
+func (k Keeper) ExportPriceHistory(ctx sdk.Context) []types.PriceRecord {
+	var result []types.PriceRecord
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.PriceHistoryPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var record types.PriceRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &record)
+		result = append(result, record)
+	}
+	return result
+}
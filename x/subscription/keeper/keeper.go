@@ -110,7 +110,7 @@ func (k Keeper) PayForSubscription(ctx sdk.Context, addr sdk.AccAddress, storage
 	amount := sdk.NewInt(int64(price) * int64(course))
 
 	// Total price without fee - we calc MLM reward based on this price
-	txFee, err := util.PayTxFee(ctx, k.supplyKeeper, k.Logger(ctx), addr, amount)
+	txFee, err := util.PayTxFee(ctx, k.bankKeeper, k.supplyKeeper, k.Logger(ctx), addr, nil, amount, types.PaySubscriptionConst)
 	if err != nil {
 		return err
 	}
@@ -146,11 +146,11 @@ func (k Keeper) PayForSubscription(ctx sdk.Context, addr sdk.AccAddress, storage
 
 	// If not active
 	if !info.Active {
-		info.ExpireAt = ctx.BlockHeight() + oneMonth
+		k.startPeriod(ctx, &info)
 		info.Active = true
 		defer k.ReferralKeeper.SetActive(ctx, addr, true)
 		payInitialStorage = true
-		k.ScheduleRenew(ctx, addr, ctx.BlockHeight()+oneMonth)
+		k.scheduleRenewal(ctx, addr, info)
 		k.resetLimits(ctx, addr)
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypeActivityChange,
@@ -158,7 +158,7 @@ func (k Keeper) PayForSubscription(ctx sdk.Context, addr sdk.AccAddress, storage
 			sdk.NewAttribute(types.AttributeKeyActive, types.AttributeValueKeyActiveActive),
 		))
 	} else {
-		info.ExpireAt += oneMonth
+		k.extendPeriod(ctx, &info)
 
 		// Pay for 1 month of storage
 	}
@@ -170,7 +170,7 @@ func (k Keeper) PayForSubscription(ctx sdk.Context, addr sdk.AccAddress, storage
 		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
 		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 		sdk.NewAttribute(types.AttributeKeyNodeFee, txFee.String()),
-		sdk.NewAttribute(types.AttributeKeyExpireAt, fmt.Sprintf("%d", info.ExpireAt)),
+		sdk.NewAttribute(types.AttributeKeyExpireAt, expireAtString(info)),
 	))
 
 	if payInitialStorage {
@@ -185,7 +185,7 @@ func (k Keeper) PayForSubscription(ctx sdk.Context, addr sdk.AccAddress, storage
 
 		if payAmount > 0 {
 			return k.payForService(ctx, addr, payAmount, storage.ModuleName,
-				types.KeyStorageGbPrice, storageAmount, types.EventTypePayStorage)
+				types.KeyStorageGbPrice, storageAmount, types.EventTypePayStorage, types.PayStorageConst)
 		}
 	}
 
@@ -193,7 +193,7 @@ func (k Keeper) PayForSubscription(ctx sdk.Context, addr sdk.AccAddress, storage
 }
 
 func (k Keeper) payForService(ctx sdk.Context, addr sdk.AccAddress, amount int64,
-	moduleName string, priceAttr []byte, limitForEvent int64, eventName string) error {
+	moduleName string, priceAttr []byte, limitForEvent int64, eventName string, msgType string) error {
 	var (
 		price  uint32
 		course uint32
@@ -206,7 +206,7 @@ func (k Keeper) payForService(ctx sdk.Context, addr sdk.AccAddress, amount int64
 		int64(price) *
 		int64(course) / util.GBSize)
 
-	txFee, err := util.PayTxFee(ctx, k.supplyKeeper, k.Logger(ctx), addr, amountPrice)
+	txFee, err := util.PayTxFee(ctx, k.bankKeeper, k.supplyKeeper, k.Logger(ctx), addr, nil, amountPrice, msgType)
 	if err != nil {
 		return err
 	}
@@ -245,7 +245,27 @@ func (k Keeper) PayForVPN(ctx sdk.Context, addr sdk.AccAddress, amount int64) er
 	}
 
 	return k.payForService(ctx, addr, amount, vpn.ModuleName,
-		types.KeyVPNGbPrice, newLimit, types.EventTypePayVPN)
+		types.KeyVPNGbPrice, newLimit, types.EventTypePayVPN, types.PayVPNConst)
+}
+
+// PayForInvitee pays for the first month of a new account subscription (with base storage) on behalf of its
+// referrer. It's called by the profile keeper when a prepaid invite is redeemed.
+func (k Keeper) PayForInvitee(ctx sdk.Context, referrer, addr sdk.AccAddress) error {
+	var (
+		price     uint32
+		course    uint32
+		storageGb uint32
+	)
+
+	k.paramspace.Get(ctx, types.KeySubscriptionPrice, &price)
+	k.paramspace.Get(ctx, types.KeyTokenCourse, &course)
+	k.paramspace.Get(ctx, types.KeyBaseStorageGb, &storageGb)
+
+	if err := k.bankKeeper.SendCoins(ctx, referrer, addr, util.Uartrs(int64(price)*int64(course))); err != nil {
+		return err
+	}
+
+	return k.PayForSubscription(ctx, addr, int64(storageGb)*util.GBSize)
 }
 
 // Payment for Storage place
@@ -274,7 +294,7 @@ func (k Keeper) PayForStorage(ctx sdk.Context, addr sdk.AccAddress, amount int64
 
 	info := k.GetActivityInfo(ctx, addr)
 
-	if !info.Active || (info.ExpireAt-ctx.BlockHeight() <= 0) {
+	if !info.Active || k.isExpired(ctx, info) {
 		return types.ErrInactiveSubscription
 	}
 
@@ -282,18 +302,33 @@ func (k Keeper) PayForStorage(ctx sdk.Context, addr sdk.AccAddress, amount int64
 	k.storageKeeper.SetLimit(ctx, addr, amount)
 
 	if newAmount > 0 {
+		left, month := k.remainingPart(ctx, info)
 		remainAmount := sdk.NewInt(newAmount).
-			MulRaw(info.ExpireAt - ctx.BlockHeight()).
-			QuoRaw(util.BlocksOneMonth).
+			MulRaw(left).
+			QuoRaw(month).
 			Int64()
 
 		return k.payForService(ctx, addr, remainAmount, storage.ModuleName,
-			types.KeyStorageGbPrice, amount, types.EventTypePayStorage)
+			types.KeyStorageGbPrice, amount, types.EventTypePayStorage, types.PayStorageConst)
 	}
 
 	return nil
 }
 
+// AutoBuyStorage extends the account's storage limit up to the nearest whole GB covering its current usage,
+// if the account allowed it in the profile.
+func (k Keeper) AutoBuyStorage(ctx sdk.Context, addr sdk.AccAddress) error {
+	profile := k.profileKeeper.GetProfile(ctx, addr)
+	if profile == nil || !profile.StorageAutoBuy {
+		return nil
+	}
+
+	current := k.storageKeeper.GetCurrent(ctx, addr)
+	amount := (current + util.GBSize - 1) / util.GBSize * util.GBSize
+
+	return k.PayForStorage(ctx, addr, amount)
+}
+
 func (k Keeper) IsActive(ctx sdk.Context, addr sdk.AccAddress) bool {
 	info := k.GetActivityInfo(ctx, addr)
 	return info.Active
@@ -324,6 +359,13 @@ func (k Keeper) SetActivityInfo(ctx sdk.Context, addr sdk.AccAddress, info types
 	store.Set(auth.AddressStoreKey(addr), bz)
 }
 
+// MigrateAccount moves the account's activity info to a new address. Scheduled renewals are moved by the schedule
+// keeper along with other tasks.
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	util.MoveKeys(ctx.KVStore(k.storeKey), auth.AddressStoreKey(from), auth.AddressStoreKey(to))
+	return nil
+}
+
 func (k Keeper) ScheduleRenew(ctx sdk.Context, addr sdk.AccAddress, height int64) {
 	bytes := addr.Bytes()
 	k.scheduleKeeper.ScheduleTask(ctx, uint64(height), types.HookName, &bytes)
@@ -336,8 +378,7 @@ func (k Keeper) resetLimits(ctx sdk.Context, addr sdk.AccAddress) {
 	k.paramspace.Get(ctx, types.KeyBaseVPNGb, &VPNGb)
 	k.paramspace.Get(ctx, types.KeyBaseStorageGb, &storageGb)
 
-	k.vpnKeeper.SetLimit(ctx, addr, int64(VPNGb)*util.GBSize)
-	k.vpnKeeper.SetCurrent(ctx, addr, 0)
+	k.vpnKeeper.StartPeriod(ctx, addr, int64(VPNGb)*util.GBSize)
 	storageLimit := k.storageKeeper.GetLimit(ctx, addr)
 	if storageLimit == 0 {
 		k.storageKeeper.SetLimit(ctx, addr, int64(storageGb)*util.GBSize)
@@ -400,8 +441,8 @@ func (k Keeper) ProcessSchedule(ctx sdk.Context, data []byte) {
 
 	info := k.GetActivityInfo(ctx, addr)
 
-	if info.ExpireAt > ctx.BlockHeight() {
-		k.ScheduleRenew(ctx, addr, ctx.BlockHeight()+oneMonth)
+	if !k.isExpired(ctx, info) {
+		k.scheduleRenewal(ctx, addr, info)
 		k.resetLimits(ctx, addr)
 	} else {
 		profile := k.profileKeeper.GetProfile(ctx, addr)
@@ -415,7 +456,7 @@ func (k Keeper) ProcessSchedule(ctx sdk.Context, data []byte) {
 				))
 				k.deactivateAccount(ctx, addr, info)
 			} else {
-				k.ScheduleRenew(ctx, addr, ctx.BlockHeight()+oneMonth)
+				k.scheduleRenewal(ctx, addr, k.GetActivityInfo(ctx, addr))
 				k.resetLimits(ctx, addr)
 			}
 		} else {
@@ -3,7 +3,9 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -102,6 +104,88 @@ func (s Suite) TestAutoPayment() {
 	s.Equal(false, info.Active)
 }
 
+func (s Suite) TestAutoPayment_WallClock() {
+	user := app.DefaultGenesisUsers["user1"]
+	month := 30 * 24 * time.Hour
+	params := s.k.GetParams(s.ctx)
+	params.MonthDuration = month
+	s.k.SetParams(s.ctx, params)
+
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(t0)
+
+	info := s.k.GetActivityInfo(s.ctx, user)
+	info.ExpireAt = 0
+	info.Active = false
+	s.k.SetActivityInfo(s.ctx, user, info)
+
+	s.NoError(s.k.PayForSubscription(s.ctx, user, 5*util.GBSize))
+	profile := s.profileKeeper.GetProfile(s.ctx, user)
+	profile.AutoPay = true
+	s.NoError(s.profileKeeper.SetProfile(s.ctx, user, *profile))
+
+	info = s.k.GetActivityInfo(s.ctx, user)
+	s.True(info.Active)
+	s.Zero(info.ExpireAt)
+	s.Equal(t0.Add(month).Unix(), info.ExpireTime)
+
+	// Blocks are slow, so the month is over long before util.BlocksOneMonth blocks pass
+	s.ctx = s.ctx.WithBlockHeight(1000).WithBlockTime(t0.Add(month - time.Second))
+	s.nextBlock()
+	s.Equal(t0.Add(month).Unix(), s.k.GetActivityInfo(s.ctx, user).ExpireTime)
+
+	s.ctx = s.ctx.WithBlockTime(t0.Add(month))
+	s.nextBlock()
+	info = s.k.GetActivityInfo(s.ctx, user)
+	s.True(info.Active)
+	s.Equal(t0.Add(2*month).Unix(), info.ExpireTime)
+
+	profile.AutoPay = false
+	s.NoError(s.profileKeeper.SetProfile(s.ctx, user, *profile))
+
+	s.ctx = s.ctx.WithBlockTime(t0.Add(2*month + time.Minute))
+	s.nextBlock()
+	info = s.k.GetActivityInfo(s.ctx, user)
+	s.False(info.Active)
+	s.Equal(t0.Add(2*month).Unix(), info.ExpireTime)
+}
+
+func (s Suite) TestAutoPayment_WallClockDelayed() {
+	user := app.DefaultGenesisUsers["user1"]
+	month := 30 * 24 * time.Hour
+	params := s.k.GetParams(s.ctx)
+	params.MonthDuration = month
+	s.k.SetParams(s.ctx, params)
+
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(t0)
+
+	info := s.k.GetActivityInfo(s.ctx, user)
+	info.ExpireAt = 0
+	info.Active = false
+	s.k.SetActivityInfo(s.ctx, user, info)
+
+	s.NoError(s.k.PayForSubscription(s.ctx, user, 5*util.GBSize))
+	profile := s.profileKeeper.GetProfile(s.ctx, user)
+	profile.AutoPay = true
+	s.NoError(s.profileKeeper.SetProfile(s.ctx, user, *profile))
+
+	// The block is an hour late, the next renewal is scheduled on the expiration time anyway
+	s.ctx = s.ctx.WithBlockHeight(1000).WithBlockTime(t0.Add(month + time.Hour))
+	s.nextBlock()
+	s.Equal(t0.Add(2*month).Unix(), s.k.GetActivityInfo(s.ctx, user).ExpireTime)
+	s.Equal([]time.Time{t0.Add(2 * month)}, s.renewalTimes(user))
+
+	// Paid in advance, the next renewal is still a month later
+	s.NoError(s.k.PayForSubscription(s.ctx, user, 5*util.GBSize))
+	s.Equal(t0.Add(3*month).Unix(), s.k.GetActivityInfo(s.ctx, user).ExpireTime)
+
+	s.ctx = s.ctx.WithBlockTime(t0.Add(2 * month))
+	s.nextBlock()
+	s.Equal(t0.Add(3*month).Unix(), s.k.GetActivityInfo(s.ctx, user).ExpireTime)
+	s.Equal([]time.Time{t0.Add(3 * month)}, s.renewalTimes(user))
+}
+
 func (s Suite) TestAutoPayment_StorageLimitRegression() {
 	user := app.DefaultGenesisUsers["user1"]
 
@@ -165,8 +249,174 @@ func (s Suite) TestAutoPayment_InsufficientFunds() {
 	s.Equal(util.Uartrs(213_999999), s.accKeeper.GetAccount(s.ctx, user).GetCoins())
 }
 
+func (s Suite) TestRateOracle_Median() {
+	s.setRateSigners("user1", "user2", "user3")
+
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user1"], 100500))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user2"], 99000))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user3"], 101000))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user3"], 102000))
+	s.Equal(3, len(s.k.GetRateVotes(s.ctx)))
+
+	s.passRateWindow()
+	s.Equal(uint32(100500), s.k.GetParams(s.ctx).TokenCourse)
+	s.Empty(s.k.GetRateVotes(s.ctx))
+	s.Equal(
+		[]subscription.RateRecord{{Height: s.ctx.BlockHeight(), Value: 100500, Votes: 3}},
+		s.k.GetRateHistory(s.ctx, 10, 1),
+	)
+}
+
+func (s Suite) TestRateOracle_Outlier() {
+	s.setRateSigners("user1", "user2", "user3", "user4")
+
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user1"], 100000))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user2"], 102000))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user3"], 104000))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user4"], 1000))
+
+	s.passRateWindow()
+	s.Equal(uint32(102000), s.k.GetParams(s.ctx).TokenCourse)
+	s.Equal(uint32(3), s.k.GetRateHistory(s.ctx, 10, 1)[0].Votes)
+}
+
+func (s Suite) TestRateOracle_Fallback() {
+	s.setRateSigners("user1", "user2", "user3")
+	params := s.k.GetParams(s.ctx)
+	params.RateMinVotes = 2
+	s.k.SetParams(s.ctx, params)
+
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user1"], 120000))
+
+	s.passRateWindow()
+	s.Equal(uint32(100000), s.k.GetParams(s.ctx).TokenCourse)
+	s.Empty(s.k.GetRateHistory(s.ctx, 10, 1))
+	s.Empty(s.k.GetRateVotes(s.ctx))
+}
+
+func (s Suite) TestRateOracle_RemovedSigner() {
+	s.setRateSigners("user1", "user2", "user3")
+
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user1"], 100500))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user2"], 100500))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user3"], 109000))
+	s.setRateSigners("user1", "user2")
+
+	s.passRateWindow()
+	s.Equal(uint32(100500), s.k.GetParams(s.ctx).TokenCourse)
+	s.Equal(uint32(2), s.k.GetRateHistory(s.ctx, 10, 1)[0].Votes)
+}
+
+func (s Suite) TestRateOracle_Majority() {
+	s.setRateSigners("user1", "user2", "user3", "user4")
+
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user1"], 120000))
+	s.NoError(s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user2"], 120000))
+
+	s.passRateWindow()
+	s.Equal(uint32(100000), s.k.GetParams(s.ctx).TokenCourse)
+	s.Empty(s.k.GetRateHistory(s.ctx, 10, 1))
+}
+
+func (s Suite) TestRateOracle_NotSigner() {
+	s.Equal(subscription.ErrNotRateSigner, s.k.VoteTokenRate(s.ctx, app.DefaultGenesisUsers["user2"], 120000))
+	s.Empty(s.k.GetRateVotes(s.ctx))
+}
+
+func (s Suite) TestQuote() {
+	user := app.DefaultGenesisUsers["user1"]
+
+	quote, err := s.k.Quote(s.ctx, user, 10*util.GBSize, 3*util.GBSize)
+	s.NoError(err)
+	s.Equal(int64(199_000000), quote.Subscription.Amount)
+	s.Equal(int64(597000), quote.Subscription.TxFee)
+	s.Equal(subscription.QuoteItem{Volume: 10 * util.GBSize, Amount: 10_000000, TxFee: 30000}, quote.Storage)
+	s.Equal(subscription.QuoteItem{Volume: 3 * util.GBSize, Amount: 3_000000, TxFee: 9000}, quote.VPN)
+	s.Equal(int64(3_968060+2_991000), quote.VPNFund)
+	s.Equal(int64(7_936120+9_970000), quote.StorageFund)
+	s.Equal(int64(597000+30000+9000), quote.TxFee)
+	s.Equal(int64(212_000000), quote.Total)
+
+	referral := int64(0)
+	for _, item := range quote.Referral {
+		referral += item.Amount
+	}
+	s.Equal(quote.Subscription.Amount-quote.Subscription.TxFee, referral+3_968060+7_936120)
+}
+
+func (s Suite) TestQuote_Large() {
+	user := app.DefaultGenesisUsers["user1"]
+
+	quote, err := s.k.Quote(s.ctx, user, (1<<20)*util.GBSize, 0)
+	s.NoError(err)
+	s.Equal(int64(1<<20)*1_000000, quote.Storage.Amount)
+
+	quote, err = s.k.Quote(s.ctx, user, 0, math.MaxInt64)
+	s.NoError(err)
+	s.Equal(sdk.NewInt(math.MaxInt64).MulRaw(1_000000).QuoRaw(util.GBSize).Int64(), quote.VPN.Amount)
+}
+
+func (s Suite) TestQuote_MatchesPayment() {
+	user := app.DefaultGenesisUsers["user1"]
+
+	quote, err := s.k.Quote(s.ctx, user, 0, 0)
+	s.NoError(err)
+	s.NoError(s.k.PayForSubscription(s.ctx, user, 5*util.GBSize))
+	s.Equal(util.Uartrs(quote.VPNFund), s.supplyKeeper.GetModuleAccount(s.ctx, vpn.ModuleName).GetCoins())
+	s.Equal(util.Uartrs(quote.StorageFund), s.supplyKeeper.GetModuleAccount(s.ctx, storage.ModuleName).GetCoins())
+}
+
+func (s Suite) TestPriceHistory() {
+	s.Empty(s.k.GetPriceHistory(s.ctx, 10, 1))
+
+	s.k.SetTokenCourse(s.ctx, 100000)
+	s.Empty(s.k.GetPriceHistory(s.ctx, 10, 1))
+
+	s.k.SetTokenCourse(s.ctx, 120000)
+	s.ctx = s.ctx.WithBlockHeight(5)
+	params := s.k.GetParams(s.ctx)
+	params.SubscriptionPrice = 2490
+	s.k.SetParams(s.ctx, params)
+
+	history := s.k.GetPriceHistory(s.ctx, 10, 1)
+	s.Equal(2, len(history))
+	s.Equal(int64(5), history[0].Height)
+	s.Equal(uint32(2490), history[0].SubscriptionPrice)
+	s.Equal(uint32(120000), history[0].TokenCourse)
+	s.Equal(int64(1), history[1].Height)
+	s.Equal(uint32(1990), history[1].SubscriptionPrice)
+	s.Equal(uint32(120000), history[1].TokenCourse)
+
+	s.Equal(history[1:], s.k.GetPriceHistory(s.ctx, 1, 2))
+}
+
 // ----- private functions ------------
 
+func (s *Suite) setRateSigners(users ...string) {
+	params := s.k.GetParams(s.ctx)
+	params.CourseChangeSigners = make([]sdk.AccAddress, len(users))
+	for i, user := range users {
+		params.CourseChangeSigners[i] = app.DefaultGenesisUsers[user]
+	}
+	s.k.SetParams(s.ctx, params)
+}
+
+func (s *Suite) passRateWindow() {
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + s.k.GetParams(s.ctx).RateVoteWindow - 1)
+	s.nextBlock()
+}
+
+
+func (s *Suite) renewalTimes(acc sdk.AccAddress) []time.Time {
+	var result []time.Time
+	for _, task := range s.app.GetScheduleKeeper().GetTasksByAccount(s.ctx, acc) {
+		if task.HandlerName == subscription.HookName && task.Time != nil {
+			result = append(result, task.Time.UTC())
+		}
+	}
+	return result
+}
+
 func (s *Suite) setBalance(acc sdk.AccAddress, coins sdk.Coins) error {
 	item := s.accKeeper.GetAccount(s.ctx, acc)
 	if item == nil {
@@ -15,7 +15,22 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 }
 
 // SetParams sets the subscription parameters to the param space.
+// If the course or any price changes, a new price history record is stored.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if k.paramspace.Has(ctx, types.KeyTokenCourse) {
+		course, subscription, vpn, storage, baseStorage, baseVpn := k.GetPrices(ctx)
+		old := types.PriceRecord{
+			TokenCourse:       course,
+			SubscriptionPrice: subscription,
+			VPNGBPrice:        vpn,
+			StorageGBPrice:    storage,
+			BaseVPNGb:         baseVpn,
+			BaseStorageGb:     baseStorage,
+		}
+		if !old.SamePrices(params) {
+			k.SetPriceRecord(ctx, types.NewPriceRecord(ctx.BlockHeight(), params))
+		}
+	}
 	k.paramspace.SetParamSet(ctx, &params)
 }
 
@@ -45,3 +60,33 @@ func (k Keeper) RemoveCourseChangeSigner(ctx sdk.Context, address sdk.AccAddress
 		}
 	}
 }
+
+// GetPriceHistory returns course and prices changes, the most recent first
+func (k Keeper) GetPriceHistory(ctx sdk.Context, limit int32, page int32) []types.PriceRecord {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.PriceHistoryPrefix)
+	defer iterator.Close()
+
+	records := make([]types.PriceRecord, 0)
+	start := limit * (page - 1)
+	end := limit * page
+
+	for current := int32(0); iterator.Valid() && (current < end); iterator.Next() {
+		if current < start {
+			current++
+			continue
+		}
+		current++
+		var record types.PriceRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func (k Keeper) SetPriceRecord(ctx sdk.Context, record types.PriceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(heightKey(types.PriceHistoryPrefix, record.Height), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+}
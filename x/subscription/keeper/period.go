@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/x/subscription/types"
+)
+
+// monthDuration returns a wall-clock subscription period, zero means periods are measured in blocks
+func (k Keeper) monthDuration(ctx sdk.Context) time.Duration {
+	var d time.Duration
+	k.paramspace.Get(ctx, types.KeyMonthDuration, &d)
+	return d
+}
+
+// wallClockMonth returns a wall-clock subscription period for accounts already switched to wall-clock time.
+// If the param was reset to zero after that, the default one is used until the account is reactivated.
+func (k Keeper) wallClockMonth(ctx sdk.Context) time.Duration {
+	if d := k.monthDuration(ctx); d > 0 {
+		return d
+	}
+	return types.DefaultWallClockMonth
+}
+
+// startPeriod sets an expiration of a subscription being activated. A kind of period (blocks or wall-clock
+// time) is chosen depending on the MonthDuration param.
+func (k Keeper) startPeriod(ctx sdk.Context, info *types.ActivityInfo) {
+	if d := k.monthDuration(ctx); d > 0 {
+		info.ExpireAt = 0
+		info.ExpireTime = ctx.BlockTime().Add(d).Unix()
+	} else {
+		info.ExpireAt = ctx.BlockHeight() + oneMonth
+		info.ExpireTime = 0
+	}
+}
+
+// extendPeriod prolongs an active subscription for one more month
+func (k Keeper) extendPeriod(ctx sdk.Context, info *types.ActivityInfo) {
+	if info.ExpireTime != 0 {
+		info.ExpireTime = time.Unix(info.ExpireTime, 0).Add(k.wallClockMonth(ctx)).Unix()
+	} else {
+		info.ExpireAt += oneMonth
+	}
+}
+
+// isExpired checks if a subscription period is over
+func (k Keeper) isExpired(ctx sdk.Context, info types.ActivityInfo) bool {
+	if info.ExpireTime != 0 {
+		return ctx.BlockTime().Unix() >= info.ExpireTime
+	}
+	return info.ExpireAt <= ctx.BlockHeight()
+}
+
+// remainingPart returns a part of the current month left as a numerator/denominator pair
+func (k Keeper) remainingPart(ctx sdk.Context, info types.ActivityInfo) (int64, int64) {
+	if info.ExpireTime != 0 {
+		return info.ExpireTime - ctx.BlockTime().Unix(), int64(k.wallClockMonth(ctx) / time.Second)
+	}
+	return info.ExpireAt - ctx.BlockHeight(), oneMonth
+}
+
+// scheduleRenewal schedules the next ProcessSchedule call one month later, in blocks or in wall-clock time
+// depending on the account's subscription period kind. Wall-clock renewals are aligned to the account's expiration
+// time, so that a delayed hook doesn't shift the next one.
+func (k Keeper) scheduleRenewal(ctx sdk.Context, addr sdk.AccAddress, info types.ActivityInfo) {
+	if info.ExpireTime == 0 {
+		k.ScheduleRenew(ctx, addr, ctx.BlockHeight()+oneMonth)
+		return
+	}
+	month := k.wallClockMonth(ctx)
+	next := time.Unix(info.ExpireTime, 0)
+	for next.Add(-month).After(ctx.BlockTime()) {
+		// paid for several months in advance
+		next = next.Add(-month)
+	}
+	if !next.After(ctx.BlockTime()) {
+		// the hook is late for more than a month
+		next = ctx.BlockTime().Add(time.Second)
+	}
+	bytes := addr.Bytes()
+	if _, err := k.scheduleKeeper.ScheduleTaskAt(ctx, next, types.HookName, &bytes); err != nil {
+		k.Logger(ctx).Error("cannot schedule renewal", "err", err, "addr", addr)
+	}
+}
+
+func expireAtString(info types.ActivityInfo) string {
+	if info.ExpireTime != 0 {
+		return time.Unix(info.ExpireTime, 0).UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf("%d", info.ExpireAt)
+}
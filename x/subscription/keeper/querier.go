@@ -19,6 +19,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryPrices(ctx, k, req)
 		case types.QueryParams:
 			return queryParams(ctx, k)
+		case types.QueryRateVotes:
+			return queryRateVotes(ctx, k)
+		case types.QueryRateHistory:
+			return queryRateHistory(ctx, k, req)
+		case types.QueryQuote:
+			return queryQuote(ctx, k, req)
+		case types.QueryPriceHistory:
+			return queryPriceHistory(ctx, k, req)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown subscription query endpoint "+path[0])
 		}
@@ -76,3 +84,67 @@ func queryPrices(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, erro
 
 	return bz, nil
 }
+
+func queryRateVotes(ctx sdk.Context, k Keeper) ([]byte, error) {
+	votes := k.GetRateVotes(ctx)
+	if votes == nil {
+		votes = make([]types.RateVote, 0)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, votes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryRateHistory(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.QueryRateHistoryParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetRateHistory(ctx, params.Limit, params.Page))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryQuote(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.QueryQuoteParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	quote, err := k.Quote(ctx, params.Address, params.ExtraStorage, params.ExtraVPN)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, quote)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPriceHistory(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.QueryPriceHistoryParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetPriceHistory(ctx, params.Limit, params.Page))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
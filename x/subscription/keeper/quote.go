@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/subscription/types"
+)
+
+// Quote calculates how much a subscription plus extra storage and VPN traffic (both in bytes) will cost
+// for the account. It follows the same math as PayForSubscription, PayForStorage and PayForVPN do.
+func (k Keeper) Quote(ctx sdk.Context, addr sdk.AccAddress, extraStorage, extraVPN int64) (types.QueryQuoteRes, error) {
+	if extraStorage < 0 || extraVPN < 0 {
+		return types.QueryQuoteRes{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "extra amounts must be non-negative")
+	}
+
+	course, subscriptionPrice, vpnPrice, storagePrice, _, _ := k.GetPrices(ctx)
+	feeSchedule := k.bankKeeper.GetFeeSchedule(ctx)
+
+	amount := sdk.NewInt(int64(subscriptionPrice) * int64(course))
+	txFee := util.CalculateFee(feeSchedule, types.PaySubscriptionConst, amount)
+	rest := amount.Sub(txFee)
+
+	fees, err := k.ReferralKeeper.GetReferralFeesForSubscription(ctx, addr)
+	if err != nil {
+		return types.QueryQuoteRes{}, err
+	}
+	referral := make([]types.QuoteReferralItem, len(fees))
+	totalReferral := int64(0)
+	for i, fee := range fees {
+		x := fee.Ratio.MulInt64(rest.Int64()).Int64()
+		totalReferral += x
+		referral[i] = types.QuoteReferralItem{Beneficiary: fee.Beneficiary, Amount: x}
+	}
+	moduleFee := rest.SubRaw(totalReferral)
+	vpnFee := moduleFee.QuoRaw(3)
+
+	storage, err := quoteService(extraStorage, storagePrice, course, feeSchedule, types.PayStorageConst)
+	if err != nil {
+		return types.QueryQuoteRes{}, err
+	}
+	vpn, err := quoteService(extraVPN, vpnPrice, course, feeSchedule, types.PayVPNConst)
+	if err != nil {
+		return types.QueryQuoteRes{}, err
+	}
+	total := amount.AddRaw(storage.Amount).AddRaw(vpn.Amount)
+	if !total.IsInt64() {
+		return types.QueryQuoteRes{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "extra amounts are too large")
+	}
+
+	res := types.QueryQuoteRes{
+		Course: int64(course),
+		Subscription: types.QuoteItem{
+			Amount: amount.Int64(),
+			TxFee:  txFee.Int64(),
+		},
+		Storage:     storage,
+		VPN:         vpn,
+		Referral:    referral,
+		VPNFund:     vpnFee.Int64(),
+		StorageFund: moduleFee.Sub(vpnFee).Int64(),
+		Total:       total.Int64(),
+	}
+	res.VPNFund += res.VPN.Amount - res.VPN.TxFee
+	res.StorageFund += res.Storage.Amount - res.Storage.TxFee
+	res.TxFee = res.Subscription.TxFee + res.Storage.TxFee + res.VPN.TxFee
+
+	return res, nil
+}
+
+func quoteService(volume int64, price, course uint32, feeSchedule util.FeeSchedule, msgType string) (types.QuoteItem, error) {
+	amount := sdk.NewInt(volume).MulRaw(int64(price)).MulRaw(int64(course)).QuoRaw(util.GBSize)
+	if !amount.IsInt64() {
+		return types.QuoteItem{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "extra amount is too large")
+	}
+	return types.QuoteItem{
+		Volume: volume,
+		Amount: amount.Int64(),
+		TxFee:  util.CalculateFee(feeSchedule, msgType, amount).Int64(),
+	}, nil
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/subscription/types"
+)
+
+// VoteTokenRate records a signer's exchange rate vote for the current window.
+// The first vote of a window schedules its tally. A signer's repeated vote replaces the previous one.
+func (k Keeper) VoteTokenRate(ctx sdk.Context, signer sdk.AccAddress, value uint32) error {
+	if !k.isCourseChangeSigner(ctx, signer) {
+		return types.ErrNotRateSigner
+	}
+
+	if !k.hasRateVotes(ctx) {
+		var window int64
+		k.paramspace.Get(ctx, types.KeyRateVoteWindow, &window)
+		if _, err := k.scheduleKeeper.ScheduleTask(ctx, uint64(ctx.BlockHeight()+window), types.RateTallyHookName, &noPayload); err != nil {
+			return err
+		}
+	}
+	k.SetRateVote(ctx, types.RateVote{Signer: signer, Value: value})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRateVote,
+		sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+		sdk.NewAttribute(types.AttributeKeyRate, fmt.Sprintf("%d", value)),
+	))
+	return nil
+}
+
+// ProcessRateTally closes the current voting window. Votes of accounts that are no longer signers are dropped,
+// votes too far from the median are rejected, the median of the rest becomes a new token course. If there are
+// not enough votes (see Params.RateQuorum), the last good course stays in effect.
+func (k Keeper) ProcessRateTally(ctx sdk.Context, _ []byte) {
+	votes := k.GetRateVotes(ctx)
+	k.clearRateVotes(ctx)
+	if len(votes) == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	signers := make(map[string]bool, len(params.CourseChangeSigners))
+	for _, signer := range params.CourseChangeSigners {
+		signers[signer.String()] = true
+	}
+	current := votes[:0]
+	for _, vote := range votes {
+		if !signers[vote.Signer.String()] {
+			// The signer has been removed since the vote
+			continue
+		}
+		current = append(current, vote)
+	}
+	votes = current
+	if len(votes) == 0 {
+		k.rateFallback(ctx, 0, params)
+		return
+	}
+
+	values := make([]uint32, len(votes))
+	for i, vote := range votes {
+		values[i] = vote.Value
+	}
+	median := medianRate(values)
+
+	accepted := make([]uint32, 0, len(values))
+	for _, vote := range votes {
+		diff := int64(vote.Value) - int64(median)
+		if diff < 0 {
+			diff = -diff
+		}
+		if util.FractionInt(diff).GT(params.RateMaxDeviation.MulInt64(int64(median))) {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRateVoteReject,
+				sdk.NewAttribute(types.AttributeKeySigner, vote.Signer.String()),
+				sdk.NewAttribute(types.AttributeKeyRate, fmt.Sprintf("%d", vote.Value)),
+			))
+			continue
+		}
+		accepted = append(accepted, vote.Value)
+	}
+
+	if len(accepted) < params.RateQuorum() {
+		k.rateFallback(ctx, len(accepted), params)
+		return
+	}
+
+	rate := medianRate(accepted)
+	k.SetTokenCourse(ctx, rate)
+	k.SetRateRecord(ctx, types.RateRecord{
+		Height: ctx.BlockHeight(),
+		Value:  rate,
+		Votes:  uint32(len(accepted)),
+	})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRateChange,
+		sdk.NewAttribute(types.AttributeKeyRate, fmt.Sprintf("%d", rate)),
+		sdk.NewAttribute(types.AttributeKeyVotes, fmt.Sprintf("%d", len(accepted))),
+	))
+}
+
+// rateFallback keeps the last good course if there are not enough rate votes
+func (k Keeper) rateFallback(ctx sdk.Context, votes int, params types.Params) {
+	k.Logger(ctx).Info("not enough rate votes, keeping the last course",
+		"votes", votes, "quorum", params.RateQuorum(), "course", params.TokenCourse)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRateFallback,
+		sdk.NewAttribute(types.AttributeKeyRate, fmt.Sprintf("%d", params.TokenCourse)),
+		sdk.NewAttribute(types.AttributeKeyVotes, fmt.Sprintf("%d", votes)),
+	))
+}
+
+func (k Keeper) GetRateVotes(ctx sdk.Context) []types.RateVote {
+	var result []types.RateVote
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.RateVotePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var value uint32
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &value)
+		result = append(result, types.RateVote{
+			Signer: sdk.AccAddress(it.Key()[len(types.RateVotePrefix):]),
+			Value:  value,
+		})
+	}
+	return result
+}
+
+func (k Keeper) SetRateVote(ctx sdk.Context, vote types.RateVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(rateVoteKey(vote.Signer), k.cdc.MustMarshalBinaryLengthPrefixed(vote.Value))
+}
+
+// GetRateHistory returns accepted exchange rates, the most recent first
+func (k Keeper) GetRateHistory(ctx sdk.Context, limit int32, page int32) []types.RateRecord {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.RateHistoryPrefix)
+	defer iterator.Close()
+
+	records := make([]types.RateRecord, 0)
+	start := limit * (page - 1)
+	end := limit * page
+
+	for current := int32(0); iterator.Valid() && (current < end); iterator.Next() {
+		if current < start {
+			current++
+			continue
+		}
+		current++
+		var record types.RateRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func (k Keeper) SetRateRecord(ctx sdk.Context, record types.RateRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(heightKey(types.RateHistoryPrefix, record.Height), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+}
+
+func (k Keeper) isCourseChangeSigner(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for _, signer := range k.GetParams(ctx).CourseChangeSigners {
+		if bytes.Equal(signer, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k Keeper) hasRateVotes(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.RateVotePrefix)
+	defer it.Close()
+	return it.Valid()
+}
+
+func (k Keeper) clearRateVotes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	it := sdk.KVStorePrefixIterator(store, types.RateVotePrefix)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+var noPayload []byte = nil
+
+func rateVoteKey(signer sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.RateVotePrefix...), signer...)
+}
+
+func heightKey(prefix []byte, height int64) []byte {
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], uint64(height))
+	return key
+}
+
+// medianRate returns a median value, for an even number of values it's a mean of the two middle ones
+func medianRate(values []uint32) uint32 {
+	sorted := make([]uint32, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return uint32((uint64(sorted[n/2-1]) + uint64(sorted[n/2])) / 2)
+}
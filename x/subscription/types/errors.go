@@ -7,4 +7,5 @@ import (
 // You can see how they are constructed below:
 var (
 	ErrInactiveSubscription = sdkerrors.Register(ModuleName, 1, "Subscription is inactive")
+	ErrNotRateSigner        = sdkerrors.Register(ModuleName, 2, "sender is not in allowed signer list")
 )
@@ -8,6 +8,10 @@ const (
 	EventTypeFee             = "subscription_fee"
 	EventTypeActivityChange  = "activity_change"
 	EventTypeAutoPayFailed   = "autopay_failed"
+	EventTypeRateVote        = "rate_vote"
+	EventTypeRateVoteReject  = "rate_vote_rejected"
+	EventTypeRateChange      = "rate_change"
+	EventTypeRateFallback    = "rate_fallback"
 
 	AttributeKeyAddress  = "address"
 	AttributeKeyExpireAt = "expire_at"
@@ -15,6 +19,9 @@ const (
 	AttributeKeyAmount   = "amount"
 	AttributeKeyNodeFee  = "node_fee"
 	AttributeKeyActive   = "active"
+	AttributeKeySigner   = "signer"
+	AttributeKeyRate     = "rate"
+	AttributeKeyVotes    = "votes"
 
 	AttributeValueCategory          = ModuleName
 	AttributeValueKeyActiveActive   = "active"
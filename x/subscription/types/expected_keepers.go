@@ -1,6 +1,9 @@
 package types
 
 import (
+	"time"
+
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/bank"
 	"github.com/arterynetwork/artr/x/profile/types"
 	referral "github.com/arterynetwork/artr/x/referral/types"
@@ -12,6 +15,7 @@ import (
 type ParamSubspace interface {
 	WithKeyTable(table params.KeyTable) params.Subspace
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
 	GetParamSet(ctx sdk.Context, ps params.ParamSet)
 	SetParamSet(ctx sdk.Context, ps params.ParamSet)
 }
@@ -21,6 +25,7 @@ type BankKeeper interface {
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error)
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) error
+	util.FeeKeeper
 }
 
 type ReferralKeeper interface {
@@ -29,12 +34,12 @@ type ReferralKeeper interface {
 }
 
 type ScheduleKeeper interface {
-	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) error
+	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) (uint64, error)
+	ScheduleTaskAt(ctx sdk.Context, t time.Time, event string, data *[]byte) (uint64, error)
 }
 
 type VPNKeeper interface {
-	SetLimit(ctx sdk.Context, addr sdk.AccAddress, value int64)
-	SetCurrent(ctx sdk.Context, addr sdk.AccAddress, value int64)
+	StartPeriod(ctx sdk.Context, addr sdk.AccAddress, limit int64)
 	AddLimit(ctx sdk.Context, addr sdk.AccAddress, value int64) (int64, error)
 }
 
@@ -12,15 +12,21 @@ type GenesisActivityInfo struct {
 
 // GenesisState - all subscription state that must be provided at genesis
 type GenesisState struct {
-	Params   Params                `json:"params" yaml:"params"`
-	Activity []GenesisActivityInfo `json:"activity" yaml:"activity"`
+	Params       Params                `json:"params" yaml:"params"`
+	Activity     []GenesisActivityInfo `json:"activity" yaml:"activity"`
+	RateVotes    []RateVote            `json:"rate_votes,omitempty" yaml:"rate_votes,omitempty"`
+	RateHistory  []RateRecord          `json:"rate_history,omitempty" yaml:"rate_history,omitempty"`
+	PriceHistory []PriceRecord         `json:"price_history,omitempty" yaml:"price_history,omitempty"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, activity []GenesisActivityInfo) GenesisState {
+func NewGenesisState(params Params, activity []GenesisActivityInfo, rateVotes []RateVote, rateHistory []RateRecord, priceHistory []PriceRecord) GenesisState {
 	return GenesisState{
-		Params:   params,
-		Activity: activity,
+		Params:       params,
+		Activity:     activity,
+		RateVotes:    rateVotes,
+		RateHistory:  rateHistory,
+		PriceHistory: priceHistory,
 	}
 }
 
@@ -42,6 +48,19 @@ func ValidateGenesis(data GenesisState) error {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
 		}
 	}
+	for _, vote := range data.RateVotes {
+		if vote.Signer.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid rate vote signer address")
+		}
+		if vote.Value == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate vote must be positive")
+		}
+	}
+	for _, record := range data.RateHistory {
+		if record.Value == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate history value must be positive")
+		}
+	}
 
 	return nil
 }
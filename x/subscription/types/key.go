@@ -15,4 +15,15 @@ const (
 
 	// hook name
 	HookName = ModuleName + "/refresh"
+
+	// RateTallyHookName is a name of the hook that closes a rate voting window
+	RateTallyHookName = ModuleName + "/rate-tally"
+)
+
+var (
+	// Activity info is stored under auth.AddressStoreKey (0x01 prefix)
+
+	RateVotePrefix     = []byte{0x02}
+	RateHistoryPrefix  = []byte{0x03}
+	PriceHistoryPrefix = []byte{0x04}
 )
@@ -2,10 +2,14 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/arterynetwork/artr/util"
 )
 
 // Default parameter namespace
@@ -21,8 +25,19 @@ const (
 	DefaultStorageGbPrice    uint32 = 10
 	DefaultBaseVPNGb         uint32 = 7
 	DefaultBaseStorageGb     uint32 = 5
+
+	// Rate oracle: votes are collected for an hour, at least two votes (and a majority of signers) are required
+	DefaultRateVoteWindow int64  = util.BlocksOneHour
+	DefaultRateMinVotes   uint32 = 2
 )
 
+// DefaultWallClockMonth is a subscription period used for accounts with wall-clock periods if MonthDuration
+// param is reset to zero
+const DefaultWallClockMonth = 30 * 24 * time.Hour
+
+// DefaultRateMaxDeviation is a maximum relative distance from the median a rate vote can have not to be rejected
+var DefaultRateMaxDeviation = util.Percent(10)
+
 // Parameter store keys
 var (
 	// KeyParamName          = []byte("ParamName")
@@ -33,6 +48,10 @@ var (
 	KeyBaseVPNGb           = []byte("BaseVPNGb")
 	KeyBaseStorageGb       = []byte("BaseStorageGb")
 	KeyCourseChangeSigners = []byte("CourseChangeSigners")
+	KeyRateVoteWindow      = []byte("RateVoteWindow")
+	KeyRateMinVotes        = []byte("RateMinVotes")
+	KeyRateMaxDeviation    = []byte("RateMaxDeviation")
+	KeyMonthDuration       = []byte("MonthDuration")
 )
 
 // ParamKeyTable for subscription module
@@ -52,11 +71,20 @@ type Params struct {
 	BaseVPNGb           uint32           `json:"base_vpn_gb" yaml:"base_vpn_gb"`
 	BaseStorageGb       uint32           `json:"base_storage_gb" yaml:"base_storage_gb"`
 	CourseChangeSigners []sdk.AccAddress `json:"course_change_signers" yaml:"course_change_signers"`
+	RateVoteWindow      int64            `json:"rate_vote_window" yaml:"rate_vote_window"`
+	// RateMinVotes is how many accepted votes are required to change the course, a majority of signers is required
+	// anyway (see RateQuorum)
+	RateMinVotes     uint32        `json:"rate_min_votes" yaml:"rate_min_votes"`
+	RateMaxDeviation util.Fraction `json:"rate_max_deviation" yaml:"rate_max_deviation"`
+	// MonthDuration is a wall-clock subscription period. Zero means periods are measured in blocks
+	// (util.BlocksOneMonth).
+	MonthDuration time.Duration `json:"month_duration" yaml:"month_duration"`
 }
 
 // NewParams creates a new Params object
 func NewParams(tokenCourse, subscriptionPrice, VPNGBPrice,
-	storageGBPrice, baseVPNGb, baseStorageGb uint32, courseSigners []sdk.AccAddress) Params {
+	storageGBPrice, baseVPNGb, baseStorageGb uint32, courseSigners []sdk.AccAddress,
+	rateVoteWindow int64, rateMinVotes uint32, rateMaxDeviation util.Fraction, monthDuration time.Duration) Params {
 	return Params{
 		TokenCourse:         tokenCourse,
 		SubscriptionPrice:   subscriptionPrice,
@@ -65,6 +93,10 @@ func NewParams(tokenCourse, subscriptionPrice, VPNGBPrice,
 		BaseVPNGb:           baseVPNGb,
 		BaseStorageGb:       baseStorageGb,
 		CourseChangeSigners: courseSigners[:],
+		RateVoteWindow:      rateVoteWindow,
+		RateMinVotes:        rateMinVotes,
+		RateMaxDeviation:    rateMaxDeviation,
+		MonthDuration:       monthDuration,
 	}
 }
 
@@ -77,7 +109,11 @@ func (p Params) String() string {
 			"StorageGBPrice: %d\n"+
 			"BaseVPNGb: %d\n"+
 			"BaseStorageGb: %d\n"+
-			"CouseChangeSigners: %v\n",
+			"CouseChangeSigners: %v\n"+
+			"RateVoteWindow: %d\n"+
+			"RateMinVotes: %d\n"+
+			"RateMaxDeviation: %s\n"+
+			"MonthDuration: %s\n",
 		p.TokenCourse,
 		p.SubscriptionPrice,
 		p.VPNGBPrice,
@@ -85,9 +121,23 @@ func (p Params) String() string {
 		p.BaseVPNGb,
 		p.BaseStorageGb,
 		p.CourseChangeSigners,
+		p.RateVoteWindow,
+		p.RateMinVotes,
+		p.RateMaxDeviation,
+		p.MonthDuration,
 	)
 }
 
+// RateQuorum returns how many accepted rate votes are required to change the course: RateMinVotes, but no less than
+// a majority of signers
+func (p Params) RateQuorum() int {
+	n := len(p.CourseChangeSigners)/2 + 1
+	if int(p.RateMinVotes) > n {
+		n = int(p.RateMinVotes)
+	}
+	return n
+}
+
 // ParamSetPairs - Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
@@ -98,6 +148,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyBaseVPNGb, &p.BaseVPNGb, validateBaseVPNGb),
 		params.NewParamSetPair(KeyBaseStorageGb, &p.BaseStorageGb, validateBaseStorageGb),
 		params.NewParamSetPair(KeyCourseChangeSigners, &p.CourseChangeSigners, validateCourseChangeSigners),
+		params.NewParamSetPair(KeyRateVoteWindow, &p.RateVoteWindow, validateRateVoteWindow),
+		params.NewParamSetPair(KeyRateMinVotes, &p.RateMinVotes, validateRateMinVotes),
+		params.NewParamSetPair(KeyRateMaxDeviation, &p.RateMaxDeviation, validateRateMaxDeviation),
+		params.NewParamSetPair(KeyMonthDuration, &p.MonthDuration, validateMonthDuration),
 	}
 }
 
@@ -111,6 +165,10 @@ func DefaultParams() Params {
 		DefaultBaseVPNGb,
 		DefaultBaseStorageGb,
 		nil,
+		DefaultRateVoteWindow,
+		DefaultRateMinVotes,
+		DefaultRateMaxDeviation,
+		0,
 	)
 }
 
@@ -211,6 +269,58 @@ func validateCourseChangeSigners(i interface{}) error {
 	return nil
 }
 
+func validateRateVoteWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("invalid rate vote window: %d", v)
+	}
+
+	return nil
+}
+
+func validateRateMinVotes(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid rate min votes: %d", v)
+	}
+
+	return nil
+}
+
+func validateRateMaxDeviation(i interface{}) error {
+	v, ok := i.(util.Fraction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNullValue() || v.IsNegative() {
+		return fmt.Errorf("invalid rate max deviation: %s", v)
+	}
+
+	return nil
+}
+
+func validateMonthDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("invalid month duration: %s", v)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTokenCourse(p.TokenCourse); err != nil {
@@ -234,6 +344,18 @@ func (p Params) Validate() error {
 	if err := validateCourseChangeSigners(p.CourseChangeSigners); err != nil {
 		return err
 	}
+	if err := validateRateVoteWindow(p.RateVoteWindow); err != nil {
+		return err
+	}
+	if err := validateRateMinVotes(p.RateMinVotes); err != nil {
+		return err
+	}
+	if err := validateRateMaxDeviation(p.RateMaxDeviation); err != nil {
+		return err
+	}
+	if err := validateMonthDuration(p.MonthDuration); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,8 +12,21 @@ const (
 	QueryActivityInfo = "info"
 	QueryPrices       = "prices"
 	QueryParams       = "params"
+	QueryRateVotes    = "rate_votes"
+	QueryRateHistory  = "rate_history"
+	QueryQuote        = "quote"
+	QueryPriceHistory = "price_history"
 )
 
+type QueryRateHistoryParams struct {
+	Limit int32 `json:"limit" yaml:"limit"`
+	Page  int32 `json:"page" yaml:"page"`
+}
+
+func (q QueryRateHistoryParams) String() string {
+	return fmt.Sprintf("Limit: %d\nPage: %d\n", q.Limit, q.Page)
+}
+
 type QueryActivityParams struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
 }
@@ -27,27 +42,33 @@ func NewQueryActivityInfoParams(addr sdk.AccAddress) QueryActivityParams {
 }
 
 type QueryActivityRes struct {
-	ExpireAt int64 `json:"expire_at" yaml:"expire_at"`
-	Active   bool  `json:"active" yaml:"active"`
-	Current  int64 `json:"current" yaml:"current"`
+	ExpireAt   int64 `json:"expire_at" yaml:"expire_at"`
+	ExpireTime int64 `json:"expire_time,omitempty" yaml:"expire_time,omitempty"`
+	Active     bool  `json:"active" yaml:"active"`
+	Current    int64 `json:"current" yaml:"current"`
 }
 
 func (res QueryActivityRes) String() string {
+	expireAt := fmt.Sprintf("%d", res.ExpireAt)
+	if res.ExpireTime != 0 {
+		expireAt = time.Unix(res.ExpireTime, 0).UTC().Format(time.RFC3339)
+	}
 	return fmt.Sprintf(
 		"Active: %t\n"+
-			"ExpireAt: %d\n"+
+			"ExpireAt: %s\n"+
 			"Current: %d\n",
 		res.Active,
-		res.ExpireAt,
+		expireAt,
 		res.Current,
 	)
 }
 
 func NewQueryActivityInfoRes(info ActivityInfo, current int64) QueryActivityRes {
 	return QueryActivityRes{
-		ExpireAt: info.ExpireAt,
-		Active:   info.Active,
-		Current:  current,
+		ExpireAt:   info.ExpireAt,
+		ExpireTime: info.ExpireTime,
+		Active:     info.Active,
+		Current:    current,
 	}
 }
 
@@ -75,6 +96,96 @@ func (res QueryPricesRes) String() string {
 	)
 }
 
+type QueryQuoteParams struct {
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+	ExtraStorage int64          `json:"extra_storage" yaml:"extra_storage"`
+	ExtraVPN     int64          `json:"extra_vpn" yaml:"extra_vpn"`
+}
+
+func NewQueryQuoteParams(addr sdk.AccAddress, extraStorage, extraVPN int64) QueryQuoteParams {
+	return QueryQuoteParams{
+		Address:      addr,
+		ExtraStorage: extraStorage,
+		ExtraVPN:     extraVPN,
+	}
+}
+
+func (params QueryQuoteParams) String() string {
+	return fmt.Sprintf(
+		"Address: %s\n"+
+			"ExtraStorage: %d\n"+
+			"ExtraVPN: %d\n",
+		params.Address,
+		params.ExtraStorage,
+		params.ExtraVPN,
+	)
+}
+
+// QuoteItem is a price of a single payment, all amounts are in uARTR
+type QuoteItem struct {
+	Volume int64 `json:"volume,omitempty" yaml:"volume,omitempty"`
+	Amount int64 `json:"amount" yaml:"amount"`
+	TxFee  int64 `json:"tx_fee" yaml:"tx_fee"`
+}
+
+func (item QuoteItem) String() string {
+	return fmt.Sprintf("Volume: %d, Amount: %d, TxFee: %d", item.Volume, item.Amount, item.TxFee)
+}
+
+// QuoteReferralItem is a part of the subscription price that goes to a referral reward beneficiary
+type QuoteReferralItem struct {
+	Beneficiary sdk.AccAddress `json:"beneficiary" yaml:"beneficiary"`
+	Amount      int64          `json:"amount" yaml:"amount"`
+}
+
+func (item QuoteReferralItem) String() string {
+	return fmt.Sprintf("%s: %d", item.Beneficiary, item.Amount)
+}
+
+type QueryQuoteRes struct {
+	Course       int64               `json:"course" yaml:"course"`
+	Subscription QuoteItem           `json:"subscription" yaml:"subscription"`
+	Storage      QuoteItem           `json:"storage" yaml:"storage"`
+	VPN          QuoteItem           `json:"vpn" yaml:"vpn"`
+	Referral     []QuoteReferralItem `json:"referral" yaml:"referral"`
+	VPNFund      int64               `json:"vpn_fund" yaml:"vpn_fund"`
+	StorageFund  int64               `json:"storage_fund" yaml:"storage_fund"`
+	TxFee        int64               `json:"tx_fee" yaml:"tx_fee"`
+	Total        int64               `json:"total" yaml:"total"`
+}
+
+func (res QueryQuoteRes) String() string {
+	return fmt.Sprintf(
+		"Course: %d\n"+
+			"Subscription: %s\n"+
+			"Storage: %s\n"+
+			"VPN: %s\n"+
+			"Referral: %v\n"+
+			"VPNFund: %d\n"+
+			"StorageFund: %d\n"+
+			"TxFee: %d\n"+
+			"Total: %d\n",
+		res.Course,
+		res.Subscription,
+		res.Storage,
+		res.VPN,
+		res.Referral,
+		res.VPNFund,
+		res.StorageFund,
+		res.TxFee,
+		res.Total,
+	)
+}
+
+type QueryPriceHistoryParams struct {
+	Limit int32 `json:"limit" yaml:"limit"`
+	Page  int32 `json:"page" yaml:"page"`
+}
+
+func (q QueryPriceHistoryParams) String() string {
+	return fmt.Sprintf("Limit: %d\nPage: %d\n", q.Limit, q.Page)
+}
+
 /*
 Below you will be able how to set your own queries:
 
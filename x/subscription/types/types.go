@@ -1,10 +1,18 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type ActivityInfo struct {
 	Active   bool  `json:"active" yaml:"active"`
 	ExpireAt int64 `json:"expire_at" yaml:"expire_at"`
+	// ExpireTime (Unix time, in seconds) is set instead of ExpireAt if the subscription period is measured
+	// in wall-clock time
+	ExpireTime int64 `json:"expire_time,omitempty" yaml:"expire_time,omitempty"`
 }
 
 func NewActivityInfo(active bool, expireAt int64) ActivityInfo {
@@ -15,5 +23,81 @@ func NewActivityInfo(active bool, expireAt int64) ActivityInfo {
 }
 
 func (info ActivityInfo) String() string {
+	if info.ExpireTime != 0 {
+		return fmt.Sprintf("Active: %t\nExpire at: %s", info.Active, time.Unix(info.ExpireTime, 0).UTC().Format(time.RFC3339))
+	}
 	return fmt.Sprintf("Active: %t\nExpire at: %d", info.Active, info.ExpireAt)
 }
+
+// RateVote is an exchange rate value proposed by one of the course change signers
+type RateVote struct {
+	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
+	Value  uint32         `json:"value" yaml:"value"`
+}
+
+func (v RateVote) String() string {
+	return fmt.Sprintf("%s: %d", v.Signer, v.Value)
+}
+
+// RateRecord is an exchange rate accepted at some height
+type RateRecord struct {
+	Height int64  `json:"height" yaml:"height"`
+	Value  uint32 `json:"value" yaml:"value"`
+	Votes  uint32 `json:"votes" yaml:"votes"`
+}
+
+func (r RateRecord) String() string {
+	return fmt.Sprintf("Height: %d\nValue: %d\nVotes: %d", r.Height, r.Value, r.Votes)
+}
+
+// PriceRecord is a snapshot of the token course and service prices taken when any of them changes
+type PriceRecord struct {
+	Height            int64  `json:"height" yaml:"height"`
+	TokenCourse       uint32 `json:"token_course" yaml:"token_course"`
+	SubscriptionPrice uint32 `json:"subscription_price" yaml:"subscription_price"`
+	VPNGBPrice        uint32 `json:"vpn_gb_price" yaml:"vpn_gb_price"`
+	StorageGBPrice    uint32 `json:"storage_gb_price" yaml:"storage_gb_price"`
+	BaseVPNGb         uint32 `json:"base_vpn_gb" yaml:"base_vpn_gb"`
+	BaseStorageGb     uint32 `json:"base_storage_gb" yaml:"base_storage_gb"`
+}
+
+func NewPriceRecord(height int64, params Params) PriceRecord {
+	return PriceRecord{
+		Height:            height,
+		TokenCourse:       params.TokenCourse,
+		SubscriptionPrice: params.SubscriptionPrice,
+		VPNGBPrice:        params.VPNGBPrice,
+		StorageGBPrice:    params.StorageGBPrice,
+		BaseVPNGb:         params.BaseVPNGb,
+		BaseStorageGb:     params.BaseStorageGb,
+	}
+}
+
+func (r PriceRecord) String() string {
+	return fmt.Sprintf(
+		"Height: %d\n"+
+			"TokenCourse: %d\n"+
+			"SubscriptionPrice: %d\n"+
+			"VPNGBPrice: %d\n"+
+			"StorageGBPrice: %d\n"+
+			"BaseVPNGb: %d\n"+
+			"BaseStorageGb: %d",
+		r.Height,
+		r.TokenCourse,
+		r.SubscriptionPrice,
+		r.VPNGBPrice,
+		r.StorageGBPrice,
+		r.BaseVPNGb,
+		r.BaseStorageGb,
+	)
+}
+
+// SamePrices checks if the record contains the same course and prices as the params
+func (r PriceRecord) SamePrices(params Params) bool {
+	return r.TokenCourse == params.TokenCourse &&
+		r.SubscriptionPrice == params.SubscriptionPrice &&
+		r.VPNGBPrice == params.VPNGBPrice &&
+		r.StorageGBPrice == params.StorageGBPrice &&
+		r.BaseVPNGb == params.BaseVPNGb &&
+		r.BaseStorageGb == params.BaseStorageGb
+}
@@ -4,6 +4,8 @@ const (
 	FlagLimit = "limit"
 	FlagPage  = "page"
 
+	FlagOverride = "override"
+
 	FlagLimitDefault = int(30)
 	FlagPageDefault  = int(1)
 )
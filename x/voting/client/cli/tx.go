@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 
 	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 	"github.com/arterynetwork/artr/x/referral"
 	"github.com/arterynetwork/artr/x/voting/types"
 )
@@ -59,6 +61,15 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdSetMaxValidators(cdc),
 		getCmdSetLotteryValidators(cdc),
 		getCmdGeneralAmnesty(cdc),
+		getCmdReserveNickname(cdc),
+		getCmdReleaseNickname(cdc),
+		getCmdSetTxFee(cdc),
+		getCmdAddFeeExemption(cdc),
+		getCmdRemoveFeeExemption(cdc),
+		getCmdSetMonthDuration(cdc),
+		getCmdSetRevokePeriod(cdc),
+		getCmdSetMaxTasksPerBlock(cdc),
+		getCmdSetEarningApprovals(cdc),
 		util.LineBreak(),
 		GetCmdVote(cdc),
 	)...)
@@ -234,7 +245,7 @@ func GetCmdAddGovernor(cdc *codec.Codec) *cobra.Command {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -265,7 +276,7 @@ func GetCmdRemoveGovernor(cdc *codec.Codec) *cobra.Command {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -367,7 +378,7 @@ func GetCmdAddFreeCreator(cdc *codec.Codec) *cobra.Command {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -397,7 +408,7 @@ func GetCmdRemoveFreeCreator(cdc *codec.Codec) *cobra.Command {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -495,7 +506,7 @@ func GetCmdStaffValidatorAdd(cdc *codec.Codec) *cobra.Command {
 
 			proposalName := args[1]
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -527,7 +538,7 @@ func GetCmdStaffValidatorRemove(cdc *codec.Codec) *cobra.Command {
 
 			proposalName := args[1]
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -559,7 +570,7 @@ func GetCmdEarningSignerAdd(cdc *codec.Codec) *cobra.Command {
 
 			proposalName := args[1]
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -591,7 +602,7 @@ func GetCmdEarningSignerRemove(cdc *codec.Codec) *cobra.Command {
 
 			proposalName := args[1]
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -623,7 +634,7 @@ func GetCmdCourseChangeSignerAdd(cdc *codec.Codec) *cobra.Command {
 
 			proposalName := args[1]
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -655,7 +666,7 @@ func GetCmdCourseChangeSignerRemove(cdc *codec.Codec) *cobra.Command {
 
 			proposalName := args[1]
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -687,7 +698,7 @@ func GetCmdVpnCurrentSignerAdd(cdc *codec.Codec) *cobra.Command {
 
 			proposalName := args[1]
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -719,7 +730,7 @@ func GetCmdVpnCurrentSignerRemove(cdc *codec.Codec) *cobra.Command {
 
 			proposalName := args[1]
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -948,6 +959,297 @@ func getCmdGeneralAmnesty(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func getCmdReserveNickname(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "reserve-nickname <nickname> <proposal name>",
+		Aliases: []string{"reserve_nickname"},
+		Short:   "Propose to make a nickname unavailable for users (it's taken away from its holder, if any)",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgCreateProposal(
+				cliCtx.GetFromAddress(),
+				args[1],
+				types.ProposalTypeNicknameReserve,
+				types.NicknameProposalParams{Nickname: args[0]},
+			)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdReleaseNickname(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "release-nickname <nickname> <proposal name>",
+		Aliases: []string{"release_nickname"},
+		Short:   "Propose to make a reserved nickname available for users again",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgCreateProposal(
+				cliCtx.GetFromAddress(),
+				args[1],
+				types.ProposalTypeNicknameRelease,
+				types.NicknameProposalParams{Nickname: args[0]},
+			)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdSetTxFee(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-tx-fee <rate> <min> <max> <proposal name>",
+		Example: `artrcli tx voting set-tx-fee 3/1000 0 10000000 "0.3%, 10 ARTR max" --override send=1/1000,0,0 --from ivan`,
+		Aliases: []string{"set_tx_fee", "stf"},
+		Short:   "Propose to change the transaction fee schedule (min and max are in uARTR, 0 means no limit)",
+		Long: "Propose to change the transaction fee schedule (min and max are in uARTR, 0 means no limit).\n" +
+			"Per-message-type rules can be set with the --override flag in the form <msg type>=<rate>,<min>,<max>.",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			defaultRule, err := parseFeeRule(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			overrideArgs, err := cmd.Flags().GetStringArray(FlagOverride)
+			if err != nil {
+				return err
+			}
+			overrides := make([]util.FeeOverride, len(overrideArgs))
+			for i, arg := range overrideArgs {
+				eq := strings.Index(arg, "=")
+				if eq < 0 {
+					return fmt.Errorf("invalid override %s: <msg type>=<rate>,<min>,<max> expected", arg)
+				}
+				parts := strings.Split(arg[eq+1:], ",")
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid override %s: <msg type>=<rate>,<min>,<max> expected", arg)
+				}
+				rule, err := parseFeeRule(parts[0], parts[1], parts[2])
+				if err != nil {
+					return err
+				}
+				overrides[i] = util.FeeOverride{MsgType: arg[:eq], Rule: rule}
+			}
+
+			schedule := util.NewFeeSchedule(defaultRule, overrides)
+			if err := schedule.Validate(); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateProposal(
+				cliCtx.GetFromAddress(),
+				args[3],
+				types.ProposalTypeTxFee,
+				types.TxFeeProposalParams{Schedule: schedule},
+			)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringArray(FlagOverride, nil, "a fee rule for a message type: <msg type>=<rate>,<min>,<max> (can be repeated)")
+	return cmd
+}
+
+func getCmdAddFeeExemption(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "add-fee-exemption <sender> <recipient> <proposal name>",
+		Aliases: []string{"add_fee_exemption", "afe"},
+		Short:   "Propose to make transfers from the sender to the recipient free of charge",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return proposeFeeExemption(cdc, cmd, args, types.ProposalTypeFeeExemptionAdd)
+		},
+	}
+}
+
+func getCmdRemoveFeeExemption(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove-fee-exemption <sender> <recipient> <proposal name>",
+		Aliases: []string{"remove_fee_exemption", "rfe"},
+		Short:   "Propose to charge the usual fee for transfers from the sender to the recipient again",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return proposeFeeExemption(cdc, cmd, args, types.ProposalTypeFeeExemptionRemove)
+		},
+	}
+}
+
+func proposeFeeExemption(cdc *codec.Codec, cmd *cobra.Command, args []string, typeCode uint8) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+	sender, err := resolver.ResolveAddressCLI(cliCtx, args[0])
+	if err != nil {
+		return err
+	}
+	recipient, err := resolver.ResolveAddressCLI(cliCtx, args[1])
+	if err != nil {
+		return err
+	}
+
+	msg := types.NewMsgCreateProposal(
+		cliCtx.GetFromAddress(),
+		args[2],
+		typeCode,
+		types.FeeExemptionProposalParams{Sender: sender, Recipient: recipient},
+	)
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
+
+func getCmdSetMonthDuration(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "set-month-duration <duration> <proposal name>",
+		Example: `artrcli tx voting set-month-duration 720h "30 days" --from ivan`,
+		Aliases: []string{"set_month_duration", "smd"},
+		Short:   "Propose to measure subscription periods in wall-clock time (0 means in blocks)",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return proposeDuration(cdc, cmd, args, types.ProposalTypeMonthDuration)
+		},
+	}
+}
+
+func getCmdSetRevokePeriod(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "set-revoke-period <duration> <proposal name>",
+		Example: `artrcli tx voting set-revoke-period 336h "two weeks" --from ivan`,
+		Aliases: []string{"set_revoke_period", "srp"},
+		Short:   "Propose to measure the revoke period in wall-clock time (0 means in blocks)",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return proposeDuration(cdc, cmd, args, types.ProposalTypeRevokePeriod)
+		},
+	}
+}
+
+func getCmdSetMaxTasksPerBlock(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "set-max-tasks-per-block <count> <proposal name>",
+		Example: `artrcli tx voting set-max-tasks-per-block 500 "smooth blocks" --from ivan`,
+		Aliases: []string{"set_max_tasks_per_block", "smt"},
+		Short:   "Propose to change maximum count of scheduled tasks performed per block",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			proposalName := args[1]
+
+			var count uint16
+			{
+				n, err := strconv.ParseUint(args[0], 0, 16)
+				if err != nil {
+					return err
+				}
+				count = uint16(n)
+			}
+
+			params := types.ShortCountProposalParams{Count: count}
+
+			msg := types.NewMsgCreateProposal(
+				cliCtx.GetFromAddress(),
+				proposalName,
+				types.ProposalTypeMaxTasksPerBlock,
+				params,
+			)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdSetEarningApprovals(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "set-earning-approvals <min approvals> <challenge window> <proposal name>",
+		Example: `artrcli tx voting set-earning-approvals 2 720 "two signers and an hour to object" --from ivan`,
+		Aliases: []string{"set_earning_approvals", "sea"},
+		Short:   "Propose to change how many signers must approve an earning period and how many blocks it waits for a challenge",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			minApprovals, err := strconv.ParseUint(args[0], 0, 32)
+			if err != nil {
+				return err
+			}
+			challengeWindow, err := strconv.ParseInt(args[1], 0, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateProposal(
+				cliCtx.GetFromAddress(),
+				args[2],
+				types.ProposalTypeEarningApprovals,
+				types.EarningApprovalsProposalParams{
+					MinApprovals:    uint32(minApprovals),
+					ChallengeWindow: challengeWindow,
+				},
+			)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func proposeDuration(cdc *codec.Codec, cmd *cobra.Command, args []string, typeCode uint8) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+	duration, err := time.ParseDuration(args[0])
+	if err != nil {
+		return err
+	}
+	if duration < 0 {
+		return fmt.Errorf("duration is negative: %s", duration)
+	}
+
+	msg := types.NewMsgCreateProposal(
+		cliCtx.GetFromAddress(),
+		args[1],
+		typeCode,
+		types.DurationProposalParams{Duration: duration},
+	)
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
+
+func parseFeeRule(rate, min, max string) (util.FeeRule, error) {
+	r, err := util.ParseFraction(rate)
+	if err != nil {
+		return util.FeeRule{}, err
+	}
+	mn, err := strconv.ParseInt(min, 0, 64)
+	if err != nil {
+		return util.FeeRule{}, err
+	}
+	mx, err := strconv.ParseInt(max, 0, 64)
+	if err != nil {
+		return util.FeeRule{}, err
+	}
+	return util.NewFeeRule(r, mn, mx), nil
+}
+
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "vote [agree/disagree]",
@@ -1,21 +1,83 @@
 package rest
 
-// The packages below are commented out at first to prevent an error if this file isn't initially saved.
 import (
-	// "bytes"
-	// "net/http"
+	"fmt"
+	"net/http"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
-	// sdk "github.com/cosmos/cosmos-sdk/types"
-	// "github.com/cosmos/cosmos-sdk/types/rest"
-	// "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
-	// "github.com/arterynetwork/artr/x/voting/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
+	"github.com/arterynetwork/artr/x/voting/types"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	// r.HandleFunc(
-	// Call the function which should be executed for this route),
-	// ).Methods("POST")
+	r.HandleFunc(
+		"/voting/proposals/address",
+		createAddressProposalHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+// addressProposalTypes are proposal types with a single account as a parameter
+var addressProposalTypes = map[uint8]bool{
+	types.ProposalTypeGovernmentAdd:          true,
+	types.ProposalTypeGovernmentRemove:       true,
+	types.ProposalTypeAddFreeCreator:         true,
+	types.ProposalTypeRemoveFreeCreator:      true,
+	types.ProposalTypeStaffValidatorAdd:      true,
+	types.ProposalTypeStaffValidatorRemove:   true,
+	types.ProposalTypeEarningSignerAdd:       true,
+	types.ProposalTypeEarningSignerRemove:    true,
+	types.ProposalTypeRateChangeSignerAdd:    true,
+	types.ProposalTypeRateChangeSignerRemove: true,
+	types.ProposalTypeVpnCurrentSignerAdd:    true,
+	types.ProposalTypeVpnCurrentSignerRemove: true,
+}
+
+// CreateAddressProposalReq defines the properties of a request's body to propose adding an account to (or removing
+// it from) some list. The account can be referred to by an address, a @nickname or a card number.
+type CreateAddressProposalReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name     string       `json:"name" yaml:"name"`
+	TypeCode uint8        `json:"type" yaml:"type"`
+	Address  string       `json:"address" yaml:"address"`
+}
+
+func createAddressProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateAddressProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		if !addressProposalTypes[req.TypeCode] {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("not an address proposal type: %d", req.TypeCode))
+			return
+		}
+		author, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		addr, ok := resolver.ResolveAddressREST(w, cliCtx, req.Address)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreateProposal(author, req.Name, req.TypeCode, types.AddressProposalParams{Address: addr})
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
 }
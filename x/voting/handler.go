@@ -1,6 +1,7 @@
 package voting
 
 import (
+	"github.com/arterynetwork/artr/x/bank"
 	"github.com/arterynetwork/artr/x/delegating"
 	"github.com/arterynetwork/artr/x/voting/types"
 	"fmt"
@@ -82,6 +83,63 @@ func handleMsgCreateProposal(ctx sdk.Context, k Keeper, msg types.MsgCreatePropo
 		if _, ok := msg.Params.(types.ShortCountProposalParams); !ok {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected parameters type: %T", msg.Params)
 		}
+	case types.ProposalTypeNicknameReserve, types.ProposalTypeNicknameRelease:
+		p, ok := msg.Params.(types.NicknameProposalParams)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected parameters type: %T", msg.Params)
+		}
+		if p.Nickname == "" {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nickname is missing")
+		}
+		if reserved := k.IsNicknameReserved(ctx, p.Nickname); reserved == (msg.TypeCode == types.ProposalTypeNicknameReserve) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "nickname %s reserved: %t", p.Nickname, reserved)
+		}
+	case types.ProposalTypeTxFee:
+		p, ok := msg.Params.(types.TxFeeProposalParams)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected parameters type: %T", msg.Params)
+		}
+		if err := p.Schedule.Validate(); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	case types.ProposalTypeFeeExemptionAdd, types.ProposalTypeFeeExemptionRemove:
+		p, ok := msg.Params.(types.FeeExemptionProposalParams)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected parameters type: %T", msg.Params)
+		}
+		if err := bank.NewFeeExemption(p.Sender, p.Recipient).Validate(); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if exempt := k.IsFeeExempt(ctx, p.Sender, p.Recipient); exempt == (msg.TypeCode == types.ProposalTypeFeeExemptionAdd) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s -> %s fee exempt: %t", p.Sender, p.Recipient, exempt)
+		}
+	case types.ProposalTypeMaxTasksPerBlock:
+		p, ok := msg.Params.(types.ShortCountProposalParams)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected parameters type: %T", msg.Params)
+		}
+		if p.Count == 0 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "task count must be positive")
+		}
+	case types.ProposalTypeEarningApprovals:
+		p, ok := msg.Params.(types.EarningApprovalsProposalParams)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected parameters type: %T", msg.Params)
+		}
+		if signers := len(k.GetEarningParams(ctx).Signers); int(p.MinApprovals) > signers {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min approvals (%d) exceeds signer count (%d)", p.MinApprovals, signers)
+		}
+		if p.ChallengeWindow < 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "challenge window is negative: %d", p.ChallengeWindow)
+		}
+	case types.ProposalTypeMonthDuration, types.ProposalTypeRevokePeriod:
+		p, ok := msg.Params.(types.DurationProposalParams)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected parameters type: %T", msg.Params)
+		}
+		if p.Duration < 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duration is negative: %s", p.Duration)
+		}
 	}
 
 	proposal := types.Proposal{
@@ -200,6 +200,166 @@ func (s *HandlerSuite) TestMinDelegate() {
 	)
 }
 
+func (s *HandlerSuite) TestReserveNickname() {
+	pk := s.app.GetProfileKeeper()
+	msg := types.NewMsgCreateProposal(
+		app.DefaultGenesisUsers["user1"],
+		"trademark",
+		types.ProposalTypeNicknameReserve,
+		types.NicknameProposalParams{Nickname: "user2"},
+	)
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	s.True(pk.IsNicknameReserved(s.ctx, "user2"))
+	s.Nil(pk.GetProfileAccountByNickname(s.ctx, "user2"))
+
+	_, err = s.handler(s.ctx, msg)
+	s.Error(err)
+
+	msg.TypeCode = types.ProposalTypeNicknameRelease
+	_, err = s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	s.False(pk.IsNicknameReserved(s.ctx, "user2"))
+}
+
+func (s *HandlerSuite) TestTxFee() {
+	schedule := util.NewFeeSchedule(
+		util.NewFeeRule(util.Permille(5), 1000, 0),
+		[]util.FeeOverride{
+			{MsgType: "pay_subscription", Rule: util.NewFeeRule(util.FractionZero(), 0, 0)},
+		},
+	)
+	msg := types.NewMsgCreateProposal(
+		app.DefaultGenesisUsers["user1"],
+		"fee",
+		types.ProposalTypeTxFee,
+		types.TxFeeProposalParams{Schedule: schedule},
+	)
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	s.Equal(schedule, s.app.GetBankKeeper().GetFeeSchedule(s.ctx))
+}
+
+func (s *HandlerSuite) TestTxFee_Invalid() {
+	msg := types.NewMsgCreateProposal(
+		app.DefaultGenesisUsers["user1"],
+		"fee",
+		types.ProposalTypeTxFee,
+		types.TxFeeProposalParams{Schedule: util.NewFeeSchedule(util.NewFeeRule(util.Percent(1), 2000, 1000), nil)},
+	)
+	_, err := s.handler(s.ctx, msg)
+	s.Error(err)
+}
+
+func (s *HandlerSuite) TestFeeExemption() {
+	bk := s.app.GetBankKeeper()
+	sender := app.DefaultGenesisUsers["user4"]
+	recipient := app.DefaultGenesisUsers["user5"]
+	msg := types.NewMsgCreateProposal(
+		app.DefaultGenesisUsers["user1"],
+		"internal",
+		types.ProposalTypeFeeExemptionAdd,
+		types.FeeExemptionProposalParams{Sender: sender, Recipient: recipient},
+	)
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	s.True(bk.IsFeeExempt(s.ctx, sender, recipient))
+	s.False(bk.IsFeeExempt(s.ctx, recipient, sender))
+
+	_, err = s.handler(s.ctx, msg)
+	s.Error(err)
+
+	msg.TypeCode = types.ProposalTypeFeeExemptionRemove
+	_, err = s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	s.False(bk.IsFeeExempt(s.ctx, sender, recipient))
+}
+
+func (s *HandlerSuite) TestMaxTasksPerBlock() {
+	msg := types.NewMsgCreateProposal(
+		app.DefaultGenesisUsers["user1"],
+		"smooth blocks",
+		types.ProposalTypeMaxTasksPerBlock,
+		types.ShortCountProposalParams{Count: 500},
+	)
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	s.Equal(uint32(500), s.app.GetScheduleKeeper().GetParams(s.ctx).MaxTasksPerBlock)
+
+	msg.Params = types.ShortCountProposalParams{Count: 0}
+	_, err = s.handler(s.ctx, msg)
+	s.Error(err)
+}
+
+func (s *HandlerSuite) TestEarningApprovals() {
+	ek := s.app.GetEarningKeeper()
+	ek.AddSigner(s.ctx, app.DefaultGenesisUsers["user2"])
+	signers := len(ek.GetParams(s.ctx).Signers)
+
+	msg := types.NewMsgCreateProposal(
+		app.DefaultGenesisUsers["user1"],
+		"M-of-N",
+		types.ProposalTypeEarningApprovals,
+		types.EarningApprovalsProposalParams{MinApprovals: uint32(signers + 1), ChallengeWindow: 10},
+	)
+	_, err := s.handler(s.ctx, msg)
+	s.Error(err)
+
+	msg.Params = types.EarningApprovalsProposalParams{MinApprovals: uint32(signers), ChallengeWindow: 10}
+	_, err = s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	params := ek.GetParams(s.ctx)
+	s.Equal(uint32(signers), params.MinApprovals)
+	s.Equal(int64(10), params.ChallengeWindow)
+	s.True(params.RequiresAttestation())
+}
+
+func (s *HandlerSuite) TestMonthDuration() {
+	msg := types.NewMsgCreateProposal(
+		app.DefaultGenesisUsers["user1"],
+		"30 days",
+		types.ProposalTypeMonthDuration,
+		types.DurationProposalParams{Duration: 720 * time.Hour},
+	)
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	s.Equal(720*time.Hour, s.app.GetSubscriptionKeeper().GetParams(s.ctx).MonthDuration)
+}
+
+func (s *HandlerSuite) TestRevokePeriod() {
+	msg := types.NewMsgCreateProposal(
+		app.DefaultGenesisUsers["user1"],
+		"two weeks",
+		types.ProposalTypeRevokePeriod,
+		types.DurationProposalParams{Duration: 336 * time.Hour},
+	)
+	_, err := s.handler(s.ctx, msg)
+	s.NoError(err)
+	s.voteFor()
+
+	s.Equal(336*time.Hour, s.app.GetDelegatingKeeper().GetParams(s.ctx).RevokePeriod)
+
+	msg.Params = types.DurationProposalParams{Duration: -time.Hour}
+	_, err = s.handler(s.ctx, msg)
+	s.Error(err)
+}
+
 func (s *HandlerSuite) voteFor() {
 	msg := types.NewMsgProposalVote(
 		app.DefaultGenesisUsers["user2"],
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"github.com/arterynetwork/artr/x/bank"
 	"github.com/arterynetwork/artr/x/delegating"
+	"github.com/arterynetwork/artr/x/earning"
 	"encoding/binary"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
@@ -291,6 +293,36 @@ func (k Keeper) EndProposal(ctx sdk.Context, proposal types.Proposal, agreed boo
 			p := k.nodingKeeper.GetParams(ctx)
 			p.LotteryValidators = proposal.Params.(types.ShortCountProposalParams).Count
 			k.nodingKeeper.SetParams(ctx, p)
+		case types.ProposalTypeNicknameReserve:
+			k.profileKeeper.ReserveNickname(ctx, proposal.Params.(types.NicknameProposalParams).Nickname)
+		case types.ProposalTypeNicknameRelease:
+			k.profileKeeper.ReleaseNickname(ctx, proposal.Params.(types.NicknameProposalParams).Nickname)
+		case types.ProposalTypeTxFee:
+			k.bankKeeper.SetFeeSchedule(ctx, proposal.Params.(types.TxFeeProposalParams).Schedule)
+		case types.ProposalTypeFeeExemptionAdd:
+			p := proposal.Params.(types.FeeExemptionProposalParams)
+			err = k.bankKeeper.AddFeeExemption(ctx, bank.NewFeeExemption(p.Sender, p.Recipient))
+		case types.ProposalTypeFeeExemptionRemove:
+			p := proposal.Params.(types.FeeExemptionProposalParams)
+			err = k.bankKeeper.RemoveFeeExemption(ctx, bank.NewFeeExemption(p.Sender, p.Recipient))
+		case types.ProposalTypeMaxTasksPerBlock:
+			p := k.scheduleKeeper.GetParams(ctx)
+			p.MaxTasksPerBlock = uint32(proposal.Params.(types.ShortCountProposalParams).Count)
+			k.scheduleKeeper.SetParams(ctx, p)
+		case types.ProposalTypeEarningApprovals:
+			pp := proposal.Params.(types.EarningApprovalsProposalParams)
+			p := k.earningKeeper.GetParams(ctx)
+			p.MinApprovals = pp.MinApprovals
+			p.ChallengeWindow = pp.ChallengeWindow
+			k.earningKeeper.SetParams(ctx, p)
+		case types.ProposalTypeMonthDuration:
+			p := k.subscriptionKeeper.GetParams(ctx)
+			p.MonthDuration = proposal.Params.(types.DurationProposalParams).Duration
+			k.subscriptionKeeper.SetParams(ctx, p)
+		case types.ProposalTypeRevokePeriod:
+			p := k.delegatingKeeper.GetParams(ctx)
+			p.RevokePeriod = proposal.Params.(types.DurationProposalParams).Duration
+			k.delegatingKeeper.SetParams(ctx, p)
 		}
 		if err != nil {
 			k.Logger(ctx).Error("could not apply voting result due to error",
@@ -342,3 +374,18 @@ func (k Keeper) GetHistory(ctx sdk.Context, limit int32, page int32) []types.Pro
 
 	return records
 }
+
+// IsNicknameReserved checks if the nickname is reserved (i.e. unavailable for users)
+func (k Keeper) IsNicknameReserved(ctx sdk.Context, nickname string) bool {
+	return k.profileKeeper.IsNicknameReserved(ctx, nickname)
+}
+
+// IsFeeExempt checks if transfers from the sender to the recipient are free of charge
+func (k Keeper) IsFeeExempt(ctx sdk.Context, sender, recipient sdk.AccAddress) bool {
+	return k.bankKeeper.IsFeeExempt(ctx, sender, recipient)
+}
+
+// GetEarningParams returns the earning module params (to validate proposals changing them)
+func (k Keeper) GetEarningParams(ctx sdk.Context) earning.Params {
+	return k.earningKeeper.GetParams(ctx)
+}
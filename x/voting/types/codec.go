@@ -20,6 +20,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(SoftwareUpgradeProposalParams{}, ModuleName+"/SoftwareUpgradeProposalParams", nil)
 	cdc.RegisterConcrete(MinAmountProposalParams{}, ModuleName+"/MinAmountProposalParams", nil)
 	cdc.RegisterConcrete(ShortCountProposalParams{}, ModuleName+"/ShortCountProposalParams", nil)
+	cdc.RegisterConcrete(NicknameProposalParams{}, ModuleName+"/NicknameProposalParams", nil)
+	cdc.RegisterConcrete(TxFeeProposalParams{}, ModuleName+"/TxFeeProposalParams", nil)
+	cdc.RegisterConcrete(FeeExemptionProposalParams{}, ModuleName+"/FeeExemptionProposalParams", nil)
+	cdc.RegisterConcrete(DurationProposalParams{}, ModuleName+"/DurationProposalParams", nil)
+	cdc.RegisterConcrete(EarningApprovalsProposalParams{}, ModuleName+"/EarningApprovalsProposalParams", nil)
 }
 
 // ModuleCdc defines the module codec
@@ -1,9 +1,13 @@
 package types
 
 import (
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/bank"
 	"github.com/arterynetwork/artr/x/delegating"
+	"github.com/arterynetwork/artr/x/earning"
 	"github.com/arterynetwork/artr/x/noding"
 	"github.com/arterynetwork/artr/x/referral"
+	"github.com/arterynetwork/artr/x/schedule"
 	"github.com/arterynetwork/artr/x/subscription"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -19,8 +23,10 @@ type ParamSubspace interface {
 }
 
 type ScheduleKeeper interface {
-	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) error
+	ScheduleTask(ctx sdk.Context, block uint64, event string, data *[]byte) (uint64, error)
 	DeleteAllTasksOnBlock(ctx sdk.Context, block uint64, event string)
+	GetParams(ctx sdk.Context) (params schedule.Params)
+	SetParams(ctx sdk.Context, params schedule.Params)
 }
 
 type UprgadeKeeper interface {
@@ -58,16 +64,28 @@ type SubscriptionKeeper interface {
 type ProfileKeeper interface {
 	AddFreeCreator(ctx sdk.Context, creator sdk.AccAddress)
 	RemoveFreeCreator(ctx sdk.Context, creator sdk.AccAddress)
+	IsNicknameReserved(ctx sdk.Context, nickname string) bool
+	ReserveNickname(ctx sdk.Context, nickname string)
+	ReleaseNickname(ctx sdk.Context, nickname string)
 }
 
 type signersKeeper interface {
 	AddSigner(ctx sdk.Context, address sdk.AccAddress)
 	RemoveSigner(ctx sdk.Context, address sdk.AccAddress)
 }
-type EarningKeeper signersKeeper
+type EarningKeeper interface {
+	signersKeeper
+	GetParams(ctx sdk.Context) (params earning.Params)
+	SetParams(ctx sdk.Context, params earning.Params)
+}
 type VpnKeeper signersKeeper
 
 type BankKeeper interface {
 	GetMinSend(ctx sdk.Context) int64
 	SetMinSend(ctx sdk.Context, minSend int64)
+	GetFeeSchedule(ctx sdk.Context) util.FeeSchedule
+	SetFeeSchedule(ctx sdk.Context, schedule util.FeeSchedule)
+	IsFeeExempt(ctx sdk.Context, sender, recipient sdk.AccAddress) bool
+	AddFeeExemption(ctx sdk.Context, exemption bank.FeeExemption) error
+	RemoveFeeExemption(ctx sdk.Context, exemption bank.FeeExemption) error
 }
@@ -1,10 +1,12 @@
 package types
 
 import (
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/referral"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"strings"
+	"time"
 )
 
 const (
@@ -54,6 +56,22 @@ const (
 	ProposalTypeGeneralAmnesty = 26
 	// "Счастливые" валидаторы
 	ProposalTypeLotteryValidators = 27
+	// Зарезервированные (недоступные пользователям) никнеймы
+	ProposalTypeNicknameReserve = 28
+	ProposalTypeNicknameRelease = 29
+	// Комиссия за переводы
+	ProposalTypeTxFee = 30
+	// Переводы без комиссии
+	ProposalTypeFeeExemptionAdd    = 31
+	ProposalTypeFeeExemptionRemove = 32
+	// Продолжительность периода подписки по часам (0 - в блоках)
+	ProposalTypeMonthDuration = 33
+	// Продолжительность отзыва делегирования по часам (0 - в блоках)
+	ProposalTypeRevokePeriod = 34
+	// Максимальное количество запланированных задач, выполняемых за блок
+	ProposalTypeMaxTasksPerBlock = 35
+	// Сколько подписантов должны подтвердить выплату вознаграждений и сколько блоков она ждёт возражений
+	ProposalTypeEarningApprovals = 36
 )
 
 // EmptyProposalParams
@@ -164,3 +182,65 @@ type ShortCountProposalParams struct {
 func (params ShortCountProposalParams) String() string {
 	return fmt.Sprintf("Count: %d", params.Count)
 }
+
+// NicknameProposalParams
+
+var _ ProposalParams = &NicknameProposalParams{}
+
+type NicknameProposalParams struct {
+	Nickname string `json:"nickname" yaml:"nickname"`
+}
+
+func (params NicknameProposalParams) String() string {
+	return "Nickname: " + params.Nickname
+}
+
+// TxFeeProposalParams
+
+var _ ProposalParams = &TxFeeProposalParams{}
+
+type TxFeeProposalParams struct {
+	Schedule util.FeeSchedule `json:"schedule" yaml:"schedule"`
+}
+
+func (params TxFeeProposalParams) String() string {
+	return params.Schedule.String()
+}
+
+// FeeExemptionProposalParams
+
+var _ ProposalParams = &FeeExemptionProposalParams{}
+
+type FeeExemptionProposalParams struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+func (params FeeExemptionProposalParams) String() string {
+	return fmt.Sprintf("Sender: %s; Recipient: %s", params.Sender, params.Recipient)
+}
+
+// DurationProposalParams
+
+var _ ProposalParams = &DurationProposalParams{}
+
+type DurationProposalParams struct {
+	Duration time.Duration `json:"duration" yaml:"duration"`
+}
+
+func (params DurationProposalParams) String() string {
+	return "Duration: " + params.Duration.String()
+}
+
+// EarningApprovalsProposalParams
+
+var _ ProposalParams = &EarningApprovalsProposalParams{}
+
+type EarningApprovalsProposalParams struct {
+	MinApprovals    uint32 `json:"min_approvals" yaml:"min_approvals"`
+	ChallengeWindow int64  `json:"challenge_window" yaml:"challenge_window"`
+}
+
+func (params EarningApprovalsProposalParams) String() string {
+	return fmt.Sprintf("MinApprovals: %d; ChallengeWindow: %d", params.MinApprovals, params.ChallengeWindow)
+}
@@ -22,6 +22,9 @@ var (
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
+	NewMsgSetCurrent    = types.NewMsgSetCurrent
+	NewMsgAddUsage      = types.NewMsgAddUsage
+	ErrNegativeUsage    = types.ErrNegativeUsage
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
@@ -31,4 +34,6 @@ type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
 	Params       = types.Params
+	VpnInfo      = types.VpnInfo
+	UsagePeriod  = types.UsagePeriod
 )
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -32,6 +33,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetVPNStatusCmd(queryRoute, cdc),
 			GetVPNLimitCmd(queryRoute, cdc),
 			GetVPNCurrentCmd(queryRoute, cdc),
+			GetVPNUsageCmd(queryRoute, cdc),
+			GetVPNOveruseCmd(queryRoute, cdc),
 			util.LineBreak(),
 			getCmdParams(queryRoute, cdc),
 		)...,
@@ -135,6 +138,64 @@ func GetVPNCurrentCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetVPNUsageCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage <address>",
+		Short: "Query vpn traffic usage history for address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryUsageParams(
+				addr,
+				int32(viper.GetInt(FlagLimit)),
+				int32(viper.GetInt(FlagPage)),
+			))
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryUsage), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResUsage
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of history records per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
+
+func GetVPNOveruseCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "overuse",
+		Short: "Query accounts that have exceeded their vpn traffic limit",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOveruse))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResOveruse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
+
 func getCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:     "params",
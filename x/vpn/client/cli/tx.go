@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/arterynetwork/artr/x/profile/client/resolver"
 	"github.com/arterynetwork/artr/x/vpn/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -29,6 +30,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	vpnTxCmd.AddCommand(flags.PostCommands(
 		GetSetCurrentCmd(cdc),
+		GetAddUsageCmd(cdc),
 	)...)
 
 	return vpnTxCmd
@@ -64,3 +66,31 @@ func GetSetCurrentCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetAddUsageCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-usage [address] [delta]",
+		Short: "Create and sign a report of vpn traffic used since the previous one",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := resolver.ResolveAddressCLI(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			delta, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddUsage(cliCtx.FromAddress, addr, delta)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -14,6 +14,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	for _, vpnStatus := range data.VpnStatus {
 		k.SetInfo(ctx, vpnStatus.Address, vpnStatus.VpnInfo)
 	}
+	for _, record := range data.UsageHistory {
+		k.SetUsagePeriod(ctx, record.Address, record.Seq, record.UsagePeriod)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -31,5 +34,17 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
 		return false
 	})
 
-	return NewGenesisState(k.GetParams(ctx), vpnStatus)
+	var usageHistory []types.GenesisUsagePeriod
+
+	k.IterateUsageHistory(ctx, func(addr sdk.AccAddress, seq uint64, period types.UsagePeriod) (stop bool) {
+		usageHistory = append(usageHistory, types.GenesisUsagePeriod{
+			UsagePeriod: period,
+			Address:     addr,
+			Seq:         seq,
+		})
+
+		return false
+	})
+
+	return NewGenesisState(k.GetParams(ctx), vpnStatus, usageHistory)
 }
@@ -56,6 +56,23 @@ func (s Suite) TestFullData() {
 	s.checkExportImport()
 }
 
+func (s Suite) TestUsageHistory() {
+	s.k.StartPeriod(s.ctx, app.DefaultGenesisUsers["user1"], 100500)
+	_, err := s.k.AddUsage(s.ctx, app.DefaultGenesisUsers["user1"], 9000)
+	s.NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(100)
+	s.k.StartPeriod(s.ctx, app.DefaultGenesisUsers["user1"], 100500)
+	s.k.StartPeriod(s.ctx, app.DefaultGenesisUsers["user2"], 40)
+	s.checkExportImport()
+}
+
+func (s Suite) TestUsageHistory_SameBlock() {
+	s.k.StartPeriod(s.ctx, app.DefaultGenesisUsers["user1"], 100500)
+	s.k.StartPeriod(s.ctx, app.DefaultGenesisUsers["user1"], 40)
+	s.k.StartPeriod(s.ctx, app.DefaultGenesisUsers["user1"], 100500)
+	s.checkExportImport()
+}
+
 func (s *Suite) TestParams() {
 	s.k.SetParams(s.ctx, vpn.Params{Signers: []sdk.AccAddress{app.DefaultGenesisUsers["user7"]}})
 	s.checkExportImport()
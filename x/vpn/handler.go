@@ -19,6 +19,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSetLimit(ctx, k, msg)
 		case types.MsgSetCurrent:
 			return handleMsgSetCurrent(ctx, k, msg)
+		case types.MsgAddUsage:
+			return handleMsgAddUsage(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -35,8 +37,24 @@ func handleMsgSetCurrent(ctx sdk.Context, k Keeper, msg types.MsgSetCurrent) (*s
 	if !checkSender(ctx, k, msg.Sender) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s is not in allowed sender list", msg.Sender.String())
 	}
-	k.SetCurrent(ctx, msg.Address, msg.Current)
-	return &sdk.Result{}, nil
+	current, err := k.GetCurrent(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.AddUsage(ctx, msg.Address, msg.Current-current); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAddUsage(ctx sdk.Context, k Keeper, msg types.MsgAddUsage) (*sdk.Result, error) {
+	if !checkSender(ctx, k, msg.Sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s is not in allowed sender list", msg.Sender.String())
+	}
+	if _, err := k.AddUsage(ctx, msg.Address, msg.Delta); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 func checkSender(ctx sdk.Context, k Keeper, sender sdk.AccAddress) (allowed bool) {
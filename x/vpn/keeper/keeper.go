@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/vpn/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -114,7 +115,7 @@ func (k Keeper) AddLimit(ctx sdk.Context, addr sdk.AccAddress, value int64) (int
 
 func (k Keeper) IterateInfo(ctx sdk.Context, cb func(info types.VpnInfo, addr sdk.AccAddress) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(nil, nil)
+	iterator := sdk.KVStorePrefixIterator(store, auth.AddressStoreKeyPrefix)
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
@@ -127,6 +128,14 @@ func (k Keeper) IterateInfo(ctx sdk.Context, cb func(info types.VpnInfo, addr sd
 	}
 }
 
+// MigrateAccount moves the account's VPN info and usage history to a new address
+func (k Keeper) MigrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	util.MoveKeys(store, auth.AddressStoreKey(from), auth.AddressStoreKey(to))
+	util.MoveKeys(store, usageHistoryKey(from), usageHistoryKey(to))
+	return nil
+}
+
 func (k Keeper) decodeVpnInfo(bz []byte) (info types.VpnInfo) {
 	err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &info)
 	if err != nil {
@@ -0,0 +1,140 @@
+// +build testing
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/app"
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/vpn"
+)
+
+func TestVpnKeeper(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+type Suite struct {
+	suite.Suite
+
+	app     *app.ArteryApp
+	cleanup func()
+	ctx     sdk.Context
+	k       vpn.Keeper
+	handler sdk.Handler
+}
+
+func (s *Suite) SetupTest() {
+	s.app, s.cleanup = app.NewAppFromGenesis(nil)
+	s.ctx = s.app.NewContext(true, abci.Header{Height: 1})
+	s.k = s.app.GetVpnKeeper()
+	s.handler = vpn.NewHandler(s.k)
+}
+
+func (s *Suite) TearDownTest() {
+	s.cleanup()
+}
+
+func (s Suite) TestAddUsage() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.StartPeriod(s.ctx, user, 7*util.GBSize)
+
+	_, err := s.handler(s.ctx, vpn.NewMsgAddUsage(app.DefaultGenesisUsers["user1"], user, 5*util.GBSize))
+	s.NoError(err)
+	res, err := s.handler(s.ctx, vpn.NewMsgAddUsage(app.DefaultGenesisUsers["user2"], user, 3*util.GBSize))
+	s.NoError(err)
+	s.True(hasEvent(res.Events, "vpn_overuse"))
+
+	info, err := s.k.GetInfo(s.ctx, user)
+	s.NoError(err)
+	s.Equal(vpn.VpnInfo{Current: 8 * util.GBSize, Limit: 7 * util.GBSize, PeriodStart: 1}, info)
+	s.Equal(int64(util.GBSize), info.Overuse())
+
+	res, err = s.handler(s.ctx, vpn.NewMsgAddUsage(app.DefaultGenesisUsers["user1"], user, 1))
+	s.NoError(err)
+	s.False(hasEvent(res.Events, "vpn_overuse"))
+}
+
+func (s Suite) TestAddUsage_Unauthorized() {
+	user := app.DefaultGenesisUsers["user4"]
+	_, err := s.handler(s.ctx, vpn.NewMsgAddUsage(app.DefaultGenesisUsers["user3"], user, util.GBSize))
+	s.Error(err)
+
+	current, err := s.k.GetCurrent(s.ctx, user)
+	s.NoError(err)
+	s.Zero(current)
+}
+
+func (s Suite) TestSetCurrent_CannotDecrease() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.StartPeriod(s.ctx, user, 7*util.GBSize)
+	_, err := s.k.AddUsage(s.ctx, user, 100)
+	s.NoError(err)
+
+	_, err = s.handler(s.ctx, vpn.NewMsgSetCurrent(app.DefaultGenesisUsers["user1"], user, 50))
+	s.Error(err)
+	_, err = s.handler(s.ctx, vpn.NewMsgSetCurrent(app.DefaultGenesisUsers["user1"], user, 150))
+	s.NoError(err)
+
+	current, err := s.k.GetCurrent(s.ctx, user)
+	s.NoError(err)
+	s.Equal(int64(150), current)
+}
+
+func (s Suite) TestUsageHistory() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.StartPeriod(s.ctx, user, 7*util.GBSize)
+	_, err := s.k.AddUsage(s.ctx, user, 3*util.GBSize)
+	s.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(1 + util.BlocksOneMonth)
+	s.k.StartPeriod(s.ctx, user, 7*util.GBSize)
+	_, err = s.k.AddUsage(s.ctx, user, 9*util.GBSize)
+	s.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(1 + 2*util.BlocksOneMonth)
+	s.k.StartPeriod(s.ctx, user, 10*util.GBSize)
+
+	s.Equal(
+		[]vpn.UsagePeriod{
+			{Start: 1 + util.BlocksOneMonth, End: 1 + 2*util.BlocksOneMonth, Limit: 7 * util.GBSize, Used: 9 * util.GBSize},
+			{Start: 1, End: 1 + util.BlocksOneMonth, Limit: 7 * util.GBSize, Used: 3 * util.GBSize},
+		},
+		s.k.GetUsageHistory(s.ctx, user, 10, 1),
+	)
+	s.Equal(int64(2*util.GBSize), s.k.GetUsageHistory(s.ctx, user, 1, 1)[0].Overuse())
+
+	info, err := s.k.GetInfo(s.ctx, user)
+	s.NoError(err)
+	s.Equal(vpn.VpnInfo{Current: 0, Limit: 10 * util.GBSize, PeriodStart: 1 + 2*util.BlocksOneMonth}, info)
+}
+
+func (s Suite) TestUsageHistory_SameBlock() {
+	user := app.DefaultGenesisUsers["user4"]
+	s.k.StartPeriod(s.ctx, user, 7*util.GBSize)
+	s.k.StartPeriod(s.ctx, user, 10*util.GBSize)
+	s.k.StartPeriod(s.ctx, user, 5*util.GBSize)
+
+	s.Equal(
+		[]vpn.UsagePeriod{
+			{Start: 1, End: 1, Limit: 10 * util.GBSize},
+			{Start: 1, End: 1, Limit: 7 * util.GBSize},
+		},
+		s.k.GetUsageHistory(s.ctx, user, 10, 1),
+	)
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -21,6 +21,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryVpnCurrent(ctx, req, k)
 		case types.QueryParams:
 			return queryParams(ctx, k)
+		case types.QueryUsage:
+			return queryUsage(ctx, req, k)
+		case types.QueryOveruse:
+			return queryOveruse(ctx, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown vpn query endpoint")
 		}
@@ -97,3 +101,47 @@ func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+func queryUsage(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryUsageParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	info, err := k.GetInfo(ctx, params.Address)
+	if err != nil {
+		info = types.VpnInfo{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResUsage{
+		Current: info,
+		History: k.GetUsageHistory(ctx, params.Address, params.Limit, params.Page),
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryOveruse(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res := make(types.QueryResOveruse, 0)
+	k.IterateInfo(ctx, func(info types.VpnInfo, addr sdk.AccAddress) (stop bool) {
+		if info.Overuse() > 0 {
+			res = append(res, types.OveruseRecord{
+				Address: addr,
+				Current: info.Current,
+				Limit:   info.Limit,
+			})
+		}
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
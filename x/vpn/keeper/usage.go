@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/arterynetwork/artr/x/vpn/types"
+)
+
+// AddUsage adds traffic used by an account to its current period. An overuse event is emitted when the limit gets
+// exceeded.
+func (k Keeper) AddUsage(ctx sdk.Context, addr sdk.AccAddress, delta int64) (int64, error) {
+	if delta < 0 {
+		return 0, types.ErrNegativeUsage
+	}
+
+	info, err := k.GetInfo(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+
+	wasOverused := info.Current > info.Limit
+	info.Current += delta
+	k.SetInfo(ctx, addr, info)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUsage,
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyDelta, fmt.Sprintf("%d", delta)),
+		sdk.NewAttribute(types.AttributeKeyCurrent, fmt.Sprintf("%d", info.Current)),
+	))
+	if !wasOverused && info.Current > info.Limit {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeOveruse,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyCurrent, fmt.Sprintf("%d", info.Current)),
+			sdk.NewAttribute(types.AttributeKeyLimit, fmt.Sprintf("%d", info.Limit)),
+		))
+	}
+
+	return info.Current, nil
+}
+
+// StartPeriod closes the account's current billing period (saving it to the usage history) and starts a new one
+// with the given limit.
+func (k Keeper) StartPeriod(ctx sdk.Context, addr sdk.AccAddress, limit int64) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(auth.AddressStoreKey(addr)) {
+		info, err := k.GetInfo(ctx, addr)
+		if err != nil {
+			panic(err)
+		}
+
+		period := types.UsagePeriod{
+			Start: info.PeriodStart,
+			End:   ctx.BlockHeight(),
+			Limit: info.Limit,
+			Used:  info.Current,
+		}
+		k.SetUsagePeriod(ctx, addr, k.nextUsagePeriodSeq(ctx, addr), period)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePeriodClose,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyCurrent, fmt.Sprintf("%d", period.Used)),
+			sdk.NewAttribute(types.AttributeKeyLimit, fmt.Sprintf("%d", period.Limit)),
+			sdk.NewAttribute(types.AttributeKeyOveruse, fmt.Sprintf("%d", period.Overuse())),
+		))
+	}
+
+	k.SetInfo(ctx, addr, types.VpnInfo{
+		Current:     0,
+		Limit:       limit,
+		PeriodStart: ctx.BlockHeight(),
+	})
+}
+
+// SetUsagePeriod stores a finished billing period of the account. Records are ordered by seq, not by height, because
+// several periods can be closed in the same block.
+func (k Keeper) SetUsagePeriod(ctx sdk.Context, addr sdk.AccAddress, seq uint64, period types.UsagePeriod) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(usagePeriodKey(addr, seq), k.cdc.MustMarshalBinaryLengthPrefixed(period))
+}
+
+// nextUsagePeriodSeq returns a sequence number for the next usage history record of the account
+func (k Keeper) nextUsagePeriodSeq(ctx sdk.Context, addr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStoreReversePrefixIterator(store, usageHistoryKey(addr))
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	key := it.Key()
+	return binary.BigEndian.Uint64(key[len(key)-8:]) + 1
+}
+
+// GetUsageHistory returns finished billing periods of the account, the most recent first
+func (k Keeper) GetUsageHistory(ctx sdk.Context, addr sdk.AccAddress, limit int32, page int32) []types.UsagePeriod {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, usageHistoryKey(addr))
+	defer iterator.Close()
+
+	records := make([]types.UsagePeriod, 0)
+	start := limit * (page - 1)
+	end := limit * page
+
+	for current := int32(0); iterator.Valid() && (current < end); iterator.Next() {
+		if current < start {
+			current++
+			continue
+		}
+		current++
+		var record types.UsagePeriod
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func (k Keeper) IterateUsageHistory(ctx sdk.Context, cb func(addr sdk.AccAddress, seq uint64, period types.UsagePeriod) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UsageHistoryPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var period types.UsagePeriod
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &period)
+		key := iterator.Key()
+		addr := sdk.AccAddress(key[len(types.UsageHistoryPrefix)+len(auth.AddressStoreKeyPrefix) : len(key)-8])
+		seq := binary.BigEndian.Uint64(key[len(key)-8:])
+
+		if cb(addr, seq, period) {
+			break
+		}
+	}
+}
+
+func usageHistoryKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte(nil), types.UsageHistoryPrefix...), auth.AddressStoreKey(addr)...)
+}
+
+func usagePeriodKey(addr sdk.AccAddress, seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(usageHistoryKey(addr), bz...)
+}
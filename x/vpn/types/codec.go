@@ -8,6 +8,7 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetLimit{}, "artr/SetLimit", nil)
 	cdc.RegisterConcrete(MsgSetCurrent{}, "artr/SetCurrent", nil)
+	cdc.RegisterConcrete(MsgAddUsage{}, "artr/AddVpnUsage", nil)
 }
 
 // ModuleCdc defines the module codec
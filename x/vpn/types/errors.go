@@ -1,6 +1,7 @@
 package types
 
-// You can see how they are constructed below:
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 var (
-//ErrInvalid = sdkerrors.Register(ModuleName, 1, "custom error message")
+	ErrNegativeUsage = sdkerrors.Register(ModuleName, 1, "traffic usage cannot decrease")
 )
@@ -2,5 +2,15 @@ package types
 
 // vpn module event types
 const (
+	EventTypeUsage       = "vpn_usage"
+	EventTypeOveruse     = "vpn_overuse"
+	EventTypePeriodClose = "vpn_period_close"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyCurrent = "current"
+	AttributeKeyLimit   = "limit"
+	AttributeKeyDelta   = "delta"
+	AttributeKeyOveruse = "overuse"
+
 	AttributeValueCategory = ModuleName
 )
@@ -1,23 +1,35 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
 type GenesisVpnInfo struct {
 	VpnInfo
 	Address sdk.AccAddress `json:"address" yaml:"address"`
 }
 
+type GenesisUsagePeriod struct {
+	UsagePeriod
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	// Seq orders usage history records of an account
+	Seq uint64 `json:"seq,omitempty" yaml:"seq,omitempty"`
+}
+
 // GenesisState - all vpn state that must be provided at genesis
 type GenesisState struct {
-	Params    Params           `json:"params" yaml:"params"`
-	VpnStatus []GenesisVpnInfo `json:"vpn_statuses" yaml:"vpn_statuses"`
+	Params       Params               `json:"params" yaml:"params"`
+	VpnStatus    []GenesisVpnInfo     `json:"vpn_statuses" yaml:"vpn_statuses"`
+	UsageHistory []GenesisUsagePeriod `json:"usage_history,omitempty" yaml:"usage_history,omitempty"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, vpnStatus []GenesisVpnInfo) GenesisState {
+func NewGenesisState(params Params, vpnStatus []GenesisVpnInfo, usageHistory []GenesisUsagePeriod) GenesisState {
 	return GenesisState{
-		Params:    params,
-		VpnStatus: vpnStatus,
+		Params:       params,
+		VpnStatus:    vpnStatus,
+		UsageHistory: usageHistory,
 	}
 }
 
@@ -34,5 +46,13 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	for _, record := range data.UsageHistory {
+		if record.Address.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid usage history account address")
+		}
+		if record.End < record.Start {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "usage period ends before it starts: %d < %d", record.End, record.Start)
+		}
+	}
 	return nil
 }
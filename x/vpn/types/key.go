@@ -13,3 +13,9 @@ const (
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
 )
+
+var (
+	// Current period info is stored under auth.AddressStoreKey (0x01 prefix)
+
+	UsageHistoryPrefix = []byte{0x02}
+)
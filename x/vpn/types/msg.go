@@ -34,6 +34,9 @@ func (msg MsgSetLimit) ValidateBasic() error {
 	return nil
 }
 
+// MsgSetCurrent sets the traffic used during the current period. The value cannot be less than the previous one.
+//
+// Deprecated: use MsgAddUsage instead.
 type MsgSetCurrent struct {
 	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
 	Address sdk.AccAddress `json:"address" yaml:"address"`
@@ -66,3 +69,40 @@ func (msg MsgSetCurrent) ValidateBasic() error {
 	}
 	return nil
 }
+
+// MsgAddUsage is a signer-attested report of traffic used by an account since the previous report
+type MsgAddUsage struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Delta   int64          `json:"delta" yaml:"delta"`
+}
+
+func NewMsgAddUsage(sender, addr sdk.AccAddress, delta int64) MsgAddUsage {
+	return MsgAddUsage{Sender: sender, Address: addr, Delta: delta}
+}
+
+const AddUsageConst = "add_usage"
+
+func (msg MsgAddUsage) Route() string { return RouterKey }
+func (msg MsgAddUsage) Type() string  { return AddUsageConst }
+func (msg MsgAddUsage) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgAddUsage) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAddUsage) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing address")
+	}
+	if msg.Delta <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "usage delta must be positive")
+	}
+	return nil
+}
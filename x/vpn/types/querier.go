@@ -11,8 +11,51 @@ const (
 	QueryVpnLimit   = "query_limit"
 	QueryVpnCurrent = "query_current"
 	QueryParams     = "params"
+	QueryUsage      = "query_usage"
+	QueryOveruse    = "query_overuse"
 )
 
+type QueryUsageParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Limit   int32          `json:"limit" yaml:"limit"`
+	Page    int32          `json:"page" yaml:"page"`
+}
+
+func (params QueryUsageParams) String() string {
+	return fmt.Sprintf("Address: %s\nLimit: %d\nPage: %d\n", params.Address, params.Limit, params.Page)
+}
+
+func NewQueryUsageParams(addr sdk.AccAddress, limit, page int32) QueryUsageParams {
+	return QueryUsageParams{
+		Address: addr,
+		Limit:   limit,
+		Page:    page,
+	}
+}
+
+// QueryResUsage contains the current billing period and the previous ones (the most recent first)
+type QueryResUsage struct {
+	Current VpnInfo       `json:"current" yaml:"current"`
+	History []UsagePeriod `json:"history" yaml:"history"`
+}
+
+func (res QueryResUsage) String() string {
+	return fmt.Sprintf("Current:\n%s\nHistory: %v\n", res.Current, res.History)
+}
+
+type OveruseRecord struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Current int64          `json:"current" yaml:"current"`
+	Limit   int64          `json:"limit" yaml:"limit"`
+}
+
+func (r OveruseRecord) String() string {
+	return fmt.Sprintf("%s: %d of %d", r.Address, r.Current, r.Limit)
+}
+
+// QueryResOveruse lists accounts that have exceeded their limit during the current period
+type QueryResOveruse []OveruseRecord
+
 type QueryResState struct {
 	State VpnInfo `json:"vpn_info" yaml:"vpn_info"`
 }
@@ -3,8 +3,9 @@ package types
 import "fmt"
 
 type VpnInfo struct {
-	Current int64 `json:"current" yaml:"current"`
-	Limit   int64 `json:"limit" yaml:"limit"`
+	Current     int64 `json:"current" yaml:"current"`
+	Limit       int64 `json:"limit" yaml:"limit"`
+	PeriodStart int64 `json:"period_start,omitempty" yaml:"period_start,omitempty"`
 }
 
 func NewVpmInfo() VpnInfo {
@@ -16,7 +17,42 @@ func NewVpmInfo() VpnInfo {
 
 func (info VpnInfo) String() string {
 	return fmt.Sprintf(
-		"Current %d\nLimit: %d\n",
+		"Current %d\nLimit: %d\nPeriodStart: %d\n",
 		info.Current,
-		info.Limit)
+		info.Limit,
+		info.PeriodStart)
+}
+
+// Overuse returns traffic used over the limit (or 0 if the limit isn't exceeded)
+func (info VpnInfo) Overuse() int64 {
+	if info.Current > info.Limit {
+		return info.Current - info.Limit
+	}
+	return 0
+}
+
+// UsagePeriod is a record of VPN traffic used during a finished billing period
+type UsagePeriod struct {
+	Start int64 `json:"start" yaml:"start"`
+	End   int64 `json:"end" yaml:"end"`
+	Limit int64 `json:"limit" yaml:"limit"`
+	Used  int64 `json:"used" yaml:"used"`
+}
+
+func (p UsagePeriod) String() string {
+	return fmt.Sprintf(
+		"Start: %d\nEnd: %d\nLimit: %d\nUsed: %d\nOveruse: %d\n",
+		p.Start,
+		p.End,
+		p.Limit,
+		p.Used,
+		p.Overuse())
+}
+
+// Overuse returns traffic used over the limit (or 0 if the limit wasn't exceeded)
+func (p UsagePeriod) Overuse() int64 {
+	if p.Used > p.Limit {
+		return p.Used - p.Limit
+	}
+	return 0
 }